@@ -304,9 +304,24 @@ type Agent struct {
 	// checkAliases maps the check ID to an associated Alias checks
 	checkAliases map[structs.CheckID]*checks.CheckAlias
 
+	// checkComposites maps the check ID to an associated Composite check
+	checkComposites map[structs.CheckID]*checks.CheckComposite
+
 	// checkOSServices maps the check ID to an associated OS Service check
 	checkOSServices map[structs.CheckID]*checks.CheckOSService
 
+	// checkCertExpiries maps the check ID to an associated certificate expiry check
+	checkCertExpiries map[structs.CheckID]*checks.CheckCertExpiry
+
+	// checkDNSs maps the check ID to an associated DNS check
+	checkDNSs map[structs.CheckID]*checks.CheckDNS
+
+	// checkFiles maps the check ID to an associated file freshness check
+	checkFiles map[structs.CheckID]*checks.CheckFileFreshness
+
+	// checkOutliers maps the check ID to an associated outlier check
+	checkOutliers map[structs.CheckID]*checks.CheckOutlier
+
 	// exposedPorts tracks listener ports for checks exposed through a proxy
 	exposedPorts map[string]int
 
@@ -351,6 +366,10 @@ type Agent struct {
 	// dnsServer provides the DNS API
 	dnsServers []dnsServer
 
+	// dnsRateLimiter limits the queries and responses of all the DNS
+	// servers per client.
+	dnsRateLimiter *dnsRateLimiter
+
 	// apiServers listening for connections. If any of these server goroutines
 	// fail, the agent will be shutdown.
 	apiServers *apiServers
@@ -380,6 +399,10 @@ type Agent struct {
 	// agent.
 	watchPlans []*watch.Plan
 
+	// watchHandlers tracks the handlers of the watch plans that must be
+	// closed when the plans are stopped, to stop their retries.
+	watchHandlers []io.Closer
+
 	// tokens holds ACL tokens initially from the configuration, but can
 	// be updated at runtime, so should always be used instead of going to
 	// the configuration directly.
@@ -464,24 +487,29 @@ func New(bd BaseDeps) (*Agent, error) {
 		return nil, errors.New("NetRPC is required")
 	}
 	a := Agent{
-		checkReapAfter:  make(map[structs.CheckID]time.Duration),
-		checkMonitors:   make(map[structs.CheckID]*checks.CheckMonitor),
-		checkTTLs:       make(map[structs.CheckID]*checks.CheckTTL),
-		checkHTTPs:      make(map[structs.CheckID]*checks.CheckHTTP),
-		checkH2PINGs:    make(map[structs.CheckID]*checks.CheckH2PING),
-		checkTCPs:       make(map[structs.CheckID]*checks.CheckTCP),
-		checkUDPs:       make(map[structs.CheckID]*checks.CheckUDP),
-		checkGRPCs:      make(map[structs.CheckID]*checks.CheckGRPC),
-		checkDockers:    make(map[structs.CheckID]*checks.CheckDocker),
-		checkAliases:    make(map[structs.CheckID]*checks.CheckAlias),
-		checkOSServices: make(map[structs.CheckID]*checks.CheckOSService),
-		eventCh:         make(chan serf.UserEvent, 1024),
-		eventBuf:        make([]*UserEvent, 256),
-		joinLANNotifier: &systemd.Notifier{},
-		retryJoinCh:     make(chan error),
-		shutdownCh:      make(chan struct{}),
-		endpoints:       make(map[string]string),
-		stateLock:       mutex.New(),
+		checkReapAfter:    make(map[structs.CheckID]time.Duration),
+		checkMonitors:     make(map[structs.CheckID]*checks.CheckMonitor),
+		checkTTLs:         make(map[structs.CheckID]*checks.CheckTTL),
+		checkHTTPs:        make(map[structs.CheckID]*checks.CheckHTTP),
+		checkH2PINGs:      make(map[structs.CheckID]*checks.CheckH2PING),
+		checkTCPs:         make(map[structs.CheckID]*checks.CheckTCP),
+		checkUDPs:         make(map[structs.CheckID]*checks.CheckUDP),
+		checkGRPCs:        make(map[structs.CheckID]*checks.CheckGRPC),
+		checkDockers:      make(map[structs.CheckID]*checks.CheckDocker),
+		checkAliases:      make(map[structs.CheckID]*checks.CheckAlias),
+		checkComposites:   make(map[structs.CheckID]*checks.CheckComposite),
+		checkOSServices:   make(map[structs.CheckID]*checks.CheckOSService),
+		checkCertExpiries: make(map[structs.CheckID]*checks.CheckCertExpiry),
+		checkDNSs:         make(map[structs.CheckID]*checks.CheckDNS),
+		checkFiles:        make(map[structs.CheckID]*checks.CheckFileFreshness),
+		checkOutliers:     make(map[structs.CheckID]*checks.CheckOutlier),
+		eventCh:           make(chan serf.UserEvent, 1024),
+		eventBuf:          make([]*UserEvent, 256),
+		joinLANNotifier:   &systemd.Notifier{},
+		retryJoinCh:       make(chan error),
+		shutdownCh:        make(chan struct{}),
+		endpoints:         make(map[string]string),
+		stateLock:         mutex.New(),
 
 		baseDeps:        bd,
 		tokens:          bd.Tokens,
@@ -1023,9 +1051,27 @@ func (a *Agent) listenAndServeGRPC(server *consul.Server) error {
 }
 
 func (a *Agent) listenAndServeDNS() error {
-	notif := make(chan net.Addr, len(a.config.DNSAddrs))
-	errCh := make(chan error, len(a.config.DNSAddrs))
+	type dnsListener struct {
+		network string
+		addr    net.Addr
+	}
+	var listeners []dnsListener
 	for _, addr := range a.config.DNSAddrs {
+		listeners = append(listeners, dnsListener{network: addr.Network(), addr: addr})
+	}
+	for _, addr := range a.config.DNSTLSAddrs {
+		listeners = append(listeners, dnsListener{network: dnsNetworkTLS, addr: addr})
+	}
+	for _, addr := range a.config.DNSHTTPSAddrs {
+		listeners = append(listeners, dnsListener{network: dnsNetworkHTTPS, addr: addr})
+	}
+
+	a.dnsRateLimiter = newDNSRateLimiter(a.config.DNSRateLimit)
+	a.dnsRateLimiter.Run(&lib.StopChannelContext{StopCh: a.shutdownCh})
+
+	notif := make(chan dnsListener, len(listeners))
+	errCh := make(chan error, len(listeners))
+	for _, l := range listeners {
 		// create server
 		s, err := NewDNSServer(a)
 		if err != nil {
@@ -1035,13 +1081,13 @@ func (a *Agent) listenAndServeDNS() error {
 
 		// start server
 		a.wgServers.Add(1)
-		go func(addr net.Addr) {
+		go func(l dnsListener) {
 			defer a.wgServers.Done()
-			err := s.ListenAndServe(addr.Network(), addr.String(), func() { notif <- addr })
+			err := s.ListenAndServe(l.network, l.addr.String(), func() { notif <- l })
 			if err != nil && !strings.Contains(err.Error(), "accept") {
 				errCh <- err
 			}
-		}(addr)
+		}(l)
 	}
 	s, _ := NewDNSServer(a)
 
@@ -1056,12 +1102,12 @@ func (a *Agent) listenAndServeDNS() error {
 	// wait for servers to be up
 	timeout := time.After(time.Second)
 	var merr *multierror.Error
-	for range a.config.DNSAddrs {
+	for range listeners {
 		select {
-		case addr := <-notif:
+		case l := <-notif:
 			a.logger.Info("Started DNS server",
-				"address", addr.String(),
-				"network", addr.Network(),
+				"address", l.addr.String(),
+				"network", l.network,
 			)
 
 		case err := <-errCh:
@@ -1279,6 +1325,9 @@ func (a *Agent) stopAllWatches() {
 	for _, wp := range a.watchPlans {
 		wp.Stop()
 	}
+	for _, h := range a.watchHandlers {
+		h.Close()
+	}
 }
 
 // reloadWatches stops any existing watch plans and attempts to load the given
@@ -1287,6 +1336,7 @@ func (a *Agent) reloadWatches(cfg *config.RuntimeConfig) error {
 	// Stop the current watches.
 	a.stopAllWatches()
 	a.watchPlans = nil
+	a.watchHandlers = nil
 
 	// Return if there are no watches now.
 	if len(cfg.Watches) == 0 {
@@ -1303,7 +1353,8 @@ func (a *Agent) reloadWatches(cfg *config.RuntimeConfig) error {
 	for _, params := range cfg.Watches {
 		if handlerType, ok := params["handler_type"]; !ok {
 			params["handler_type"] = "script"
-		} else if handlerType != "http" && handlerType != "script" {
+		} else if handlerType != "http" && handlerType != "script" &&
+			handlerType != "file" && handlerType != "socket" {
 			return fmt.Errorf("Handler type '%s' not recognized", params["handler_type"])
 		}
 
@@ -1332,17 +1383,31 @@ func (a *Agent) reloadWatches(cfg *config.RuntimeConfig) error {
 			continue
 		}
 
-		a.watchPlans = append(a.watchPlans, wp)
-		go func(wp *watch.Plan) {
+		switch wp.HandlerType {
+		case "http":
+			httpConfig := wp.Exempt["http_handler_config"].(*watch.HttpHandlerConfig)
+			wp.Handler = makeHTTPWatchHandler(a.logger, httpConfig)
+		case "file":
+			fileConfig := wp.Exempt["file_handler_config"].(*watch.FileHandlerConfig)
+			h := makeFileWatchHandler(a.logger, fileConfig)
+			a.watchHandlers = append(a.watchHandlers, h)
+			wp.Handler = h.Handle
+		case "socket":
+			socketConfig := wp.Exempt["socket_handler_config"].(*watch.SocketHandlerConfig)
+			h := makeSocketWatchHandler(a.logger, socketConfig)
+			a.watchHandlers = append(a.watchHandlers, h)
+			wp.Handler = h.Handle
+		default:
 			if h, ok := wp.Exempt["handler"]; ok {
 				wp.Handler = makeWatchHandler(a.logger, h)
-			} else if h, ok := wp.Exempt["args"]; ok {
-				wp.Handler = makeWatchHandler(a.logger, h)
 			} else {
-				httpConfig := wp.Exempt["http_handler_config"].(*watch.HttpHandlerConfig)
-				wp.Handler = makeHTTPWatchHandler(a.logger, httpConfig)
+				wp.Handler = makeWatchHandler(a.logger, wp.Exempt["args"])
 			}
-			wp.Logger = a.logger.Named("watch")
+		}
+		wp.Logger = a.logger.Named("watch")
+
+		a.watchPlans = append(a.watchPlans, wp)
+		go func(wp *watch.Plan) {
 
 			addr := config.Address
 			if config.Scheme == "https" {
@@ -1485,6 +1550,12 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	if runtimeCfg.ReadReplica {
 		cfg.ReadReplica = runtimeCfg.ReadReplica
 	}
+	cfg.KVHistoryRetainCount = runtimeCfg.KVHistoryRetainCount
+	cfg.KVHistoryRetainAge = runtimeCfg.KVHistoryRetainAge
+	cfg.AutoSnapshotInterval = runtimeCfg.AutoSnapshotInterval
+	cfg.AutoSnapshotPath = runtimeCfg.AutoSnapshotPath
+	cfg.AutoSnapshotRetain = runtimeCfg.AutoSnapshotRetain
+	cfg.AutoSnapshotNameTemplate = runtimeCfg.AutoSnapshotNameTemplate
 
 	// These are fully specified in the agent defaults, so we can simply
 	// copy them over.
@@ -1754,9 +1825,24 @@ func (a *Agent) ShutdownAgent() error {
 	for _, chk := range a.checkAliases {
 		chk.Stop()
 	}
+	for _, chk := range a.checkComposites {
+		chk.Stop()
+	}
 	for _, chk := range a.checkH2PINGs {
 		chk.Stop()
 	}
+	for _, chk := range a.checkCertExpiries {
+		chk.Stop()
+	}
+	for _, chk := range a.checkDNSs {
+		chk.Stop()
+	}
+	for _, chk := range a.checkFiles {
+		chk.Stop()
+	}
+	for _, chk := range a.checkOutliers {
+		chk.Stop()
+	}
 
 	// Stop gRPC
 	if a.externalGRPCServer != nil {
@@ -2918,6 +3004,12 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				return fmt.Errorf("Scripts are disabled on this agent from remote calls; to enable, configure 'enable_script_checks' to true")
 			}
 		}
+
+		// A file freshness check can stat any path the agent can read, so
+		// remote calls are held to the same setting as scripts.
+		if chkType.IsFileFreshness() && source == ConfigSourceRemote && !a.config.EnableRemoteScriptChecks {
+			return fmt.Errorf("File freshness checks are disabled on this agent from remote calls; to enable, configure 'enable_script_checks' to true")
+		}
 	}
 
 	if check.ServiceID != "" {
@@ -2956,6 +3048,10 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 		}
 
 		statusHandler := checks.NewStatusHandler(a.State, a.logger, chkType.SuccessBeforePassing, chkType.FailuresBeforeWarning, chkType.FailuresBeforeCritical)
+		if chkType.FlapHalfLife > 0 {
+			suppress, reuse, maxSuppress := chkType.FlapDampening()
+			statusHandler.EnableFlapDampening(chkType.FlapHalfLife, maxSuppress, suppress, reuse)
+		}
 		sid := check.CompoundServiceID()
 
 		cid := check.CompoundCheckID()
@@ -3017,6 +3113,8 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				OutputMaxSize:    maxOutputSize,
 				TLSClientConfig:  tlsClientConfig,
 				StatusHandler:    statusHandler,
+
+				ResponseAssertions: chkType.ResponseAssertions,
 			}
 
 			if proxy != nil && proxy.Proxy.Expose.Checks {
@@ -3232,6 +3330,10 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				OutputMaxSize: maxOutputSize,
 				StatusHandler: statusHandler,
 			}
+			if a.config.ScriptCheckSandbox.Enabled() {
+				sandbox := a.config.ScriptCheckSandbox
+				monitor.Sandbox = &sandbox
+			}
 			monitor.Start()
 			a.checkMonitors[cid] = monitor
 
@@ -3267,6 +3369,147 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			h2ping.Start()
 			a.checkH2PINGs[cid] = h2ping
 
+		case chkType.IsCertExpiry():
+			if existing, ok := a.checkCertExpiries[cid]; ok {
+				existing.Stop()
+				delete(a.checkCertExpiries, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			certExpiry := &checks.CheckCertExpiry{
+				CheckID:           cid,
+				ServiceID:         sid,
+				CertExpiry:        chkType.CertExpiry,
+				WarningThreshold:  chkType.WarningThreshold,
+				CriticalThreshold: chkType.CriticalThreshold,
+				Interval:          chkType.Interval,
+				Timeout:           chkType.Timeout,
+				Logger:            a.logger,
+				TLSClientConfig:   a.tlsConfigurator.OutgoingTLSConfigForCheck(chkType.TLSSkipVerify, chkType.TLSServerName),
+				StatusHandler:     statusHandler,
+			}
+			certExpiry.Start()
+			a.checkCertExpiries[cid] = certExpiry
+
+		case chkType.IsDNS():
+			if existing, ok := a.checkDNSs[cid]; ok {
+				existing.Stop()
+				delete(a.checkDNSs, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			dns := &checks.CheckDNS{
+				CheckID:           cid,
+				ServiceID:         sid,
+				DNS:               chkType.DNS,
+				DNSServer:         chkType.DNSServer,
+				WarningThreshold:  chkType.WarningThreshold,
+				CriticalThreshold: chkType.CriticalThreshold,
+				Interval:          chkType.Interval,
+				Timeout:           chkType.Timeout,
+				Logger:            a.logger,
+				StatusHandler:     statusHandler,
+			}
+			dns.Start()
+			a.checkDNSs[cid] = dns
+
+		case chkType.IsFileFreshness():
+			if existing, ok := a.checkFiles[cid]; ok {
+				existing.Stop()
+				delete(a.checkFiles, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			fileFreshness := &checks.CheckFileFreshness{
+				CheckID:           cid,
+				ServiceID:         sid,
+				FileFreshness:     chkType.FileFreshness,
+				WarningThreshold:  chkType.WarningThreshold,
+				CriticalThreshold: chkType.CriticalThreshold,
+				Interval:          chkType.Interval,
+				Logger:            a.logger,
+				StatusHandler:     statusHandler,
+			}
+			fileFreshness.Start()
+			a.checkFiles[cid] = fileFreshness
+
+		case chkType.IsOutlier():
+			if service == nil {
+				return fmt.Errorf("Outlier checks must be associated with a service")
+			}
+			if existing, ok := a.checkOutliers[cid]; ok {
+				existing.Stop()
+				delete(a.checkOutliers, cid)
+			}
+			if chkType.Interval < checks.MinInterval {
+				a.logger.Warn("check has interval below minimum",
+					"check", cid.String(),
+					"minimum_interval", checks.MinInterval,
+				)
+				chkType.Interval = checks.MinInterval
+			}
+
+			var rpcReq structs.DCSpecificRequest
+			rpcReq.Datacenter = a.config.Datacenter
+
+			// The servers are queried with the same token as alias checks.
+			rpcReq.Token = a.tokens.UserToken()
+			if token != "" {
+				rpcReq.Token = token
+			}
+
+			// The instance opts in with the config entry of the destination
+			// service of a proxy, and other proxies reach it at any of its
+			// addresses.
+			serviceName := service.Service
+			if service.Kind == structs.ServiceKindConnectProxy {
+				serviceName = service.Proxy.DestinationServiceName
+			}
+			address := service.Address
+			if address == "" {
+				address = a.config.AdvertiseAddrLAN.String()
+			}
+			addresses := []string{net.JoinHostPort(address, strconv.Itoa(service.Port))}
+			for _, addr := range service.TaggedAddresses {
+				addresses = append(addresses, net.JoinHostPort(addr.Address, strconv.Itoa(addr.Port)))
+			}
+
+			outlier := &checks.CheckOutlier{
+				CheckID:        cid,
+				ServiceID:      sid,
+				Node:           a.config.NodeName,
+				ServiceName:    serviceName,
+				Addresses:      addresses,
+				Outlier:        chkType.Outlier,
+				Interval:       chkType.Interval,
+				Logger:         a.logger,
+				Notify:         a.State,
+				StatusHandler:  statusHandler,
+				RPC:            a.delegate,
+				RPCReq:         rpcReq,
+				EnterpriseMeta: check.EnterpriseMeta,
+			}
+			outlier.Start()
+			a.checkOutliers[cid] = outlier
+
 		case chkType.IsAlias():
 			if existing, ok := a.checkAliases[cid]; ok {
 				existing.Stop()
@@ -3299,6 +3542,35 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			chkImpl.Start()
 			a.checkAliases[cid] = chkImpl
 
+		case chkType.IsComposite():
+			if existing, ok := a.checkComposites[cid]; ok {
+				existing.Stop()
+				delete(a.checkComposites, cid)
+			}
+
+			var rpcReq structs.NodeSpecificRequest
+			rpcReq.Datacenter = a.config.Datacenter
+			rpcReq.EnterpriseMeta = *a.AgentEnterpriseMeta()
+
+			// Remote members are queried with the same token as alias checks.
+			rpcReq.Token = a.tokens.UserToken()
+			if token != "" {
+				rpcReq.Token = token
+			}
+
+			chkImpl := &checks.CheckComposite{
+				Notify:         a.State,
+				RPC:            a.delegate,
+				RPCReq:         rpcReq,
+				CheckID:        cid,
+				Mode:           chkType.CompositeMode,
+				Quorum:         chkType.CompositeQuorum,
+				Members:        chkType.CompositeMembers,
+				EnterpriseMeta: check.EnterpriseMeta,
+			}
+			chkImpl.Start()
+			a.checkComposites[cid] = chkImpl
+
 		default:
 			return fmt.Errorf("Check type is not valid")
 		}
@@ -3447,6 +3719,26 @@ func (a *Agent) cancelCheckMonitors(checkID structs.CheckID) {
 		check.Stop()
 		delete(a.checkAliases, checkID)
 	}
+	if check, ok := a.checkComposites[checkID]; ok {
+		check.Stop()
+		delete(a.checkComposites, checkID)
+	}
+	if check, ok := a.checkCertExpiries[checkID]; ok {
+		check.Stop()
+		delete(a.checkCertExpiries, checkID)
+	}
+	if check, ok := a.checkDNSs[checkID]; ok {
+		check.Stop()
+		delete(a.checkDNSs, checkID)
+	}
+	if check, ok := a.checkFiles[checkID]; ok {
+		check.Stop()
+		delete(a.checkFiles, checkID)
+	}
+	if check, ok := a.checkOutliers[checkID]; ok {
+		check.Stop()
+		delete(a.checkOutliers, checkID)
+	}
 }
 
 // updateTTLCheck is used to update the status of a TTL check via the Agent API.
@@ -4288,6 +4580,9 @@ func (a *Agent) reloadConfigInternal(newCfg *config.RuntimeConfig) error {
 			return fmt.Errorf("Failed reloading dns config : %v", err)
 		}
 	}
+	if a.dnsRateLimiter != nil {
+		a.dnsRateLimiter.UpdateConfig(newCfg.DNSRateLimit)
+	}
 
 	err := a.reloadEnterprise(newCfg)
 	if err != nil {
@@ -531,10 +531,15 @@ func (s *HTTPHandlers) AgentChecks(resp http.ResponseWriter, req *http.Request)
 	}
 
 	// NOTE(partitions): this works because nodes exist in ONE partition
-	checks := s.agent.State.Checks(&entMeta)
+	checkStates := s.agent.State.CheckStates(&entMeta)
 
 	agentChecks := make(map[types.CheckID]*structs.HealthCheck)
-	for id, c := range checks {
+	flapping := make(map[types.CheckID]*structs.CheckFlapState)
+	for id, cs := range checkStates {
+		c := cs.Check
+		if cs.Flapping != nil {
+			flapping[id.ID] = cs.Flapping
+		}
 		if c.ServiceTags == nil {
 			clone := *c
 			clone.ServiceTags = make([]string, 0)
@@ -575,7 +580,18 @@ func (s *HTTPHandlers) AgentChecks(resp http.ResponseWriter, req *http.Request)
 	}
 	agentChecks = raw.(map[types.CheckID]*structs.HealthCheck)
 
-	return agentChecks, nil
+	out := make(map[types.CheckID]*agentCheck, len(agentChecks))
+	for id, c := range agentChecks {
+		out[id] = &agentCheck{HealthCheck: c, Flapping: flapping[id]}
+	}
+	return out, nil
+}
+
+// agentCheck is a check returned by the /v1/agent/checks endpoint, with the
+// flap dampening state that only the agent keeps.
+type agentCheck struct {
+	*structs.HealthCheck
+	Flapping *structs.CheckFlapState `json:",omitempty"`
 }
 
 func (s *HTTPHandlers) AgentMembers(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -854,6 +854,65 @@ func TestAgent_Checks(t *testing.T) {
 	}
 }
 
+func TestAgent_Checks_Flapping(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	for _, id := range []types.CheckID{"mysql", "redis"} {
+		chk := &structs.HealthCheck{
+			Node:    a.Config.NodeName,
+			CheckID: id,
+			Name:    string(id),
+			Status:  api.HealthWarning,
+		}
+		require.NoError(t, a.State.AddCheck(chk, "", false))
+	}
+	state := &structs.CheckFlapState{Flapping: true, Penalty: 3.5, Transitions: 4, Status: api.HealthCritical}
+	a.State.UpdateCheckFlapping(structs.NewCheckID("mysql", nil), state)
+
+	req, _ := http.NewRequest("GET", "/v1/agent/checks", nil)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var val map[types.CheckID]*api.AgentCheck
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&val))
+	require.Len(t, val, 2)
+	require.Equal(t, api.HealthWarning, val["mysql"].Status)
+	require.Equal(t, &api.AgentCheckFlapState{Flapping: true, Penalty: 3.5, Transitions: 4, Status: api.HealthCritical}, val["mysql"].Flapping)
+	require.Equal(t, map[string]string{api.HealthCheckMetaFlapping: "true"}, val["mysql"].Meta)
+	require.Nil(t, val["redis"].Flapping)
+	require.Nil(t, val["redis"].Meta)
+
+	// Whether the check is flapping is synced to the servers.
+	requireFlapping := func(t *testing.T, flapping bool) {
+		t.Helper()
+		require.NoError(t, a.State.SyncFull())
+		checks, _, err := a.Client().Health().Node(a.Config.NodeName, nil)
+		require.NoError(t, err)
+		for _, chk := range checks {
+			if chk.CheckID != "mysql" {
+				continue
+			}
+			_, ok := chk.Meta[api.HealthCheckMetaFlapping]
+			require.Equal(t, flapping, ok)
+			return
+		}
+		t.Fatal("check mysql not found")
+	}
+	requireFlapping(t, true)
+
+	state = &structs.CheckFlapState{Penalty: 0.5, Transitions: 1, Status: api.HealthPassing}
+	a.State.UpdateCheckFlapping(structs.NewCheckID("mysql", nil), state)
+	requireFlapping(t, false)
+}
+
 func TestAgent_ChecksWithFilter(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
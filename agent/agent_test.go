@@ -1864,6 +1864,69 @@ func TestAgent_AddCheck_GRPC(t *testing.T) {
 	requireCheckExistsMap(t, a.checkGRPCs, "grpchealth")
 }
 
+func TestAgent_AddCheck_Thresholds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	add := func(t *testing.T, id types.CheckID, chk *structs.CheckType) {
+		t.Helper()
+		health := &structs.HealthCheck{
+			Node:    "foo",
+			CheckID: id,
+			Name:    string(id),
+			Status:  api.HealthCritical,
+		}
+		require.NoError(t, a.AddCheck(health, chk, false, "", ConfigSourceLocal))
+		requireCheckExists(t, a, id)
+	}
+
+	add(t, "cert", &structs.CheckType{
+		CertExpiry:        "localhost:12345",
+		WarningThreshold:  30 * 24 * time.Hour,
+		CriticalThreshold: 7 * 24 * time.Hour,
+		Interval:          15 * time.Second,
+	})
+	requireCheckExistsMap(t, a.checkCertExpiries, "cert")
+
+	add(t, "dns", &structs.CheckType{
+		DNS:              "localhost",
+		WarningThreshold: time.Second,
+		Interval:         15 * time.Second,
+	})
+	requireCheckExistsMap(t, a.checkDNSs, "dns")
+
+	add(t, "file", &structs.CheckType{
+		FileFreshness:     "/var/run/job/last-success",
+		CriticalThreshold: time.Hour,
+		Interval:          15 * time.Second,
+	})
+	requireCheckExistsMap(t, a.checkFiles, "file")
+
+	require.NoError(t, a.RemoveCheck(structs.NewCheckID("file", nil), false))
+	requireCheckMissingMap(t, a.checkFiles, "file")
+
+	// A file freshness check can only be registered remotely when remote
+	// script checks are enabled.
+	health := &structs.HealthCheck{
+		Node:    "foo",
+		CheckID: "remote-file",
+		Name:    "remote-file",
+		Status:  api.HealthCritical,
+	}
+	err := a.AddCheck(health, &structs.CheckType{
+		FileFreshness:     "/etc/passwd",
+		CriticalThreshold: time.Hour,
+		Interval:          15 * time.Second,
+	}, false, "", ConfigSourceRemote)
+	require.ErrorContains(t, err, "File freshness checks are disabled on this agent from remote calls")
+	requireCheckMissingMap(t, a.checkFiles, "remote-file")
+}
+
 func TestAgent_RestoreServiceWithAliasCheck(t *testing.T) {
 	// t.Parallel() don't even think about making this parallel
 
@@ -2055,6 +2118,157 @@ func TestAgent_Alias_AddRemove(t *testing.T) {
 	})
 }
 
+func TestAgent_AddCheck_Composite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	for _, id := range []types.CheckID{"mem", "disk"} {
+		health := &structs.HealthCheck{
+			Node:    "foo",
+			CheckID: id,
+			Name:    string(id),
+			Status:  api.HealthPassing,
+		}
+		require.NoError(t, a.AddCheck(health, &structs.CheckType{TTL: time.Minute}, false, "", ConfigSourceLocal))
+	}
+
+	health := &structs.HealthCheck{
+		Node:    "foo",
+		CheckID: "group",
+		Name:    "group",
+		Status:  api.HealthCritical,
+	}
+	chk := &structs.CheckType{
+		CompositeMode:    structs.CompositeModeQuorum,
+		CompositeQuorum:  2,
+		CompositeMembers: []structs.CompositeCheckMember{{CheckID: "mem"}, {CheckID: "disk"}},
+	}
+	require.NoError(t, a.AddCheck(health, chk, false, "", ConfigSourceLocal))
+	requireCheckExistsMap(t, a.checkComposites, "group")
+
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, api.HealthPassing, a.State.Check(structs.NewCheckID("group", nil)).Status)
+	})
+
+	// The check follows the state of its members.
+	require.NoError(t, a.updateTTLCheck(structs.NewCheckID("disk", nil), api.HealthWarning, "almost full"))
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, api.HealthWarning, a.State.Check(structs.NewCheckID("group", nil)).Status)
+	})
+
+	require.NoError(t, a.RemoveCheck(structs.NewCheckID("group", nil), false))
+	requireCheckMissingMap(t, a.checkComposites, "group")
+}
+
+func TestAgent_AddCheck_Outlier(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	envoy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"cluster_statuses": [{"name": "web", "host_statuses": [{
+			"address": {"socket_address": {"address": "10.0.0.3", "port_value": 21000}},
+			"health_status": {"failed_outlier_check": true}
+		}]}]}`))
+	}))
+	defer envoy.Close()
+
+	// Outlier checks must belong to a service.
+	health := &structs.HealthCheck{
+		Node:    "foo",
+		CheckID: "outlier",
+		Name:    "outlier",
+		Status:  api.HealthCritical,
+	}
+	chk := &structs.CheckType{
+		Outlier:  strings.TrimPrefix(envoy.URL, "http://"),
+		Interval: time.Second,
+	}
+	err := a.AddCheck(health, chk, false, "", ConfigSourceLocal)
+	require.EqualError(t, err, "Outlier checks must be associated with a service")
+
+	proxy := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		ID:      "api-sidecar-proxy",
+		Service: "api-sidecar-proxy",
+		Address: "10.0.0.1",
+		Port:    21000,
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceName: "api",
+		},
+	}
+	require.NoError(t, a.addServiceFromSource(proxy, []*structs.CheckType{chk}, false, "", ConfigSourceLocal))
+	cid := structs.NewCheckID("service:api-sidecar-proxy", nil)
+	requireCheckExistsMap(t, a.checkOutliers, cid.ID)
+
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, api.HealthPassing, a.State.Check(cid).Status)
+		require.Contains(r, a.State.Check(cid).Output, `Outlier health checks are not enabled for service "api"`)
+		require.Equal(r, "10.0.0.3:21000", a.State.Check(cid).Meta[structs.MetaCheckOutlierEjected])
+	})
+
+	// The instance is warning once it opts in and a downstream proxy reports
+	// it ejected.
+	entryReq := &structs.ConfigEntryRequest{
+		Op:         structs.ConfigEntryUpsert,
+		Datacenter: "dc1",
+		Entry: &structs.ServiceConfigEntry{
+			Kind:               structs.ServiceDefaults,
+			Name:               "api",
+			OutlierHealthCheck: &structs.OutlierHealthCheck{MinProxies: 1},
+		},
+	}
+	var entryResp bool
+	require.NoError(t, a.RPC(context.Background(), "ConfigEntry.Apply", entryReq, &entryResp))
+
+	regReq := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "other",
+		Address:    "10.0.0.2",
+		Service: &structs.NodeService{
+			Kind:    structs.ServiceKindConnectProxy,
+			ID:      "web-sidecar-proxy",
+			Service: "web-sidecar-proxy",
+			Port:    21000,
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: "web",
+				Upstreams: structs.Upstreams{
+					{DestinationName: "api", LocalBindPort: 9191},
+				},
+			},
+		},
+		Check: &structs.HealthCheck{
+			Node:      "other",
+			CheckID:   "outlier",
+			Name:      "outlier",
+			Type:      "outlier",
+			ServiceID: "web-sidecar-proxy",
+			Status:    api.HealthPassing,
+			Meta:      map[string]string{structs.MetaCheckOutlierEjected: "10.0.0.1:21000"},
+		},
+	}
+	var regResp struct{}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", regReq, &regResp))
+
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, api.HealthWarning, a.State.Check(cid).Status)
+		require.Contains(r, a.State.Check(cid).Output, `1 of 1 proxies needed report this instance ejected: "web-sidecar-proxy" on node "other"`)
+	})
+
+	require.NoError(t, a.RemoveCheck(cid, false))
+	requireCheckMissingMap(t, a.checkOutliers, cid.ID)
+}
+
 func TestAgent_AddCheck_Alias_setToken(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
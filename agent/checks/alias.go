@@ -95,12 +95,24 @@ func (c *CheckAlias) run(stopCh chan struct{}) {
 }
 
 func (c *CheckAlias) runLocal(stopCh chan struct{}) {
+	runLocalChecks(stopCh, c.Notify, c.CheckID, c.ServiceID, c.WithWildcardNamespace(), func(checks []*structs.HealthCheck) {
+		c.processChecks(checks, func(serviceID *structs.ServiceID) bool {
+			return c.Notify.ServiceExists(*serviceID)
+		})
+	})
+}
+
+// runLocalChecks calls fn with the checks in the local state whenever a
+// check of srcServiceID changes, until stopCh is closed. checkID identifies
+// the check that is notified of the changes.
+func runLocalChecks(stopCh <-chan struct{}, notify AliasNotifier, checkID structs.CheckID, srcServiceID structs.ServiceID,
+	entMeta *acl.EnterpriseMeta, fn func([]*structs.HealthCheck)) {
 	// Very important this is buffered as 1 so that we do not lose any
 	// queued updates. This only has to be exactly 1 since the existence
 	// of any update triggers us to load the full health check state.
 	notifyCh := make(chan struct{}, 1)
-	c.Notify.AddAliasCheck(c.CheckID, c.ServiceID, notifyCh)
-	defer c.Notify.RemoveAliasCheck(c.CheckID, c.ServiceID)
+	notify.AddAliasCheck(checkID, srcServiceID, notifyCh)
+	defer notify.RemoveAliasCheck(checkID, srcServiceID)
 
 	// maxDurationBetweenUpdates is maximum time we go between explicit
 	// notifications before we re-query the aliased service checks anyway. This
@@ -114,14 +126,12 @@ func (c *CheckAlias) runLocal(stopCh chan struct{}) {
 	}
 
 	updateStatus := func() {
-		checks := c.Notify.Checks(c.WithWildcardNamespace())
+		checks := notify.Checks(entMeta)
 		checksList := make([]*structs.HealthCheck, 0, len(checks))
 		for _, chk := range checks {
 			checksList = append(checksList, chk)
 		}
-		c.processChecks(checksList, func(serviceID *structs.ServiceID) bool {
-			return c.Notify.ServiceExists(*serviceID)
-		})
+		fn(checksList)
 		extendRefreshTimer()
 	}
 
@@ -181,13 +191,63 @@ RETRY_CALL:
 func (c *CheckAlias) runQuery(stopCh chan struct{}) {
 	args := c.RPCReq
 	args.Node = c.Node
+	args.EnterpriseMeta = c.EnterpriseMeta
+
+	onErr := func(err error) {
+		c.Notify.UpdateCheck(c.CheckID, api.HealthCritical,
+			fmt.Sprintf("Failure checking aliased node or service: %s", err))
+	}
+	runNodeChecksQuery(stopCh, c.RPC, args, onErr, func(checks []*structs.HealthCheck) {
+		c.processChecks(checks, func(serviceID *structs.ServiceID) bool {
+			ret, err := c.checkServiceExistsOnRemoteServer(serviceID)
+			if err != nil {
+				// We cannot determine if node has the check, let's assume it exists
+				return true
+			}
+			return ret
+		})
+	})
+}
+
+// runNodeChecksQuery calls fn with the health checks of args.Node each time
+// they change, using blocking queries until stopCh is closed. onErr is called
+// when a query fails after it was retried once, and the failed queries are
+// retried with a backoff.
+func runNodeChecksQuery(stopCh <-chan struct{}, rpc RPC, args structs.NodeSpecificRequest,
+	onErr func(error), fn func([]*structs.HealthCheck)) {
 	args.AllowStale = true
 	args.MaxQueryTime = 1 * time.Minute
-	args.EnterpriseMeta = c.EnterpriseMeta
 	// We are late at maximum of 15s compared to leader
 	args.MaxStaleDuration = 15 * time.Second
 
-	var attempt uint
+	runBlockingQuery(stopCh, onErr, func(minIndex uint64) (uint64, error) {
+		args.MinQueryIndex = minIndex
+
+		// Get the current health checks for the specified node.
+		//
+		// NOTE(mitchellh): This currently returns ALL health checks for
+		// a node even though we also have the service ID. This can be
+		// optimized if we introduce a new RPC endpoint to filter both,
+		// but for blocking queries isn't that much more efficient since the checks
+		// index is global to the cluster.
+		var out structs.IndexedHealthChecks
+		if err := rpc.RPC(context.Background(), "Health.NodeChecks", &args, &out); err != nil {
+			return 0, err
+		}
+		fn(out.HealthChecks)
+		return out.Index, nil
+	})
+}
+
+// runBlockingQuery calls query with the index of its last result until stopCh
+// is closed, so that each call blocks until the result changes. onErr is
+// called when a query fails after it was retried once, and the failed queries
+// are retried with a backoff.
+func runBlockingQuery(stopCh <-chan struct{}, onErr func(error), query func(minIndex uint64) (uint64, error)) {
+	var (
+		attempt  uint
+		minIndex uint64
+	)
 	for {
 		// Check if we're stopped. We fallthrough and block otherwise,
 		// which has a maximum time set above so we'll always check for
@@ -211,20 +271,11 @@ func (c *CheckAlias) runQuery(stopCh chan struct{}) {
 			time.Sleep(waitTime)
 		}
 
-		// Get the current health checks for the specified node.
-		//
-		// NOTE(mitchellh): This currently returns ALL health checks for
-		// a node even though we also have the service ID. This can be
-		// optimized if we introduce a new RPC endpoint to filter both,
-		// but for blocking queries isn't that much more efficient since the checks
-		// index is global to the cluster.
-		var out structs.IndexedHealthChecks
-
-		if err := c.RPC.RPC(context.Background(), "Health.NodeChecks", &args, &out); err != nil {
+		index, err := query(minIndex)
+		if err != nil {
 			attempt++
 			if attempt > 1 {
-				c.Notify.UpdateCheck(c.CheckID, api.HealthCritical,
-					fmt.Sprintf("Failure checking aliased node or service: %s", err))
+				onErr(err)
 			}
 
 			continue
@@ -233,23 +284,15 @@ func (c *CheckAlias) runQuery(stopCh chan struct{}) {
 		attempt = 0 // Reset the attempts so we don't backoff the next
 
 		// Set our index for the next request
-		args.MinQueryIndex = out.Index
+		minIndex = index
 
 		// We want to ensure that we're always blocking on subsequent requests
 		// to avoid hot loops. Index 1 is always safe since the min raft index
 		// is at least 5. Note this shouldn't happen but protecting against this
 		// case is safer than a 100% CPU loop.
-		if args.MinQueryIndex < 1 {
-			args.MinQueryIndex = 1
+		if minIndex < 1 {
+			minIndex = 1
 		}
-		c.processChecks(out.HealthChecks, func(serviceID *structs.ServiceID) bool {
-			ret, err := c.checkServiceExistsOnRemoteServer(serviceID)
-			if err != nil {
-				// We cannot determine if node has the check, let's assume it exists
-				return true
-			}
-			return ret
-		})
 	}
 }
 
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CheckCertExpiry is used to periodically make a TLS connection to an
+// endpoint and check how long the certificates it presents remain valid.
+// The check is critical if the connection fails, a certificate has expired
+// or expires within CriticalThreshold.
+// The check is warning if a certificate expires within WarningThreshold.
+// Otherwise the check is passing.
+// Supports failures_before_critical and success_before_passing.
+type CheckCertExpiry struct {
+	CheckID           structs.CheckID
+	ServiceID         structs.ServiceID
+	CertExpiry        string
+	WarningThreshold  time.Duration
+	CriticalThreshold time.Duration
+	Interval          time.Duration
+	Timeout           time.Duration
+	Logger            hclog.Logger
+	TLSClientConfig   *tls.Config
+	StatusHandler     *StatusHandler
+
+	dialer   *net.Dialer
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+	stopWg   sync.WaitGroup
+}
+
+func (c *CheckCertExpiry) CheckType() structs.CheckType {
+	return structs.CheckType{
+		CheckID:           c.CheckID.ID,
+		CertExpiry:        c.CertExpiry,
+		WarningThreshold:  c.WarningThreshold,
+		CriticalThreshold: c.CriticalThreshold,
+		Interval:          c.Interval,
+		Timeout:           c.Timeout,
+	}
+}
+
+// Start is used to start a certificate expiry check.
+// The check runs until stop is called
+func (c *CheckCertExpiry) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+
+	if c.dialer == nil {
+		c.dialer = &net.Dialer{
+			Timeout: 10 * time.Second,
+		}
+		if c.Timeout > 0 {
+			c.dialer.Timeout = c.Timeout
+		}
+	}
+	if c.TLSClientConfig == nil {
+		c.TLSClientConfig = &tls.Config{}
+	}
+
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	c.stopWg.Add(1)
+	go c.run()
+}
+
+// Stop is used to stop a certificate expiry check.
+func (c *CheckCertExpiry) Stop() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+
+	// Wait for the c.run() goroutine to complete before returning.
+	c.stopWg.Wait()
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckCertExpiry) run() {
+	defer c.stopWg.Done()
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			c.check()
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the certificate expiry check
+func (c *CheckCertExpiry) check() {
+	conn, err := tls.DialWithDialer(c.dialer, "tcp", c.CertExpiry, c.TLSClientConfig)
+	if err != nil {
+		c.Logger.Warn("Check TLS connection failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	certs := conn.ConnectionState().PeerCertificates
+	conn.Close()
+
+	if len(certs) == 0 {
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, fmt.Sprintf("TLS connect %s: No certificate presented", c.CertExpiry))
+		return
+	}
+
+	// The chain is only as good as the certificate that expires first.
+	var cert *x509.Certificate
+	for _, crt := range certs {
+		if cert == nil || crt.NotAfter.Before(cert.NotAfter) {
+			cert = crt
+		}
+	}
+
+	remaining := time.Until(cert.NotAfter)
+	status := api.HealthPassing
+	switch {
+	case remaining <= 0 || remaining <= c.CriticalThreshold:
+		status = api.HealthCritical
+	case remaining <= c.WarningThreshold:
+		status = api.HealthWarning
+	}
+
+	var output string
+	if remaining <= 0 {
+		output = fmt.Sprintf("TLS connect %s: Certificate %q expired at %s",
+			c.CertExpiry, cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339))
+	} else {
+		output = fmt.Sprintf("TLS connect %s: Certificate %q expires at %s, in %s",
+			c.CertExpiry, cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339), remaining.Round(time.Second))
+	}
+	c.StatusHandler.updateCheck(c.CheckID, status, output)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+// testCertificate returns a self-signed certificate valid until notAfter.
+func testCertificate(t *testing.T, notAfter time.Time) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestCheckCertExpiry(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	startServer := func(t *testing.T, notAfter time.Time) string {
+		server := httptest.NewUnstartedServer(handler)
+		server.TLS = &tls.Config{Certificates: []tls.Certificate{testCertificate(t, notAfter)}}
+		server.StartTLS()
+		t.Cleanup(server.Close)
+		return server.Listener.Addr().String()
+	}
+
+	day := 24 * time.Hour
+	cases := []struct {
+		name       string
+		notAfter   time.Time
+		warning    time.Duration
+		critical   time.Duration
+		skipVerify bool
+		status     string
+		output     string
+	}{
+		{
+			name:       "no thresholds",
+			notAfter:   time.Now().Add(3 * day),
+			skipVerify: true,
+			status:     api.HealthPassing,
+			output:     `Certificate "CN=test.example.com" expires at`,
+		},
+		{
+			name:       "passing",
+			notAfter:   time.Now().Add(60 * day),
+			warning:    30 * day,
+			critical:   7 * day,
+			skipVerify: true,
+			status:     api.HealthPassing,
+		},
+		{
+			name:       "warning",
+			notAfter:   time.Now().Add(10 * day),
+			warning:    30 * day,
+			critical:   7 * day,
+			skipVerify: true,
+			status:     api.HealthWarning,
+		},
+		{
+			name:       "critical",
+			notAfter:   time.Now().Add(3 * day),
+			warning:    30 * day,
+			critical:   7 * day,
+			skipVerify: true,
+			status:     api.HealthCritical,
+		},
+		{
+			name:       "expired",
+			notAfter:   time.Now().Add(-day),
+			skipVerify: true,
+			status:     api.HealthCritical,
+			output:     `Certificate "CN=test.example.com" expired at`,
+		},
+		{
+			name:     "untrusted",
+			notAfter: time.Now().Add(60 * day),
+			status:   api.HealthCritical,
+			output:   "certificate",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := startServer(t, tc.notAfter)
+
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+			cid := structs.NewCheckID("foo", nil)
+
+			check := &CheckCertExpiry{
+				CheckID:           cid,
+				CertExpiry:        addr,
+				WarningThreshold:  tc.warning,
+				CriticalThreshold: tc.critical,
+				Interval:          10 * time.Millisecond,
+				Logger:            logger,
+				TLSClientConfig:   &tls.Config{InsecureSkipVerify: tc.skipVerify},
+				StatusHandler:     statusHandler,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, tc.status, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+			})
+		})
+	}
+}
@@ -79,6 +79,9 @@ type CheckMonitor struct {
 	OutputMaxSize int
 	StatusHandler *StatusHandler
 
+	// Sandbox, if set, restricts the environment that the script runs in.
+	Sandbox *exec.Sandbox
+
 	stop     bool
 	stopCh   chan struct{}
 	stopLock sync.Mutex
@@ -159,7 +162,13 @@ func (c *CheckMonitor) check() {
 	}
 
 	// Start the check
-	if err := cmd.Start(); err != nil {
+	var run *exec.SandboxRun
+	if c.Sandbox != nil {
+		run, err = c.Sandbox.Start(cmd)
+	} else {
+		err = cmd.Start()
+	}
+	if err != nil {
 		c.Logger.Error("Check failed to invoke",
 			"check", c.CheckID.String(),
 			"error", err,
@@ -167,6 +176,14 @@ func (c *CheckMonitor) check() {
 		c.Notify.UpdateCheck(c.CheckID, api.HealthCritical, err.Error())
 		return
 	}
+	defer func() {
+		if err := run.Close(); err != nil {
+			c.Logger.Warn("Check failed to clean up its sandbox",
+				"check", c.CheckID.String(),
+				"error", err,
+			)
+		}
+	}()
 
 	// Wait for the check to complete
 	waitCh := make(chan error, 1)
@@ -188,6 +205,9 @@ func (c *CheckMonitor) check() {
 		}
 
 		msg := fmt.Sprintf("Timed out (%s) running check", timeout.String())
+		if throttled := run.Throttled(); throttled > 0 {
+			msg += fmt.Sprintf(", throttled for %s by the sandbox CPU limit", throttled)
+		}
 		c.Logger.Warn("Timed out running check",
 			"check", c.CheckID.String(),
 			"timeout", timeout.String(),
@@ -208,8 +228,22 @@ func (c *CheckMonitor) check() {
 		// The process returned before the timeout, proceed normally
 	}
 
-	// Check if the check passed
+	// Check if the sandbox killed the check
 	outputStr := truncateAndLogOutput()
+	if limit := run.LimitExceeded(); limit != "" {
+		c.Logger.Warn("Check killed by sandbox limit",
+			"check", c.CheckID.String(),
+			"limit", limit,
+		)
+		msg := fmt.Sprintf("Killed for exceeding the sandbox %s", limit)
+		if len(outputStr) > 0 {
+			msg += "\n\n" + outputStr
+		}
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, msg)
+		return
+	}
+
+	// Check if the check passed
 	if err == nil {
 		c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing, outputStr)
 		return
@@ -352,11 +386,18 @@ type CheckHTTP struct {
 	StatusHandler    *StatusHandler
 	DisableRedirects bool
 
-	httpClient *http.Client
-	stop       bool
-	stopCh     chan struct{}
-	stopLock   sync.Mutex
-	stopWg     sync.WaitGroup
+	// ResponseAssertions set the status of the check when the response
+	// matches, overriding the status derived from the status code. The first
+	// assertion that matches is used.
+	ResponseAssertions []structs.HTTPResponseAssertion
+
+	httpClient    *http.Client
+	assertions    []*httpAssertion
+	assertionsErr error
+	stop          bool
+	stopCh        chan struct{}
+	stopLock      sync.Mutex
+	stopWg        sync.WaitGroup
 
 	// Set if checks are exposed through Connect proxies
 	// If set, this is the target of check()
@@ -374,6 +415,8 @@ func (c *CheckHTTP) CheckType() structs.CheckType {
 		ProxyHTTP:     c.ProxyHTTP,
 		Timeout:       c.Timeout,
 		OutputMaxSize: c.OutputMaxSize,
+
+		ResponseAssertions: c.ResponseAssertions,
 	}
 }
 
@@ -409,6 +452,8 @@ func (c *CheckHTTP) Start() {
 		if c.OutputMaxSize < 1 {
 			c.OutputMaxSize = DefaultBufSize
 		}
+
+		c.assertions, c.assertionsErr = compileHTTPAssertions(c.ResponseAssertions)
 	}
 
 	c.stop = false
@@ -491,30 +536,57 @@ func (c *CheckHTTP) check() {
 	}
 	defer resp.Body.Close()
 
-	// Read the response into a circular buffer to limit the size
+	// Read the start of the body for the assertions, and the response into
+	// a circular buffer to limit the size
 	output, _ := circbuf.NewBuffer(int64(c.OutputMaxSize))
-	if _, err := io.Copy(output, resp.Body); err != nil {
+	var body []byte
+	if len(c.assertions) > 0 {
+		body, err = io.ReadAll(io.LimitReader(resp.Body, MaxAssertionBodySize))
+		output.Write(body)
+	}
+	if err == nil {
+		_, err = io.Copy(output, resp.Body)
+	}
+	if err != nil {
 		c.Logger.Warn("Check error while reading body",
 			"check", c.CheckID.String(),
 			"error", err,
 		)
 	}
 
-	// Format the response body
-	result := fmt.Sprintf("HTTP %s %s: %s Output: %s", method, target, resp.Status, output.String())
-
+	var status string
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
 		// PASSING (2xx)
-		c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing, result)
+		status = api.HealthPassing
 	} else if resp.StatusCode == 429 {
 		// WARNING
 		// 429 Too Many Requests (RFC 6585)
 		// The user has sent too many requests in a given amount of time.
-		c.StatusHandler.updateCheck(c.CheckID, api.HealthWarning, result)
+		status = api.HealthWarning
 	} else {
 		// CRITICAL
-		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, result)
+		status = api.HealthCritical
+	}
+
+	// Format the response body
+	result := fmt.Sprintf("HTTP %s %s: %s Output: %s", method, target, resp.Status, output.String())
+
+	// The first assertion that matches the response sets the status
+	if c.assertionsErr != nil {
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, c.assertionsErr.Error())
+		return
 	}
+	r := &httpResponse{header: resp.Header, body: body}
+	for _, a := range c.assertions {
+		if a.matches(r) {
+			status = a.def.Status
+			result = fmt.Sprintf("HTTP %s %s: %s Assertion: %s Output: %s",
+				method, target, resp.Status, a, output.String())
+			break
+		}
+	}
+
+	c.StatusHandler.updateCheck(c.CheckID, status, result)
 }
 
 type CheckH2PING struct {
@@ -1194,6 +1266,7 @@ type StatusHandler struct {
 	failuresBeforeWarning  int
 	failuresBeforeCritical int
 	failuresCounter        int
+	flap                   *flapDamper
 }
 
 // NewStatusHandler set counters values to threshold in order to immediatly update status after first check.
@@ -1209,6 +1282,14 @@ func NewStatusHandler(inner CheckNotifier, logger hclog.Logger, successBeforePas
 	}
 }
 
+// EnableFlapDampening makes the handler hold a flapping check in the warning
+// state. Every status transition adds 1 to a penalty that halves every
+// halfLife. The check is flapping once the penalty reaches suppress and until
+// it decays below reuse, for at most maxSuppress after its last transition.
+func (s *StatusHandler) EnableFlapDampening(halfLife, maxSuppress time.Duration, suppress, reuse int) {
+	s.flap = newFlapDamper(halfLife, maxSuppress, suppress, reuse)
+}
+
 func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output string) {
 
 	if status == api.HealthPassing || status == api.HealthWarning {
@@ -1219,7 +1300,7 @@ func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output stri
 				"check", checkID.String(),
 				"status", status,
 			)
-			s.inner.UpdateCheck(checkID, status, output)
+			s.report(checkID, status, output)
 			return
 		}
 		s.logger.Warn("Check passed but has not reached success threshold",
@@ -1233,13 +1314,13 @@ func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output stri
 		s.successCounter = 0
 		if s.failuresCounter >= s.failuresBeforeCritical {
 			s.logger.Warn("Check is now critical", "check", checkID.String())
-			s.inner.UpdateCheck(checkID, status, output)
+			s.report(checkID, status, output)
 			return
 		}
 		// Defaults to same value as failuresBeforeCritical if not set.
 		if s.failuresCounter >= s.failuresBeforeWarning {
 			s.logger.Warn("Check is now warning", "check", checkID.String())
-			s.inner.UpdateCheck(checkID, api.HealthWarning, output)
+			s.report(checkID, api.HealthWarning, output)
 			return
 		}
 		s.logger.Warn("Check failed but has not reached warning/failure threshold",
@@ -1251,3 +1332,37 @@ func (s *StatusHandler) updateCheck(checkID structs.CheckID, status, output stri
 		)
 	}
 }
+
+// report updates the check with its status, or with the warning status while
+// the check is flapping.
+func (s *StatusHandler) report(checkID structs.CheckID, status, output string) {
+	if s.flap == nil {
+		s.inner.UpdateCheck(checkID, status, output)
+		return
+	}
+
+	wasFlapping := s.flap.flapping
+	flapping := s.flap.update(status)
+	switch {
+	case flapping && !wasFlapping:
+		s.logger.Warn("Check is flapping",
+			"check", checkID.String(),
+			"penalty", s.flap.penalty,
+			"transitions", len(s.flap.transitions),
+		)
+	case !flapping && wasFlapping:
+		s.logger.Info("Check is no longer flapping", "check", checkID.String())
+	}
+
+	state := s.flap.state()
+	if n, ok := s.inner.(CheckFlapNotifier); ok {
+		n.UpdateCheckFlapping(checkID, state)
+	}
+	if flapping {
+		output = fmt.Sprintf("Check is flapping, %d transitions in the last %s, last status %s: %s",
+			state.Transitions, s.flap.maxSuppress, status, output)
+		s.inner.UpdateCheck(checkID, api.HealthWarning, output)
+		return
+	}
+	s.inner.UpdateCheck(checkID, status, output)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package checks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/agent/exec"
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func TestCheckMonitor_Sandbox(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Setenv("SANDBOX_ALLOWED", "yes")
+	t.Setenv("SANDBOX_DENIED", "no")
+
+	tests := []struct {
+		desc    string
+		args    []string
+		sandbox exec.Sandbox
+		root    bool
+		status  string
+		output  string
+	}{
+		{
+			desc:    "env allowlist",
+			args:    []string{"/bin/sh", "-c", "echo ${SANDBOX_ALLOWED:-unset} ${SANDBOX_DENIED:-unset}"},
+			sandbox: exec.Sandbox{EnvAllowlist: []string{"SANDBOX_ALLOW*"}},
+			status:  api.HealthPassing,
+			output:  "yes unset",
+		},
+		{
+			desc:    "no new privileges",
+			args:    []string{"/bin/grep", "NoNewPrivs", "/proc/self/status"},
+			sandbox: exec.Sandbox{NoNewPrivileges: true},
+			status:  api.HealthPassing,
+			output:  "NoNewPrivs:\t1",
+		},
+		{
+			desc:    "user and group",
+			args:    []string{"/bin/sh", "-c", "echo $(id -u) $(id -g) $(id -G)"},
+			sandbox: exec.Sandbox{User: "65534", Group: "65533"},
+			root:    true,
+			status:  api.HealthPassing,
+			output:  "65534 65533 65533",
+		},
+		{
+			desc:    "unknown user",
+			args:    []string{"/bin/true"},
+			sandbox: exec.Sandbox{User: "consul-sandbox-unknown"},
+			status:  api.HealthCritical,
+			output:  "failed to look up user consul-sandbox-unknown",
+		},
+		{
+			desc:    "limits without cgroup parent",
+			args:    []string{"/bin/true"},
+			sandbox: exec.Sandbox{CPUMax: 1},
+			status:  api.HealthCritical,
+			output:  "a cgroup parent is required for CPU and memory limits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if tt.root && os.Getuid() != 0 {
+				t.Skip("must run as root")
+			}
+			notif, cid := runSandboxedCheckMonitor(t, tt.args, tt.sandbox)
+			retry.Run(t, func(r *retry.R) {
+				if got, want := notif.State(cid), tt.status; got != want {
+					r.Fatalf("got state %q want %q", got, want)
+				}
+				if got, want := notif.Output(cid), tt.output; !strings.Contains(got, want) {
+					r.Fatalf("got output %q want %q", got, want)
+				}
+			})
+		})
+	}
+}
+
+func TestCheckMonitor_SandboxMemoryLimit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	// The test needs a cgroup v2 hierarchy that the memory controller can
+	// be enabled in.
+	parent := filepath.Join("/sys/fs/cgroup", "consul-test-"+strings.ReplaceAll(t.Name(), "/", "-"))
+	if err := os.Mkdir(parent, 0755); err != nil {
+		t.Skipf("cannot create cgroup: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(parent) })
+	controllers, err := os.ReadFile(filepath.Join(parent, "cgroup.controllers"))
+	if err != nil || !strings.Contains(string(controllers), "memory") {
+		t.Skip("memory controller of cgroup v2 is not available")
+	}
+
+	notif, cid := runSandboxedCheckMonitor(t, []string{"tail", "/dev/zero"}, exec.Sandbox{
+		CgroupParent: parent,
+		MemoryMax:    16 * 1024 * 1024,
+	})
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notif.State(cid), api.HealthCritical; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+		if got, want := notif.Output(cid), "Killed for exceeding the sandbox memory limit of 16777216 bytes"; !strings.HasPrefix(got, want) {
+			r.Fatalf("got output %q want %q", got, want)
+		}
+	})
+}
+
+func runSandboxedCheckMonitor(t *testing.T, args []string, sandbox exec.Sandbox) (*mock.Notify, structs.CheckID) {
+	notif := mock.NewNotify()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+	cid := structs.NewCheckID("foo", nil)
+
+	check := &CheckMonitor{
+		Notify:        notif,
+		CheckID:       cid,
+		ScriptArgs:    args,
+		Interval:      25 * time.Millisecond,
+		Timeout:       10 * time.Second,
+		OutputMaxSize: DefaultBufSize,
+		Logger:        logger,
+		StatusHandler: statusHandler,
+		Sandbox:       &sandbox,
+	}
+	check.Start()
+	t.Cleanup(check.Stop)
+	return notif, cid
+}
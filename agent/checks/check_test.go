@@ -506,6 +506,150 @@ func TestCheckHTTP_DisableRedirects(t *testing.T) {
 	})
 }
 
+func TestCheckHTTP_ResponseAssertions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Maintenance", "planned")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		fmt.Fprint(w, `{"status": "degraded", "checks": [{"name": "db", "ok": true}, {"name": "cache", "ok": false}]}`)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name       string
+		path       string
+		assertions []structs.HTTPResponseAssertion
+		status     string
+		output     string
+	}{
+		{
+			name:   "no match keeps the status code",
+			path:   "/",
+			status: api.HealthPassing,
+			assertions: []structs.HTTPResponseAssertion{
+				{BodyRegex: "healthy", Status: api.HealthCritical},
+				{JSONPath: "$.missing", Status: api.HealthCritical},
+				{Header: "X-Missing", Status: api.HealthCritical},
+			},
+			output: "HTTP GET " + server.URL + "/: 200 OK Output: {",
+		},
+		{
+			name:   "body regex",
+			path:   "/",
+			status: api.HealthWarning,
+			assertions: []structs.HTTPResponseAssertion{
+				{BodyRegex: `"status":\s*"degraded"`, Status: api.HealthWarning},
+			},
+			output: `Assertion: body matches`,
+		},
+		{
+			name:   "JSONPath",
+			path:   "/",
+			status: api.HealthCritical,
+			assertions: []structs.HTTPResponseAssertion{
+				{JSONPath: "$.status", Match: "^ok$", Status: api.HealthPassing},
+				{JSONPath: "$.checks[*].ok", Match: "^false$", Status: api.HealthCritical},
+				{BodyRegex: "degraded", Status: api.HealthWarning},
+			},
+			output: `Assertion: $.checks[*].ok matches "^false$"`,
+		},
+		{
+			name:   "header on an error",
+			path:   "/down",
+			status: api.HealthWarning,
+			assertions: []structs.HTTPResponseAssertion{
+				{Header: "x-maintenance", Match: "planned", Status: api.HealthWarning},
+			},
+			output: `503 Service Unavailable Assertion: header X-Maintenance matches "planned"`,
+		},
+		{
+			name:   "header present",
+			path:   "/down",
+			status: api.HealthPassing,
+			assertions: []structs.HTTPResponseAssertion{
+				{Header: "X-Maintenance", Status: api.HealthPassing},
+			},
+			output: "Assertion: header X-Maintenance is present",
+		},
+		{
+			name:   "invalid assertion",
+			path:   "/",
+			status: api.HealthCritical,
+			assertions: []structs.HTTPResponseAssertion{
+				{BodyRegex: "(", Status: api.HealthPassing},
+			},
+			output: "invalid response assertion 0: invalid BodyRegex",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+			cid := structs.NewCheckID("foo", nil)
+
+			check := &CheckHTTP{
+				CheckID:            cid,
+				HTTP:               server.URL + tc.path,
+				Interval:           10 * time.Millisecond,
+				Logger:             logger,
+				StatusHandler:      statusHandler,
+				ResponseAssertions: tc.assertions,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, tc.status, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+			})
+		})
+	}
+}
+
+func TestCheckHTTP_ResponseAssertions_OutputMaxSize(t *testing.T) {
+	t.Parallel()
+
+	// The assertions see the start of the body, while the output keeps its
+	// end as before.
+	body := "degraded" + strings.Repeat("x", 2*DefaultBufSize) + "end"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	notif := mock.NewNotify()
+	logger := testutil.Logger(t)
+	statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+	cid := structs.NewCheckID("foo", nil)
+
+	check := &CheckHTTP{
+		CheckID:       cid,
+		HTTP:          server.URL,
+		Interval:      10 * time.Millisecond,
+		OutputMaxSize: DefaultBufSize,
+		Logger:        logger,
+		StatusHandler: statusHandler,
+		ResponseAssertions: []structs.HTTPResponseAssertion{
+			{BodyRegex: "^degraded", Status: api.HealthWarning},
+		},
+	}
+	check.Start()
+	defer check.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, api.HealthWarning, notif.State(cid))
+		output := notif.Output(cid)
+		require.True(r, strings.HasSuffix(output, "xend"), output)
+		require.NotContains(r, output, "Output: degraded")
+	})
+}
+
 func TestCheckHTTPTCP_BigTimeout(t *testing.T) {
 	testCases := []struct {
 		timeoutIn, intervalIn, timeoutWant time.Duration
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+// CheckComposite is a check type that aggregates the health of several
+// members, each of them a check, the instances of a service or the node-level
+// checks of a node, local or remote. The health of a member is the worst
+// health of the checks it selects, and critical when it selects none.
+//
+// In the "all" mode, the default, the check has the worst health of its
+// members. In the "any" mode, the check is passing if any member is passing,
+// warning if any member is warning and critical otherwise. In the "quorum"
+// mode, the check is passing if the weight of the passing members reaches
+// Quorum, warning if the weight of the passing and warning members does and
+// critical otherwise.
+type CheckComposite struct {
+	Mode    string                         // One of the structs.CompositeMode* constants, "all" if empty
+	Quorum  int                            // Weight needed in the "quorum" mode
+	Members []structs.CompositeCheckMember // Members aggregated by the check
+
+	CheckID structs.CheckID             // ID of this check
+	RPC     RPC                         // Used to query remote server if necessary
+	RPCReq  structs.NodeSpecificRequest // Base request
+	Notify  AliasNotifier               // For updating the check state
+
+	// checks and errs hold the latest checks and the latest query error of
+	// each node of the members, keyed by node name, "" for the local node.
+	lock   sync.Mutex
+	checks map[string][]*structs.HealthCheck
+	errs   map[string]error
+
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+	stopWg   sync.WaitGroup
+
+	acl.EnterpriseMeta
+}
+
+// Start is used to start the check, runs until Stop()
+func (c *CheckComposite) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	c.stop = false
+	c.stopCh = make(chan struct{})
+
+	c.lock.Lock()
+	c.checks = make(map[string][]*structs.HealthCheck)
+	c.errs = make(map[string]error)
+	c.lock.Unlock()
+
+	for _, node := range c.nodes() {
+		c.stopWg.Add(1)
+		if node == "" {
+			go c.runLocal(c.stopCh)
+		} else {
+			go c.runQuery(c.stopCh, node)
+		}
+	}
+}
+
+// Stop is used to stop the check.
+func (c *CheckComposite) Stop() {
+	c.stopLock.Lock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+	c.stopLock.Unlock()
+
+	// Wait until the associated goroutines are definitely complete before
+	// returning to the caller, like CheckAlias.Stop.
+	c.stopWg.Wait()
+}
+
+// nodes returns the distinct nodes of the members.
+func (c *CheckComposite) nodes() []string {
+	seen := make(map[string]struct{})
+	var nodes []string
+	for _, m := range c.Members {
+		if _, ok := seen[m.Node]; ok {
+			continue
+		}
+		seen[m.Node] = struct{}{}
+		nodes = append(nodes, m.Node)
+	}
+	return nodes
+}
+
+func (c *CheckComposite) runLocal(stopCh chan struct{}) {
+	defer c.stopWg.Done()
+
+	// Members can select checks of any local service so the check is
+	// notified of the changes to all of them.
+	srcServiceID := structs.NewServiceID(structs.WildcardSpecifier, c.WithWildcardNamespace())
+	runLocalChecks(stopCh, c.Notify, c.CheckID, srcServiceID, c.WithWildcardNamespace(), func(checks []*structs.HealthCheck) {
+		c.update("", checks, nil)
+	})
+}
+
+func (c *CheckComposite) runQuery(stopCh chan struct{}, node string) {
+	defer c.stopWg.Done()
+
+	args := c.RPCReq
+	args.Node = node
+	args.EnterpriseMeta = c.EnterpriseMeta
+
+	onErr := func(err error) {
+		c.update(node, nil, err)
+	}
+	runNodeChecksQuery(stopCh, c.RPC, args, onErr, func(checks []*structs.HealthCheck) {
+		c.update(node, checks, nil)
+	})
+}
+
+// update records the checks or the query error of a node and updates the
+// check once all the nodes of the members have reported.
+func (c *CheckComposite) update(node string, checks []*structs.HealthCheck, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err != nil {
+		c.errs[node] = err
+		c.checks[node] = nil
+	} else {
+		delete(c.errs, node)
+		c.checks[node] = checks
+	}
+	if len(c.checks) < len(c.nodes()) {
+		return
+	}
+
+	status, output := c.processMembers()
+	c.Notify.UpdateCheck(c.CheckID, status, output)
+}
+
+// processMembers computes the health of the check from the latest checks of
+// the nodes of the members. c.lock must be held.
+func (c *CheckComposite) processMembers() (string, string) {
+	var total, passing, warning int
+	lines := make([]string, 0, len(c.Members))
+	for _, m := range c.Members {
+		weight := m.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		total += weight
+
+		status, output := c.processMember(m)
+		switch status {
+		case api.HealthPassing:
+			passing += weight
+		case api.HealthWarning:
+			warning += weight
+		}
+		lines = append(lines, fmt.Sprintf("- %s: %s", describeCompositeMember(m), output))
+	}
+
+	var status, summary string
+	switch c.Mode {
+	case structs.CompositeModeAny:
+		status = api.HealthCritical
+		if passing > 0 {
+			status = api.HealthPassing
+		} else if warning > 0 {
+			status = api.HealthWarning
+		}
+		summary = fmt.Sprintf("Any member passing: weight %d of %d passing", passing, total)
+	case structs.CompositeModeQuorum:
+		status = api.HealthCritical
+		if passing >= c.Quorum {
+			status = api.HealthPassing
+		} else if passing+warning >= c.Quorum {
+			status = api.HealthWarning
+		}
+		summary = fmt.Sprintf("Quorum of %d: weight %d of %d passing", c.Quorum, passing, total)
+	default:
+		status = api.HealthPassing
+		if passing+warning < total {
+			status = api.HealthCritical
+		} else if passing < total {
+			status = api.HealthWarning
+		}
+		summary = fmt.Sprintf("All members passing: weight %d of %d passing", passing, total)
+	}
+
+	return status, summary + "\n" + strings.Join(lines, "\n")
+}
+
+// processMember computes the health of a single member, which is the worst
+// health of the checks it selects.
+func (c *CheckComposite) processMember(m structs.CompositeCheckMember) (string, string) {
+	if err, ok := c.errs[m.Node]; ok {
+		return api.HealthCritical, fmt.Sprintf("critical: Failure checking node: %s", err)
+	}
+
+	var serviceID structs.ServiceID
+	if m.ServiceID != "" {
+		serviceID = structs.NewServiceID(m.ServiceID, &c.EnterpriseMeta)
+	}
+
+	health := api.HealthPassing
+	var failing []string
+	found := false
+	checks := c.checks[m.Node]
+	for _, chk := range checks {
+		// Never aggregate this check, which could otherwise be selected by
+		// a member of its own service.
+		if m.Node == "" && chk.CompoundCheckID() == c.CheckID {
+			continue
+		}
+
+		// Node-level checks are part of the health of the services on the
+		// node as they are for alias checks, but are only enough to find
+		// a member that selects the node itself.
+		nodeLevel := chk.ServiceID == ""
+		switch {
+		case m.CheckID != "":
+			if chk.CheckID != m.CheckID {
+				continue
+			}
+			found = true
+		case m.ServiceID != "":
+			match := serviceID.Matches(chk.CompoundServiceID())
+			if !nodeLevel && !match {
+				continue
+			}
+			found = found || match
+		case m.ServiceName != "":
+			match := chk.ServiceName == m.ServiceName
+			if !nodeLevel && !match {
+				continue
+			}
+			found = found || match
+		default:
+			if !nodeLevel {
+				continue
+			}
+			found = true
+		}
+
+		switch chk.Status {
+		case api.HealthCritical:
+			health = api.HealthCritical
+			failing = append(failing, chk.Name)
+		case api.HealthWarning:
+			if health == api.HealthPassing {
+				health = api.HealthWarning
+			}
+			failing = append(failing, chk.Name)
+		}
+	}
+
+	if !found {
+		return api.HealthCritical, "critical: No checks found"
+	}
+	if len(failing) == 0 {
+		return health, fmt.Sprintf("%s: All checks passing", health)
+	}
+	sort.Strings(failing)
+	return health, fmt.Sprintf("%s: Failing checks: %s", health, strings.Join(failing, ", "))
+}
+
+// describeCompositeMember returns a human readable description of a member
+// for the output of the check.
+func describeCompositeMember(m structs.CompositeCheckMember) string {
+	var desc string
+	switch {
+	case m.CheckID != "":
+		desc = fmt.Sprintf("check %q", m.CheckID)
+	case m.ServiceID != "":
+		desc = fmt.Sprintf("service %q", m.ServiceID)
+	case m.ServiceName != "":
+		desc = fmt.Sprintf("service name %q", m.ServiceName)
+	default:
+		return fmt.Sprintf("node %q", m.Node)
+	}
+	if m.Node != "" {
+		desc += fmt.Sprintf(" on node %q", m.Node)
+	}
+	return desc
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/types"
+)
+
+func TestCheckComposite_local(t *testing.T) {
+	t.Parallel()
+
+	localChecks := []*structs.HealthCheck{
+		{CheckID: "serfHealth", Name: "Serf", Status: api.HealthPassing},
+		{CheckID: "web-1", Name: "web-1", ServiceID: "web-1", ServiceName: "web", Status: api.HealthPassing},
+		{CheckID: "web-2", Name: "web-2", ServiceID: "web-2", ServiceName: "web", Status: api.HealthCritical},
+		{CheckID: "db", Name: "db", ServiceID: "db", ServiceName: "db", Status: api.HealthWarning},
+		// The composite check itself is never aggregated.
+		{CheckID: "foo", Name: "foo", ServiceID: "web-1", ServiceName: "web", Status: api.HealthCritical},
+	}
+
+	cases := []struct {
+		name    string
+		mode    string
+		quorum  int
+		members []structs.CompositeCheckMember
+		status  string
+		output  string
+	}{
+		{
+			name:    "all passing",
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {CheckID: "serfHealth"}},
+			status:  api.HealthPassing,
+			output:  `- service "web-1": passing: All checks passing`,
+		},
+		{
+			name:    "all warning",
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {ServiceName: "db"}},
+			status:  api.HealthWarning,
+			output:  `- service name "db": warning: Failing checks: db`,
+		},
+		{
+			name:    "all critical",
+			mode:    structs.CompositeModeAll,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {ServiceName: "db"}, {ServiceID: "web-2"}},
+			status:  api.HealthCritical,
+			output:  `- service "web-2": critical: Failing checks: web-2`,
+		},
+		{
+			name:    "service name selects all instances",
+			members: []structs.CompositeCheckMember{{ServiceName: "web"}},
+			status:  api.HealthCritical,
+		},
+		{
+			name:    "no checks found",
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {CheckID: "missing"}},
+			status:  api.HealthCritical,
+			output:  `- check "missing": critical: No checks found`,
+		},
+		{
+			name:    "any passing",
+			mode:    structs.CompositeModeAny,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-2"}, {ServiceID: "web-1"}},
+			status:  api.HealthPassing,
+		},
+		{
+			name:    "any warning",
+			mode:    structs.CompositeModeAny,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-2"}, {ServiceID: "db"}},
+			status:  api.HealthWarning,
+		},
+		{
+			name:    "any critical",
+			mode:    structs.CompositeModeAny,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-2"}, {CheckID: "missing"}},
+			status:  api.HealthCritical,
+		},
+		{
+			name:    "quorum reached",
+			mode:    structs.CompositeModeQuorum,
+			quorum:  2,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1", Weight: 2}, {ServiceID: "web-2"}},
+			status:  api.HealthPassing,
+			output:  "Quorum of 2: weight 2 of 3 passing",
+		},
+		{
+			name:    "quorum reached with warnings",
+			mode:    structs.CompositeModeQuorum,
+			quorum:  2,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {ServiceID: "db"}, {ServiceID: "web-2"}},
+			status:  api.HealthWarning,
+		},
+		{
+			name:    "quorum not reached",
+			mode:    structs.CompositeModeQuorum,
+			quorum:  3,
+			members: []structs.CompositeCheckMember{{ServiceID: "web-1"}, {ServiceID: "db"}, {ServiceID: "web-2"}},
+			status:  api.HealthCritical,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			notify := newMockCompositeNotify(localChecks...)
+			chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+			chk := &CheckComposite{
+				Mode:    tc.mode,
+				Quorum:  tc.quorum,
+				Members: tc.members,
+				CheckID: chkID,
+				Notify:  notify,
+				RPC:     &mockRPC{},
+			}
+
+			chk.Start()
+			defer chk.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				if got, want := notify.State(chkID), tc.status; got != want {
+					r.Fatalf("got state %q want %q", got, want)
+				}
+				if got := notify.Output(chkID); !strings.Contains(got, tc.output) {
+					r.Fatalf("got output %q want it to contain %q", got, tc.output)
+				}
+			})
+		})
+	}
+}
+
+func TestCheckComposite_remote(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	notify := newMockCompositeNotify(
+		&structs.HealthCheck{CheckID: "web", ServiceID: "web", ServiceName: "web", Status: api.HealthPassing},
+	)
+	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+	rpc := &mockRPC{}
+	chk := &CheckComposite{
+		Mode: structs.CompositeModeAll,
+		Members: []structs.CompositeCheckMember{
+			{ServiceID: "web"},
+			{Node: "remote", ServiceName: "db"},
+			{Node: "remote"},
+		},
+		CheckID: chkID,
+		Notify:  notify,
+		RPC:     rpc,
+	}
+
+	rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
+		HealthChecks: []*structs.HealthCheck{
+			{Node: "remote", CheckID: "serfHealth", Name: "Serf", Status: api.HealthPassing},
+			{Node: "remote", CheckID: "db", Name: "db", ServiceID: "db-1", ServiceName: "db", Status: api.HealthPassing},
+		},
+	})
+
+	chk.Start()
+	defer chk.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthPassing; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+	})
+
+	// A failing node-level check fails every member on the node. The reply
+	// is stored in place since the check is already running.
+	rpc.Replies["Health.NodeChecks"].Store(structs.IndexedHealthChecks{
+		HealthChecks: []*structs.HealthCheck{
+			{Node: "remote", CheckID: "serfHealth", Name: "Serf", Status: api.HealthCritical},
+			{Node: "remote", CheckID: "db", Name: "db", ServiceID: "db-1", ServiceName: "db", Status: api.HealthPassing},
+		},
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthCritical; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+		want := `- service name "db" on node "remote": critical: Failing checks: Serf`
+		if got := notify.Output(chkID); !strings.Contains(got, want) {
+			r.Fatalf("got output %q want it to contain %q", got, want)
+		}
+	})
+}
+
+func TestCheckComposite_remoteErr(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	notify := newMockCompositeNotify()
+	chkID := structs.NewCheckID(types.CheckID("foo"), nil)
+	rpc := &mockRPC{}
+	chk := &CheckComposite{
+		Mode:    structs.CompositeModeAny,
+		Members: []structs.CompositeCheckMember{{Node: "remote"}},
+		CheckID: chkID,
+		Notify:  notify,
+		RPC:     rpc,
+	}
+
+	rpc.AddReply("Health.NodeChecks", fmt.Errorf("failure"))
+
+	chk.Start()
+	defer chk.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		if got, want := notify.State(chkID), api.HealthCritical; got != want {
+			r.Fatalf("got state %q want %q", got, want)
+		}
+		want := "Failure checking node: failure"
+		if got := notify.Output(chkID); !strings.Contains(got, want) {
+			r.Fatalf("got output %q want it to contain %q", got, want)
+		}
+	})
+}
+
+// mockCompositeNotify is a mockAliasNotify whose local state holds the
+// given checks.
+type mockCompositeNotify struct {
+	*mockAliasNotify
+
+	checks map[structs.CheckID]*structs.HealthCheck
+}
+
+func newMockCompositeNotify(checks ...*structs.HealthCheck) *mockCompositeNotify {
+	m := &mockCompositeNotify{
+		mockAliasNotify: newMockAliasNotify(),
+		checks:          make(map[structs.CheckID]*structs.HealthCheck),
+	}
+	for _, chk := range checks {
+		m.checks[chk.CompoundCheckID()] = chk
+	}
+	return m
+}
+
+func (m *mockCompositeNotify) Checks(*acl.EnterpriseMeta) map[structs.CheckID]*structs.HealthCheck {
+	return m.checks
+}
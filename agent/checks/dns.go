@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CheckDNS is used to periodically resolve a name to determine the health
+// of a given check. DNSServer is the address of the DNS server to query,
+// the system resolver is used when it is empty.
+// The check is critical if the name does not resolve to any address or the
+// lookup takes longer than CriticalThreshold.
+// The check is warning if the lookup takes longer than WarningThreshold.
+// Otherwise the check is passing.
+// Supports failures_before_critical and success_before_passing.
+type CheckDNS struct {
+	CheckID           structs.CheckID
+	ServiceID         structs.ServiceID
+	DNS               string
+	DNSServer         string
+	WarningThreshold  time.Duration
+	CriticalThreshold time.Duration
+	Interval          time.Duration
+	Timeout           time.Duration
+	Logger            hclog.Logger
+	StatusHandler     *StatusHandler
+
+	resolver *net.Resolver
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+	stopWg   sync.WaitGroup
+}
+
+func (c *CheckDNS) CheckType() structs.CheckType {
+	return structs.CheckType{
+		CheckID:           c.CheckID.ID,
+		DNS:               c.DNS,
+		DNSServer:         c.DNSServer,
+		WarningThreshold:  c.WarningThreshold,
+		CriticalThreshold: c.CriticalThreshold,
+		Interval:          c.Interval,
+		Timeout:           c.Timeout,
+	}
+}
+
+// Start is used to start a DNS check.
+// The check runs until stop is called
+func (c *CheckDNS) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+
+	if c.resolver == nil {
+		c.resolver = net.DefaultResolver
+		if c.DNSServer != "" {
+			server := c.DNSServer
+			if _, _, err := net.SplitHostPort(server); err != nil {
+				server = net.JoinHostPort(server, "53")
+			}
+			c.resolver = &net.Resolver{
+				PreferGo: true,
+				Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, network, server)
+				},
+			}
+		}
+	}
+
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	c.stopWg.Add(1)
+	go c.run()
+}
+
+// Stop is used to stop a DNS check.
+func (c *CheckDNS) Stop() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+
+	// Wait for the c.run() goroutine to complete before returning.
+	c.stopWg.Wait()
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckDNS) run() {
+	defer c.stopWg.Done()
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			c.check()
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the DNS check
+func (c *CheckDNS) check() {
+	timeout := 10 * time.Second
+	if c.Timeout > 0 {
+		timeout = c.Timeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	addrs, err := c.resolver.LookupHost(ctx, c.DNS)
+	elapsed := time.Since(start)
+	if err == nil && len(addrs) == 0 {
+		err = fmt.Errorf("no addresses found for %s", c.DNS)
+	}
+	if err != nil {
+		c.Logger.Warn("Check DNS lookup failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	status := api.HealthPassing
+	switch {
+	case c.CriticalThreshold > 0 && elapsed > c.CriticalThreshold:
+		status = api.HealthCritical
+	case c.WarningThreshold > 0 && elapsed > c.WarningThreshold:
+		status = api.HealthWarning
+	}
+	output := fmt.Sprintf("DNS lookup %s: %s in %s", c.DNS, strings.Join(addrs, ", "), elapsed.Round(time.Millisecond))
+	c.StatusHandler.updateCheck(c.CheckID, status, output)
+}
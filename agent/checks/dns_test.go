@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+// startDNSServer starts a DNS server that resolves web.example.com to
+// 192.0.2.10 and returns its address.
+func startDNSServer(t *testing.T) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		PacketConn: pc,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(req)
+			q := req.Question[0]
+			switch {
+			case q.Name != "web.example.com.":
+				m.Rcode = dns.RcodeNameError
+			case q.Qtype == dns.TypeA:
+				m.Answer = append(m.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+					A:   net.ParseIP("192.0.2.10"),
+				})
+			}
+			w.WriteMsg(m)
+		}),
+	}
+	go server.ActivateAndServe()
+	t.Cleanup(func() { server.Shutdown() })
+	return pc.LocalAddr().String()
+}
+
+func TestCheckDNS(t *testing.T) {
+	t.Parallel()
+
+	addr := startDNSServer(t)
+
+	cases := []struct {
+		name     string
+		dns      string
+		warning  time.Duration
+		critical time.Duration
+		status   string
+		output   string
+	}{
+		{
+			name:   "passing",
+			dns:    "web.example.com.",
+			status: api.HealthPassing,
+			output: "DNS lookup web.example.com.: 192.0.2.10 in",
+		},
+		{
+			name:     "below thresholds",
+			dns:      "web.example.com.",
+			warning:  time.Minute,
+			critical: time.Hour,
+			status:   api.HealthPassing,
+		},
+		{
+			name:    "slow warning",
+			dns:     "web.example.com.",
+			warning: time.Nanosecond,
+			status:  api.HealthWarning,
+			output:  "192.0.2.10",
+		},
+		{
+			name:     "slow critical",
+			dns:      "web.example.com.",
+			warning:  time.Nanosecond,
+			critical: time.Nanosecond,
+			status:   api.HealthCritical,
+			output:   "192.0.2.10",
+		},
+		{
+			name:   "not found",
+			dns:    "missing.example.com.",
+			status: api.HealthCritical,
+			output: "missing.example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+			cid := structs.NewCheckID("foo", nil)
+
+			check := &CheckDNS{
+				CheckID:           cid,
+				DNS:               tc.dns,
+				DNSServer:         addr,
+				WarningThreshold:  tc.warning,
+				CriticalThreshold: tc.critical,
+				Interval:          10 * time.Millisecond,
+				Timeout:           time.Second,
+				Logger:            logger,
+				StatusHandler:     statusHandler,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, tc.status, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+			})
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CheckFileFreshness is used to periodically check when a file was last
+// modified, for example a file that a job touches whenever it completes.
+// The check is critical if the file does not exist or was last modified
+// longer than CriticalThreshold ago.
+// The check is warning if the file was last modified longer than
+// WarningThreshold ago.
+// Otherwise the check is passing.
+// Supports failures_before_critical and success_before_passing.
+type CheckFileFreshness struct {
+	CheckID           structs.CheckID
+	ServiceID         structs.ServiceID
+	FileFreshness     string
+	WarningThreshold  time.Duration
+	CriticalThreshold time.Duration
+	Interval          time.Duration
+	Logger            hclog.Logger
+	StatusHandler     *StatusHandler
+
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+	stopWg   sync.WaitGroup
+}
+
+func (c *CheckFileFreshness) CheckType() structs.CheckType {
+	return structs.CheckType{
+		CheckID:           c.CheckID.ID,
+		FileFreshness:     c.FileFreshness,
+		WarningThreshold:  c.WarningThreshold,
+		CriticalThreshold: c.CriticalThreshold,
+		Interval:          c.Interval,
+	}
+}
+
+// Start is used to start a file freshness check.
+// The check runs until stop is called
+func (c *CheckFileFreshness) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	c.stopWg.Add(1)
+	go c.run()
+}
+
+// Stop is used to stop a file freshness check.
+func (c *CheckFileFreshness) Stop() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+
+	// Wait for the c.run() goroutine to complete before returning.
+	c.stopWg.Wait()
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckFileFreshness) run() {
+	defer c.stopWg.Done()
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			c.check()
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the file freshness check
+func (c *CheckFileFreshness) check() {
+	fi, err := os.Stat(c.FileFreshness)
+	if err != nil {
+		c.Logger.Warn("Check file stat failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+
+	// A modification time in the future, for example from clock skew,
+	// counts as fresh.
+	age := time.Since(fi.ModTime())
+	if age < 0 {
+		age = 0
+	}
+
+	status := api.HealthPassing
+	switch {
+	case c.CriticalThreshold > 0 && age > c.CriticalThreshold:
+		status = api.HealthCritical
+	case c.WarningThreshold > 0 && age > c.WarningThreshold:
+		status = api.HealthWarning
+	}
+	output := fmt.Sprintf("File %s: Modified at %s, %s ago",
+		c.FileFreshness, fi.ModTime().UTC().Format(time.RFC3339), age.Round(time.Second))
+	c.StatusHandler.updateCheck(c.CheckID, status, output)
+}
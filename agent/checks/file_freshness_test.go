@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func TestCheckFileFreshness(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.TempDir(t, "file-freshness")
+	file := filepath.Join(dir, "last-run")
+	require.NoError(t, os.WriteFile(file, nil, 0600))
+	modTime := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+
+	cases := []struct {
+		name     string
+		file     string
+		warning  time.Duration
+		critical time.Duration
+		status   string
+		output   string
+	}{
+		{
+			name:   "no thresholds",
+			file:   file,
+			status: api.HealthPassing,
+			output: "File " + file + ": Modified at " + modTime.UTC().Format(time.RFC3339) + ", 2h0m",
+		},
+		{
+			name:     "fresh",
+			file:     file,
+			warning:  3 * time.Hour,
+			critical: 4 * time.Hour,
+			status:   api.HealthPassing,
+		},
+		{
+			name:     "warning",
+			file:     file,
+			warning:  time.Hour,
+			critical: 4 * time.Hour,
+			status:   api.HealthWarning,
+		},
+		{
+			name:     "critical",
+			file:     file,
+			warning:  30 * time.Minute,
+			critical: time.Hour,
+			status:   api.HealthCritical,
+		},
+		{
+			name:     "missing",
+			file:     filepath.Join(dir, "missing"),
+			critical: time.Hour,
+			status:   api.HealthCritical,
+			output:   "no such file or directory",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			statusHandler := NewStatusHandler(notif, logger, 0, 0, 0)
+			cid := structs.NewCheckID("foo", nil)
+
+			check := &CheckFileFreshness{
+				CheckID:           cid,
+				FileFreshness:     tc.file,
+				WarningThreshold:  tc.warning,
+				CriticalThreshold: tc.critical,
+				Interval:          10 * time.Millisecond,
+				Logger:            logger,
+				StatusHandler:     statusHandler,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, tc.status, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+			})
+		})
+	}
+}
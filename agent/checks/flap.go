@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"math"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// CheckFlapNotifier is implemented by the CheckNotifier of a StatusHandler
+// that wants to be notified of the flap dampening state of its checks.
+type CheckFlapNotifier interface {
+	UpdateCheckFlapping(checkID structs.CheckID, state *structs.CheckFlapState)
+}
+
+// flapDamper detects a flapping check the way BGP dampens flapping routes.
+// Every status transition adds 1 to a penalty that decays exponentially, the
+// check is flapping once the penalty reaches the suppress threshold and until
+// it decays below the reuse threshold. The penalty is capped so that a check
+// stops flapping at most maxSuppress after its last transition.
+type flapDamper struct {
+	halfLife    time.Duration
+	maxSuppress time.Duration
+	suppress    float64
+	reuse       float64
+	maxPenalty  float64
+
+	penalty     float64
+	decayed     time.Time   // when the penalty was last decayed
+	status      string      // last status reported by the check
+	transitions []time.Time // transitions within the last maxSuppress
+	flapping    bool
+
+	now func() time.Time // for testing
+}
+
+func newFlapDamper(halfLife, maxSuppress time.Duration, suppress, reuse int) *flapDamper {
+	return &flapDamper{
+		halfLife:    halfLife,
+		maxSuppress: maxSuppress,
+		suppress:    float64(suppress),
+		reuse:       float64(reuse),
+		maxPenalty:  float64(reuse) * math.Exp2(float64(maxSuppress)/float64(halfLife)),
+		now:         time.Now,
+	}
+}
+
+// update records a status reported by the check and returns whether the
+// check is flapping.
+func (f *flapDamper) update(status string) bool {
+	now := f.now()
+	if !f.decayed.IsZero() {
+		f.penalty *= math.Exp2(-float64(now.Sub(f.decayed)) / float64(f.halfLife))
+	}
+	f.decayed = now
+
+	if f.status != "" && f.status != status {
+		f.penalty = math.Min(f.penalty+1, f.maxPenalty)
+		f.transitions = append(f.transitions, now)
+	}
+	f.status = status
+
+	// Slide the window of transitions.
+	i := 0
+	for i < len(f.transitions) && now.Sub(f.transitions[i]) > f.maxSuppress {
+		i++
+	}
+	f.transitions = f.transitions[i:]
+
+	if f.flapping {
+		f.flapping = f.penalty >= f.reuse
+	} else {
+		f.flapping = f.penalty >= f.suppress
+	}
+	return f.flapping
+}
+
+func (f *flapDamper) state() *structs.CheckFlapState {
+	return &structs.CheckFlapState{
+		Flapping:    f.flapping,
+		Penalty:     f.penalty,
+		Transitions: len(f.transitions),
+		Status:      f.status,
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestStatusHandler_FlapDampening(t *testing.T) {
+	t.Parallel()
+	cid := structs.NewCheckID("foo", nil)
+	notif := newMockFlapNotify()
+	statusHandler := NewStatusHandler(notif, testutil.Logger(t), 0, 0, 0)
+	statusHandler.EnableFlapDampening(time.Minute, 4*time.Minute, 3, 1)
+
+	now := time.Now()
+	statusHandler.flap.now = func() time.Time { return now }
+
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthPassing, notif.State(cid))
+	require.Equal(t, &structs.CheckFlapState{Status: api.HealthPassing}, notif.Flapping(cid))
+
+	// The first transitions are reported as usual.
+	statusHandler.updateCheck(cid, api.HealthCritical, "down")
+	require.Equal(t, api.HealthCritical, notif.State(cid))
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthPassing, notif.State(cid))
+
+	// The check is flapping once the penalty reaches the suppress threshold.
+	statusHandler.updateCheck(cid, api.HealthCritical, "down")
+	require.Equal(t, api.HealthWarning, notif.State(cid))
+	require.Equal(t, "Check is flapping, 3 transitions in the last 4m0s, last status critical: down", notif.Output(cid))
+	require.Equal(t, &structs.CheckFlapState{Flapping: true, Penalty: 3, Transitions: 3, Status: api.HealthCritical}, notif.Flapping(cid))
+
+	// The penalty halves every half-life and the check keeps flapping until
+	// it decays below the reuse threshold.
+	now = now.Add(time.Minute)
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthWarning, notif.State(cid))
+	require.InDelta(t, 2.5, notif.Flapping(cid).Penalty, 0.001)
+
+	now = now.Add(time.Minute)
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthWarning, notif.State(cid))
+	require.True(t, notif.Flapping(cid).Flapping)
+
+	now = now.Add(time.Minute)
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthPassing, notif.State(cid))
+	require.Equal(t, "ok", notif.Output(cid))
+	require.False(t, notif.Flapping(cid).Flapping)
+	require.InDelta(t, 0.625, notif.Flapping(cid).Penalty, 0.001)
+}
+
+func TestStatusHandler_FlapDampening_MaxSuppress(t *testing.T) {
+	t.Parallel()
+	cid := structs.NewCheckID("foo", nil)
+	notif := newMockFlapNotify()
+	statusHandler := NewStatusHandler(notif, testutil.Logger(t), 0, 0, 0)
+	statusHandler.EnableFlapDampening(time.Minute, 4*time.Minute, 3, 1)
+
+	now := time.Now()
+	statusHandler.flap.now = func() time.Time { return now }
+
+	statuses := []string{api.HealthPassing, api.HealthCritical}
+	for i := 0; i < 21; i++ {
+		statusHandler.updateCheck(cid, statuses[i%2], "")
+	}
+	require.Equal(t, api.HealthWarning, notif.State(cid))
+
+	// The penalty is capped so that the check stops flapping at most
+	// max suppress after its last transition.
+	state := notif.Flapping(cid)
+	require.Equal(t, 20, state.Transitions)
+	require.Equal(t, float64(16), state.Penalty)
+
+	now = now.Add(4*time.Minute + time.Second)
+	statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+	require.Equal(t, api.HealthPassing, notif.State(cid))
+
+	// The transitions slide out of the window.
+	state = notif.Flapping(cid)
+	require.False(t, state.Flapping)
+	require.Equal(t, 0, state.Transitions)
+}
+
+func TestStatusHandler_FlapDampening_AfterThresholds(t *testing.T) {
+	t.Parallel()
+	cid := structs.NewCheckID("foo", nil)
+	notif := newMockFlapNotify()
+	statusHandler := NewStatusHandler(notif, testutil.Logger(t), 0, 2, 2)
+	statusHandler.EnableFlapDampening(time.Minute, 4*time.Minute, 3, 1)
+
+	now := time.Now()
+	statusHandler.flap.now = func() time.Time { return now }
+
+	// Failures that do not reach failures_before_critical are not
+	// transitions.
+	for i := 0; i < 5; i++ {
+		statusHandler.updateCheck(cid, api.HealthPassing, "ok")
+		statusHandler.updateCheck(cid, api.HealthCritical, "down")
+	}
+	require.Equal(t, api.HealthPassing, notif.State(cid))
+	require.Equal(t, 0, notif.Flapping(cid).Transitions)
+}
+
+// mockFlapNotify is a mock.Notify that records the flap dampening state of
+// checks.
+type mockFlapNotify struct {
+	*mock.Notify
+
+	lock     sync.Mutex
+	flapping map[structs.CheckID]*structs.CheckFlapState
+}
+
+func newMockFlapNotify() *mockFlapNotify {
+	return &mockFlapNotify{
+		Notify:   mock.NewNotify(),
+		flapping: make(map[structs.CheckID]*structs.CheckFlapState),
+	}
+}
+
+func (m *mockFlapNotify) UpdateCheckFlapping(checkID structs.CheckID, state *structs.CheckFlapState) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.flapping[checkID] = state
+}
+
+func (m *mockFlapNotify) Flapping(checkID structs.CheckID) *structs.CheckFlapState {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.flapping[checkID]
+}
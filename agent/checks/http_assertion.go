@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib/jsonpath"
+)
+
+// MaxAssertionBodySize is the size of the response body that the response
+// assertions of an HTTP check are evaluated against. The rest of the body is
+// ignored, so a larger JSON body does not match any JSONPath assertion.
+const MaxAssertionBodySize = 1024 * 1024 // 1MB
+
+// httpAssertion is a compiled structs.HTTPResponseAssertion.
+type httpAssertion struct {
+	def   structs.HTTPResponseAssertion
+	body  *regexp.Regexp
+	path  *jsonpath.Path
+	match *regexp.Regexp
+}
+
+func compileHTTPAssertions(defs []structs.HTTPResponseAssertion) ([]*httpAssertion, error) {
+	assertions := make([]*httpAssertion, 0, len(defs))
+	for i, def := range defs {
+		if err := def.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid response assertion %d: %v", i, err)
+		}
+		a := &httpAssertion{def: def}
+		if def.BodyRegex != "" {
+			a.body = regexp.MustCompile(def.BodyRegex)
+		}
+		if def.JSONPath != "" {
+			a.path, _ = jsonpath.Parse(def.JSONPath)
+		}
+		if def.Match != "" {
+			a.match = regexp.MustCompile(def.Match)
+		}
+		assertions = append(assertions, a)
+	}
+	return assertions, nil
+}
+
+// httpResponse is the part of a response that assertions are evaluated
+// against. The body is only decoded as JSON once, when a JSONPath assertion
+// needs it.
+type httpResponse struct {
+	header http.Header
+	body   []byte
+
+	decoded bool
+	doc     interface{}
+	docErr  error
+}
+
+func (r *httpResponse) json() (interface{}, error) {
+	if !r.decoded {
+		r.decoded = true
+		dec := json.NewDecoder(bytes.NewReader(r.body))
+		dec.UseNumber()
+		r.docErr = dec.Decode(&r.doc)
+	}
+	return r.doc, r.docErr
+}
+
+// matches returns whether the response matches the assertion.
+func (a *httpAssertion) matches(resp *httpResponse) bool {
+	switch {
+	case a.body != nil:
+		return a.body.Match(resp.body)
+
+	case a.path != nil:
+		doc, err := resp.json()
+		if err != nil {
+			return false
+		}
+		for _, v := range a.path.Get(doc) {
+			if a.match == nil || a.match.MatchString(jsonValueString(v)) {
+				return true
+			}
+		}
+
+	default:
+		for _, v := range resp.header.Values(a.def.Header) {
+			if a.match == nil || a.match.MatchString(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// String describes the assertion in the output of the check.
+func (a *httpAssertion) String() string {
+	var source string
+	switch {
+	case a.body != nil:
+		return fmt.Sprintf("body matches %q", a.def.BodyRegex)
+	case a.path != nil:
+		source = a.def.JSONPath
+	default:
+		source = "header " + http.CanonicalHeaderKey(a.def.Header)
+	}
+	if a.match == nil {
+		return source + " is present"
+	}
+	return fmt.Sprintf("%s matches %q", source, a.def.Match)
+}
+
+// jsonValueString returns a string as is, and other values as JSON, so that
+// a Match of "^true$" or "^42$" works with the values they look like.
+func jsonValueString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CheckOutlier is a passive check that periodically fetches the upstream
+// hosts that the outlier detection of a local sidecar proxy ejected from the
+// Envoy admin API, and publishes them in the MetaCheckOutlierEjected metadata
+// of the check.
+// When the service of the check opts in with the OutlierHealthCheck of its
+// service-defaults config entry, the check watches the outlier checks of the
+// downstream proxies of the service with blocking queries, and is warning
+// while at least MinProxies of them list one of Addresses as ejected.
+// Otherwise the check is passing.
+// The check is critical if the Envoy admin API or the servers cannot be
+// reached.
+type CheckOutlier struct {
+	CheckID       structs.CheckID
+	ServiceID     structs.ServiceID
+	Node          string   // Node name of this agent
+	ServiceName   string   // Name of the service that opts in, the destination of a proxy
+	Addresses     []string // Addresses that other proxies reach the service at, as host:port
+	Outlier       string   // Address of the Envoy admin API, as host:port
+	Interval      time.Duration
+	Logger        hclog.Logger
+	Notify        OutlierNotifier // For updating the check metadata
+	StatusHandler *StatusHandler
+
+	RPC    RPC                       // Used to query the servers
+	RPCReq structs.DCSpecificRequest // Base request
+
+	httpClient *http.Client
+
+	// The results of the blocking queries, guarded by lock. A nil error and
+	// a false fetched flag mean that the first query did not return yet.
+	lock             sync.Mutex
+	config           *structs.OutlierHealthCheck
+	configFetched    bool
+	configErr        error
+	reporters        []string
+	reportersFetched bool
+	reportersErr     error
+
+	// Closed to stop watching the downstream proxies, nil while they are
+	// not watched.
+	reportersStopCh   chan struct{}
+	reportersStopLock sync.Mutex
+
+	stop     bool
+	stopCh   chan struct{}
+	stopLock sync.Mutex
+	stopWg   sync.WaitGroup
+
+	acl.EnterpriseMeta
+}
+
+// OutlierNotifier is a CheckNotifier specifically for the Outlier check.
+// This requires additional methods that are satisfied by the agent
+// local state.
+type OutlierNotifier interface {
+	CheckNotifier
+
+	UpdateCheckMeta(checkID structs.CheckID, key, value string)
+}
+
+func (c *CheckOutlier) CheckType() structs.CheckType {
+	return structs.CheckType{
+		CheckID:  c.CheckID.ID,
+		Outlier:  c.Outlier,
+		Interval: c.Interval,
+	}
+}
+
+// Start is used to start an outlier check.
+// The check runs until stop is called
+func (c *CheckOutlier) Start() {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	if c.httpClient == nil {
+		trans := cleanhttp.DefaultTransport()
+		trans.DisableKeepAlives = true
+		c.httpClient = &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: trans,
+		}
+	}
+	c.stop = false
+	c.stopCh = make(chan struct{})
+	c.stopWg.Add(1)
+	go c.run()
+	go c.watchConfig(c.stopCh)
+}
+
+// Stop is used to stop an outlier check.
+func (c *CheckOutlier) Stop() {
+	c.stopLock.Lock()
+	if !c.stop {
+		c.stop = true
+		close(c.stopCh)
+	}
+	c.stopLock.Unlock()
+
+	// Wait for the c.run() goroutine to complete before returning. The
+	// goroutines of the blocking queries return once their current query
+	// does, and never update the check.
+	c.stopWg.Wait()
+}
+
+// run is invoked by a goroutine to run until Stop() is called
+func (c *CheckOutlier) run() {
+	defer c.stopWg.Done()
+	// Get the randomized initial pause time
+	initialPauseTime := lib.RandomStagger(c.Interval)
+	next := time.After(initialPauseTime)
+	for {
+		select {
+		case <-next:
+			c.check()
+			next = time.After(c.Interval)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// check is invoked periodically to perform the outlier check
+func (c *CheckOutlier) check() {
+	ejected, err := c.ejections()
+	if err != nil {
+		c.Logger.Warn("Check outlier ejections failed",
+			"check", c.CheckID.String(),
+			"error", err,
+		)
+		c.Notify.UpdateCheckMeta(c.CheckID, structs.MetaCheckOutlierEjected, "")
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical, err.Error())
+		return
+	}
+	sort.Strings(ejected)
+	c.Notify.UpdateCheckMeta(c.CheckID, structs.MetaCheckOutlierEjected, strings.Join(ejected, ","))
+
+	report := "Ejected upstream hosts: none"
+	if len(ejected) > 0 {
+		report = "Ejected upstream hosts: " + strings.Join(ejected, ", ")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	switch {
+	case c.configErr != nil:
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical,
+			fmt.Sprintf("Failure fetching service-defaults of service %q: %s\n%s", c.ServiceName, c.configErr, report))
+		return
+	case !c.configFetched:
+		return
+	case c.config == nil:
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthPassing,
+			fmt.Sprintf("Outlier health checks are not enabled for service %q\n%s", c.ServiceName, report))
+		return
+	case c.reportersErr != nil:
+		c.StatusHandler.updateCheck(c.CheckID, api.HealthCritical,
+			fmt.Sprintf("Failure fetching downstream proxies of service %q: %s\n%s", c.ServiceName, c.reportersErr, report))
+		return
+	case !c.reportersFetched:
+		return
+	}
+
+	minProxies := c.config.MinProxies
+	if minProxies == 0 {
+		minProxies = 1
+	}
+	status := api.HealthPassing
+	if len(c.reporters) >= minProxies {
+		status = api.HealthWarning
+	}
+	summary := fmt.Sprintf("%d of %d proxies needed report this instance ejected", len(c.reporters), minProxies)
+	if len(c.reporters) > 0 {
+		summary += ": " + strings.Join(c.reporters, ", ")
+	}
+	c.StatusHandler.updateCheck(c.CheckID, status, summary+"\n"+report)
+}
+
+// ejections returns the upstream hosts ejected by the outlier detection of
+// the proxy.
+func (c *CheckOutlier) ejections() ([]string, error) {
+	if _, _, err := net.SplitHostPort(c.Outlier); err != nil {
+		return nil, fmt.Errorf("Invalid Envoy admin address %q: %w", c.Outlier, err)
+	}
+
+	clusters, err := c.envoyClusters()
+	if err != nil {
+		return nil, fmt.Errorf("Failure fetching outlier ejections from Envoy admin API at %s: %w", c.Outlier, err)
+	}
+	return outlierEjections(clusters), nil
+}
+
+// envoyClusters fetches the status of the clusters of the proxy from the
+// /clusters endpoint of the Envoy admin API.
+func (c *CheckOutlier) envoyClusters() (*envoy_admin_v3.Clusters, error) {
+	req, err := http.NewRequest("GET", "http://"+c.Outlier+"/clusters?format=json", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status %s", resp.Status)
+	}
+
+	clusters := &envoy_admin_v3.Clusters{}
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := unmarshal.Unmarshal(body, clusters); err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+// outlierEjections returns the addresses, as host:port, of the upstream hosts
+// that Envoy currently ejects from the load balancing pool of any of its
+// clusters because they failed outlier detection.
+func outlierEjections(clusters *envoy_admin_v3.Clusters) []string {
+	seen := make(map[string]struct{})
+	ejected := []string{}
+	for _, cs := range clusters.GetClusterStatuses() {
+		if cs.Name == "local_app" || cs.Name == "local_agent" {
+			continue
+		}
+		for _, hs := range cs.GetHostStatuses() {
+			if !hs.GetHealthStatus().GetFailedOutlierCheck() {
+				continue
+			}
+			sa := hs.GetAddress().GetSocketAddress()
+			if sa == nil {
+				continue
+			}
+			addr := net.JoinHostPort(sa.GetAddress(), strconv.Itoa(int(sa.GetPortValue())))
+			if _, ok := seen[addr]; ok {
+				continue
+			}
+			seen[addr] = struct{}{}
+			ejected = append(ejected, addr)
+		}
+	}
+	sort.Strings(ejected)
+	return ejected
+}
+
+// watchConfig watches the OutlierHealthCheck of the service-defaults config
+// entry of the service with blocking queries until stopCh is closed, and
+// watches the outlier checks of the downstream proxies while it is set.
+func (c *CheckOutlier) watchConfig(stopCh <-chan struct{}) {
+	defer c.stopWatchReporters()
+
+	args := structs.ConfigEntryQuery{
+		Kind:           structs.ServiceDefaults,
+		Name:           c.ServiceName,
+		Datacenter:     c.RPCReq.Datacenter,
+		EnterpriseMeta: c.EnterpriseMeta,
+		QueryOptions:   c.RPCReq.QueryOptions,
+	}
+	args.AllowStale = true
+	args.MaxQueryTime = 1 * time.Minute
+
+	onErr := func(err error) {
+		c.lock.Lock()
+		c.configErr = err
+		c.lock.Unlock()
+	}
+	runBlockingQuery(stopCh, onErr, func(minIndex uint64) (uint64, error) {
+		args.MinQueryIndex = minIndex
+
+		var out structs.ConfigEntryResponse
+		if err := c.RPC.RPC(context.Background(), "ConfigEntry.Get", &args, &out); err != nil {
+			return 0, err
+		}
+		var config *structs.OutlierHealthCheck
+		if entry, ok := out.Entry.(*structs.ServiceConfigEntry); ok {
+			config = entry.OutlierHealthCheck
+		}
+
+		if config == nil {
+			c.stopWatchReporters()
+		} else {
+			c.startWatchReporters()
+		}
+		c.lock.Lock()
+		c.config, c.configFetched, c.configErr = config, true, nil
+		c.lock.Unlock()
+		return out.Index, nil
+	})
+}
+
+// startWatchReporters starts watching the outlier checks of the downstream
+// proxies of the service, unless they are already watched.
+func (c *CheckOutlier) startWatchReporters() {
+	c.reportersStopLock.Lock()
+	defer c.reportersStopLock.Unlock()
+	if c.reportersStopCh != nil {
+		return
+	}
+	c.reportersStopCh = make(chan struct{})
+	go c.watchReporters(c.reportersStopCh)
+}
+
+// stopWatchReporters stops watching the outlier checks of the downstream
+// proxies of the service, and forgets the last result.
+func (c *CheckOutlier) stopWatchReporters() {
+	c.reportersStopLock.Lock()
+	defer c.reportersStopLock.Unlock()
+	if c.reportersStopCh == nil {
+		return
+	}
+	close(c.reportersStopCh)
+	c.reportersStopCh = nil
+
+	c.lock.Lock()
+	c.reporters, c.reportersFetched, c.reportersErr = nil, false, nil
+	c.lock.Unlock()
+}
+
+// watchReporters watches the outlier checks of the downstream proxies of the
+// service with blocking queries until stopCh is closed, and records those that
+// list one of the addresses of the service as ejected.
+func (c *CheckOutlier) watchReporters(stopCh <-chan struct{}) {
+	args := structs.ServiceSpecificRequest{
+		Datacenter:     c.RPCReq.Datacenter,
+		ServiceName:    c.ServiceName,
+		ServiceKind:    structs.ServiceKindTypical,
+		EnterpriseMeta: c.EnterpriseMeta,
+		QueryOptions:   c.RPCReq.QueryOptions,
+	}
+	args.AllowStale = true
+	args.MaxQueryTime = 1 * time.Minute
+
+	onErr := func(err error) {
+		c.lock.Lock()
+		c.reportersErr = err
+		c.lock.Unlock()
+	}
+	runBlockingQuery(stopCh, onErr, func(minIndex uint64) (uint64, error) {
+		args.MinQueryIndex = minIndex
+
+		var out structs.IndexedServiceTopology
+		if err := c.RPC.RPC(context.Background(), "Internal.ServiceTopology", &args, &out); err != nil {
+			return 0, err
+		}
+		var downstreams structs.CheckServiceNodes
+		if out.ServiceTopology != nil {
+			downstreams = out.ServiceTopology.Downstreams
+		}
+		reporters := c.outlierReporters(downstreams)
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		select {
+		case <-stopCh:
+			// The result of a query that was stopped is stale.
+		default:
+			c.reporters, c.reportersFetched, c.reportersErr = reporters, true, nil
+		}
+		return out.Index, nil
+	})
+}
+
+// outlierReporters returns the outlier checks of the downstream proxies
+// whose metadata lists one of the addresses of the service as ejected.
+func (c *CheckOutlier) outlierReporters(downstreams structs.CheckServiceNodes) []string {
+	addrs := make(map[string]struct{}, len(c.Addresses))
+	for _, addr := range c.Addresses {
+		addrs[addr] = struct{}{}
+	}
+
+	var reporters []string
+	for _, csn := range downstreams {
+		for _, chk := range csn.Checks {
+			if chk.Type != "outlier" {
+				continue
+			}
+			if chk.Node == c.Node && chk.CompoundCheckID() == c.CheckID {
+				continue
+			}
+			for _, addr := range strings.Split(chk.Meta[structs.MetaCheckOutlierEjected], ",") {
+				if _, ok := addrs[addr]; ok {
+					reporters = append(reporters, fmt.Sprintf("%q on node %q", chk.ServiceID, chk.Node))
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(reporters)
+	return reporters
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+// outlierEnvoyClusters is a /clusters response of the Envoy admin API whose
+// outlier detection ejected 10.0.0.3:21000.
+const outlierEnvoyClusters = `{
+  "cluster_statuses": [
+    {
+      "name": "api.default.dc1.internal.domain.consul",
+      "host_statuses": [
+        {
+          "address": {"socket_address": {"address": "10.0.0.3", "port_value": 21000}},
+          "health_status": {"eds_health_status": "HEALTHY", "failed_outlier_check": true}
+        },
+        {
+          "address": {"socket_address": {"address": "10.0.0.4", "port_value": 21000}},
+          "health_status": {"eds_health_status": "HEALTHY"}
+        }
+      ]
+    }
+  ]
+}`
+
+func TestCheckOutlier(t *testing.T) {
+	t.Parallel()
+
+	envoy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(outlierEnvoyClusters))
+	}))
+	t.Cleanup(envoy.Close)
+
+	// The downstream proxies of the service, whose outlier checks list the
+	// hosts ejected by their proxy in their metadata.
+	downstreams := structs.CheckServiceNodes{
+		{
+			Node:    &structs.Node{Node: "local"},
+			Service: &structs.NodeService{ID: "foo"},
+			Checks: structs.HealthChecks{
+				// The check itself is never a reporter.
+				{Node: "local", CheckID: "foo", Type: "outlier", Meta: outlierEjectedMeta("10.0.0.1:21000")},
+			},
+		},
+		{
+			Node:    &structs.Node{Node: "node1"},
+			Service: &structs.NodeService{ID: "web-sidecar-proxy"},
+			Checks: structs.HealthChecks{
+				{Node: "node1", CheckID: "serfHealth", Type: "", Meta: outlierEjectedMeta("10.0.0.1:21000")},
+				{Node: "node1", CheckID: "outlier", ServiceID: "web-sidecar-proxy", Type: "outlier", Meta: outlierEjectedMeta("10.0.0.2:21000,10.0.0.1:21000")},
+			},
+		},
+		{
+			Node:    &structs.Node{Node: "node2"},
+			Service: &structs.NodeService{ID: "web-sidecar-proxy"},
+			Checks: structs.HealthChecks{
+				{Node: "node2", CheckID: "outlier", ServiceID: "web-sidecar-proxy", Type: "outlier", Meta: outlierEjectedMeta("10.0.0.1:21000")},
+			},
+		},
+		{
+			Node:    &structs.Node{Node: "node3"},
+			Service: &structs.NodeService{ID: "web-sidecar-proxy"},
+			Checks: structs.HealthChecks{
+				{Node: "node3", CheckID: "outlier", ServiceID: "web-sidecar-proxy", Type: "outlier"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name   string
+		entry  structs.ConfigEntry
+		status string
+		output string
+	}{
+		{
+			name:   "not enabled",
+			status: api.HealthPassing,
+			output: "Outlier health checks are not enabled for service \"api\"\nEjected upstream hosts: 10.0.0.3:21000",
+		},
+		{
+			name:   "enough proxies",
+			entry:  &structs.ServiceConfigEntry{Name: "api", OutlierHealthCheck: &structs.OutlierHealthCheck{}},
+			status: api.HealthWarning,
+			output: `2 of 1 proxies needed report this instance ejected: "web-sidecar-proxy" on node "node1", "web-sidecar-proxy" on node "node2"`,
+		},
+		{
+			name:   "not enough proxies",
+			entry:  &structs.ServiceConfigEntry{Name: "api", OutlierHealthCheck: &structs.OutlierHealthCheck{MinProxies: 3}},
+			status: api.HealthPassing,
+			output: "2 of 3 proxies needed",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rpc := newMockOutlierRPC()
+			rpc.entries.AddReply("ConfigEntry.Get", structs.ConfigEntryResponse{Entry: tc.entry})
+			rpc.topology.AddReply("Internal.ServiceTopology", structs.IndexedServiceTopology{
+				ServiceTopology: &structs.ServiceTopology{Downstreams: downstreams},
+			})
+
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			cid := structs.NewCheckID("foo", nil)
+			check := &CheckOutlier{
+				CheckID:       cid,
+				Node:          "local",
+				ServiceName:   "api",
+				Addresses:     []string{"10.0.0.1:21000"},
+				Outlier:       strings.TrimPrefix(envoy.URL, "http://"),
+				Interval:      10 * time.Millisecond,
+				Logger:        logger,
+				Notify:        notif,
+				StatusHandler: NewStatusHandler(notif, logger, 0, 0, 0),
+				RPC:           rpc,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, tc.status, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+				require.Equal(r, "10.0.0.3:21000", notif.Meta(cid, structs.MetaCheckOutlierEjected))
+			})
+		})
+	}
+}
+
+func TestCheckOutlier_Errors(t *testing.T) {
+	t.Parallel()
+
+	envoy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(outlierEnvoyClusters))
+	}))
+	t.Cleanup(envoy.Close)
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notFound.Close)
+
+	cases := []struct {
+		name    string
+		outlier string
+		output  string
+	}{
+		{
+			name:    "envoy unreachable",
+			outlier: strings.TrimPrefix(envoy.URL, "http://") + "0",
+			output:  "Failure fetching outlier ejections from Envoy admin API",
+		},
+		{
+			name:    "envoy admin API not found",
+			outlier: strings.TrimPrefix(notFound.URL, "http://"),
+			output:  "HTTP status 404 Not Found",
+		},
+		{
+			name:    "invalid address",
+			outlier: "127.0.0.1",
+			output:  `Invalid Envoy admin address "127.0.0.1"`,
+		},
+		{
+			name:    "servers unreachable",
+			outlier: strings.TrimPrefix(envoy.URL, "http://"),
+			output:  "Failure fetching service-defaults of service \"api\": failure\nEjected upstream hosts: 10.0.0.3:21000",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rpc := newMockOutlierRPC()
+			rpc.entries.AddReply("ConfigEntry.Get", fmt.Errorf("failure"))
+
+			notif := mock.NewNotify()
+			logger := testutil.Logger(t)
+			cid := structs.NewCheckID("foo", nil)
+			check := &CheckOutlier{
+				CheckID:       cid,
+				ServiceName:   "api",
+				Outlier:       tc.outlier,
+				Interval:      10 * time.Millisecond,
+				Logger:        logger,
+				Notify:        notif,
+				StatusHandler: NewStatusHandler(notif, logger, 0, 0, 0),
+				RPC:           rpc,
+			}
+			check.Start()
+			defer check.Stop()
+
+			retry.Run(t, func(r *retry.R) {
+				require.Equal(r, api.HealthCritical, notif.State(cid))
+				require.Contains(r, notif.Output(cid), tc.output)
+			})
+		})
+	}
+}
+
+func TestCheckOutlier_Ejections(t *testing.T) {
+	t.Parallel()
+
+	envoy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/clusters", r.URL.Path)
+		require.Equal(t, "json", r.URL.Query().Get("format"))
+		w.Write([]byte(`{
+  "cluster_statuses": [
+    {
+      "name": "local_app",
+      "host_statuses": [
+        {
+          "address": {"socket_address": {"address": "127.0.0.1", "port_value": 8080}},
+          "health_status": {"failed_outlier_check": true}
+        }
+      ]
+    },
+    {
+      "name": "db.default.dc1.internal.domain.consul",
+      "host_statuses": [
+        {
+          "address": {"socket_address": {"address": "10.0.0.2", "port_value": 21000}},
+          "health_status": {"eds_health_status": "HEALTHY", "failed_outlier_check": true}
+        },
+        {
+          "address": {"socket_address": {"address": "10.0.0.1", "port_value": 21000}},
+          "health_status": {"eds_health_status": "HEALTHY"}
+        }
+      ]
+    },
+    {
+      "name": "db-v2.default.dc1.internal.domain.consul",
+      "host_statuses": [
+        {
+          "address": {"socket_address": {"address": "10.0.0.2", "port_value": 21000}},
+          "health_status": {"failed_outlier_check": true}
+        },
+        {
+          "address": {"socket_address": {"address": "10.0.0.1", "port_value": 21001}},
+          "health_status": {"failed_outlier_check": true}
+        }
+      ]
+    }
+  ]
+}`))
+	}))
+	t.Cleanup(envoy.Close)
+
+	check := &CheckOutlier{
+		Outlier:    strings.TrimPrefix(envoy.URL, "http://"),
+		httpClient: envoy.Client(),
+	}
+	ejected, err := check.ejections()
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.1:21001", "10.0.0.2:21000"}, ejected)
+}
+
+func outlierEjectedMeta(ejected string) map[string]string {
+	return map[string]string{structs.MetaCheckOutlierEjected: ejected}
+}
+
+// mockOutlierRPC dispatches the config entry and the topology queries of
+// outlier checks to distinct mockRPCs, which record the args of a single
+// type.
+type mockOutlierRPC struct {
+	entries  *mockRPC
+	topology *mockRPC
+}
+
+func newMockOutlierRPC() *mockOutlierRPC {
+	return &mockOutlierRPC{entries: &mockRPC{}, topology: &mockRPC{}}
+}
+
+func (m *mockOutlierRPC) RPC(ctx context.Context, method string, args interface{}, reply interface{}) error {
+	if method == "ConfigEntry.Get" {
+		return m.entries.RPC(ctx, method, args, reply)
+	}
+	return m.topology.RPC(ctx, method, args, reply)
+}
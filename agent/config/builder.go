@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"net/url"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
@@ -36,6 +38,7 @@ import (
 	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/consul/authmethod/ssoauth"
 	consulrate "github.com/hashicorp/consul/agent/consul/rate"
+	"github.com/hashicorp/consul/agent/exec"
 	hcpconfig "github.com/hashicorp/consul/agent/hcp/config"
 	"github.com/hashicorp/consul/agent/rpc/middleware"
 	"github.com/hashicorp/consul/agent/structs"
@@ -392,6 +395,11 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		dnsServiceTTL[k] = b.durationVal(fmt.Sprintf("dns_config.service_ttl[%q]", k), &v)
 	}
 
+	dnsServiceOrdering := map[string]structs.DNSAnswerOrdering{}
+	for k, v := range c.DNS.ServiceOrdering {
+		dnsServiceOrdering[k] = b.dnsAnswerOrderingVal(fmt.Sprintf("dns_config.service_answer_ordering[%q]", k), v)
+	}
+
 	soa := RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0}
 	if c.DNS.SOA != nil {
 		if c.DNS.SOA.Expire != nil {
@@ -408,6 +416,33 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		}
 	}
 
+	var dnssec RuntimeDNSSECConfig
+	if c.DNS.DNSSEC != nil {
+		dnssec = RuntimeDNSSECConfig{
+			Enabled:           boolVal(c.DNS.DNSSEC.Enabled),
+			PublicKeyFile:     stringVal(c.DNS.DNSSEC.PublicKeyFile),
+			PrivateKeyFile:    stringVal(c.DNS.DNSSEC.PrivateKeyFile),
+			SignatureValidity: b.durationValWithDefault("dns_config.dnssec.signature_validity", c.DNS.DNSSEC.SignatureValidity, 7*24*time.Hour),
+		}
+	}
+
+	dnsRateLimit := RuntimeDNSRateLimitConfig{
+		Slip:             2,
+		IPv4PrefixLength: 24,
+		IPv6PrefixLength: 56,
+	}
+	if rl := c.DNS.RateLimit; rl != nil {
+		dnsRateLimit = RuntimeDNSRateLimitConfig{
+			QueryRate:        float64Val(rl.QueryRate),
+			QueryBurst:       intValWithDefault(rl.QueryBurst, int(math.Ceil(float64Val(rl.QueryRate)))),
+			ResponseRate:     float64Val(rl.ResponseRate),
+			ResponseBurst:    intValWithDefault(rl.ResponseBurst, int(math.Ceil(float64Val(rl.ResponseRate)))),
+			Slip:             intValWithDefault(rl.Slip, dnsRateLimit.Slip),
+			IPv4PrefixLength: intValWithDefault(rl.IPv4PrefixLength, dnsRateLimit.IPv4PrefixLength),
+			IPv6PrefixLength: intValWithDefault(rl.IPv6PrefixLength, dnsRateLimit.IPv6PrefixLength),
+		}
+	}
+
 	leaveOnTerm := !boolVal(c.ServerMode)
 	if c.LeaveOnTerm != nil {
 		leaveOnTerm = boolVal(c.LeaveOnTerm)
@@ -444,6 +479,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 
 	// determine port values and replace values <= 0 and > 65535 with -1
 	dnsPort := b.portVal("ports.dns", c.Ports.DNS)
+	dnsTLSPort := b.portVal("ports.dns_tls", c.Ports.DNSTLS)
+	dnsHTTPSPort := b.portVal("ports.dns_https", c.Ports.DNSHTTPS)
 	httpPort := b.portVal("ports.http", c.Ports.HTTP)
 	httpsPort := b.portVal("ports.https", c.Ports.HTTPS)
 	serverPort := b.portVal("ports.server", c.Ports.Server)
@@ -588,6 +625,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		b.warn("client_addr is empty, client services (DNS, HTTP, HTTPS, GRPC) will not be listening for connections")
 	}
 	dnsAddrs := b.makeAddrs(b.expandAddrs("addresses.dns", c.Addresses.DNS), clientAddrs, dnsPort)
+	dnsTLSAddrs := b.makeAddrs(b.expandAddrs("addresses.dns_tls", c.Addresses.DNSTLS), clientAddrs, dnsTLSPort)
+	dnsHTTPSAddrs := b.makeAddrs(b.expandAddrs("addresses.dns_https", c.Addresses.DNSHTTPS), clientAddrs, dnsHTTPSPort)
 	httpAddrs := b.makeAddrs(b.expandAddrs("addresses.http", c.Addresses.HTTP), clientAddrs, httpPort)
 	httpsAddrs := b.makeAddrs(b.expandAddrs("addresses.https", c.Addresses.HTTPS), clientAddrs, httpsPort)
 	grpcAddrs := b.makeAddrs(b.expandAddrs("addresses.grpc", c.Addresses.GRPC), clientAddrs, grpcPort)
@@ -767,6 +806,16 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 	enableRemoteScriptChecks := boolVal(c.EnableScriptChecks)
 	enableLocalScriptChecks := boolValWithDefault(c.EnableLocalScriptChecks, enableRemoteScriptChecks)
 
+	scriptCheckSandbox := exec.Sandbox{
+		CgroupParent:    stringVal(c.ScriptCheckSandbox.CgroupParent),
+		CPUMax:          float64Val(c.ScriptCheckSandbox.CPUMax),
+		MemoryMax:       int64(intVal(c.ScriptCheckSandbox.MemoryMaxMB)) * 1024 * 1024,
+		User:            stringVal(c.ScriptCheckSandbox.User),
+		Group:           stringVal(c.ScriptCheckSandbox.Group),
+		NoNewPrivileges: boolVal(c.ScriptCheckSandbox.NoNewPrivileges),
+		EnvAllowlist:    c.ScriptCheckSandbox.EnvAllowlist,
+	}
+
 	var configEntries []structs.ConfigEntry
 
 	if len(c.ConfigEntries.Bootstrap) > 0 {
@@ -900,6 +949,12 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		AutopilotServerStabilizationTime: b.durationVal("autopilot.server_stabilization_time", c.Autopilot.ServerStabilizationTime),
 		AutopilotUpgradeVersionTag:       stringVal(c.Autopilot.UpgradeVersionTag),
 
+		// Automatic snapshots
+		AutoSnapshotInterval:     b.durationVal("auto_snapshot.interval", c.AutoSnapshot.Interval),
+		AutoSnapshotPath:         stringVal(c.AutoSnapshot.Path),
+		AutoSnapshotRetain:       intVal(c.AutoSnapshot.Retain),
+		AutoSnapshotNameTemplate: stringVal(c.AutoSnapshot.NameTemplate),
+
 		// DNS
 		DNSAddrs:              dnsAddrs,
 		DNSAllowStale:         boolVal(c.DNS.AllowStale),
@@ -912,11 +967,20 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		DNSNodeTTL:            b.durationVal("dns_config.node_ttl", c.DNS.NodeTTL),
 		DNSOnlyPassing:        boolVal(c.DNS.OnlyPassing),
 		DNSPort:               dnsPort,
+		DNSTLSAddrs:           dnsTLSAddrs,
+		DNSTLSPort:            dnsTLSPort,
+		DNSHTTPSAddrs:         dnsHTTPSAddrs,
+		DNSHTTPSPort:          dnsHTTPSPort,
 		DNSRecursorStrategy:   b.dnsRecursorStrategyVal(stringVal(c.DNS.RecursorStrategy)),
 		DNSRecursorTimeout:    b.durationVal("recursor_timeout", c.DNS.RecursorTimeout),
 		DNSRecursors:          dnsRecursors,
 		DNSServiceTTL:         dnsServiceTTL,
+		DNSAnswerOrdering:     b.dnsAnswerOrderingVal("dns_config.answer_ordering", stringVal(c.DNS.AnswerOrdering)),
+		DNSServiceOrdering:    dnsServiceOrdering,
+		DNSAnswerSubsetSize:   intValWithDefault(c.DNS.AnswerSubsetSize, 3),
 		DNSSOA:                soa,
+		DNSSEC:                dnssec,
+		DNSRateLimit:          dnsRateLimit,
 		DNSUDPAnswerLimit:     intVal(c.DNS.UDPAnswerLimit),
 		DNSNodeMetaTXT:        boolValWithDefault(c.DNS.NodeMetaTXT, true),
 		DNSUseCache:           boolVal(c.DNS.UseCache),
@@ -1021,6 +1085,7 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		EnableDebug:                boolVal(c.EnableDebug),
 		EnableRemoteScriptChecks:   enableRemoteScriptChecks,
 		EnableLocalScriptChecks:    enableLocalScriptChecks,
+		ScriptCheckSandbox:         scriptCheckSandbox,
 		EncryptKey:                 stringVal(c.EncryptKey),
 		GRPCAddrs:                  grpcAddrs,
 		GRPCPort:                   grpcPort,
@@ -1031,6 +1096,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		HTTPMaxConnsPerClient:      intVal(c.Limits.HTTPMaxConnsPerClient),
 		HTTPSHandshakeTimeout:      b.durationVal("limits.https_handshake_timeout", c.Limits.HTTPSHandshakeTimeout),
 		KVMaxValueSize:             uint64Val(c.Limits.KVMaxValueSize),
+		KVHistoryRetainCount:       intVal(c.KVHistory.RetainCount),
+		KVHistoryRetainAge:         b.durationVal("kv_history.retain_age", c.KVHistory.RetainAge),
 		LeaveDrainTime:             b.durationVal("performance.leave_drain_time", c.Performance.LeaveDrainTime),
 		LeaveOnTerm:                leaveOnTerm,
 		EnableXDSLoadBalancing:     boolVal(c.Performance.EnableXDSLoadBalancing),
@@ -1139,6 +1206,10 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 
 	rt.UseStreamingBackend = boolValWithDefault(c.UseStreamingBackend, true)
 
+	if rt.AutoSnapshotInterval > 0 && rt.AutoSnapshotPath == "" && rt.DataDir != "" {
+		rt.AutoSnapshotPath = filepath.Join(rt.DataDir, "snapshots")
+	}
+
 	if rt.Cache.EntryFetchMaxBurst <= 0 {
 		return RuntimeConfig{}, fmt.Errorf("cache.entry_fetch_max_burst must be strictly positive, was: %v", rt.Cache.EntryFetchMaxBurst)
 	}
@@ -1320,11 +1391,96 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			return fmt.Errorf("DNS address cannot be a unix socket")
 		}
 	}
+	for _, a := range rt.DNSTLSAddrs {
+		if _, ok := a.(*net.UnixAddr); ok {
+			return fmt.Errorf("DNS TLS address cannot be a unix socket")
+		}
+	}
+	for _, a := range rt.DNSHTTPSAddrs {
+		if _, ok := a.(*net.UnixAddr); ok {
+			return fmt.Errorf("DNS HTTPS address cannot be a unix socket")
+		}
+	}
+	if (rt.DNSTLSPort > 0 || rt.DNSHTTPSPort > 0) && rt.TLS.HTTPS.CertFile == "" && !rt.TLS.AutoTLS {
+		return fmt.Errorf("ports.dns_tls and ports.dns_https require a TLS certificate to be configured in tls.https or tls.defaults")
+	}
 	for _, a := range rt.DNSRecursors {
 		if ipaddr.IsAny(a) {
 			return fmt.Errorf("DNS recursor address cannot be 0.0.0.0, :: or [::]")
 		}
 	}
+	if rt.DNSSEC.Enabled {
+		if (rt.DNSSEC.PublicKeyFile == "") != (rt.DNSSEC.PrivateKeyFile == "") {
+			return fmt.Errorf("dns_config.dnssec.public_key_file and dns_config.dnssec.private_key_file must be set together")
+		}
+		if rt.DNSSEC.PublicKeyFile == "" && rt.EncryptKey == "" {
+			return fmt.Errorf("dns_config.dnssec requires either a signing key or a gossip encryption key to derive one from")
+		}
+		if rt.DNSSEC.SignatureValidity <= 0 {
+			return fmt.Errorf("dns_config.dnssec.signature_validity must be positive")
+		}
+	}
+	if rt.ScriptCheckSandbox.CPUMax < 0 {
+		return fmt.Errorf("script_check_sandbox.cpu_max cannot be negative")
+	}
+	if rt.ScriptCheckSandbox.MemoryMax < 0 {
+		return fmt.Errorf("script_check_sandbox.memory_max_mb cannot be negative")
+	}
+	if sandbox := rt.ScriptCheckSandbox; sandbox.Enabled() {
+		if (sandbox.CPUMax > 0 || sandbox.MemoryMax > 0) && sandbox.CgroupParent == "" {
+			return fmt.Errorf("script_check_sandbox.cgroup_parent must be set to limit CPU or memory")
+		}
+		if runtime.GOOS != "linux" {
+			return fmt.Errorf("script_check_sandbox is only supported on Linux")
+		}
+	}
+	if rt.AutoSnapshotInterval < 0 {
+		return fmt.Errorf("auto_snapshot.interval cannot be negative")
+	}
+	if rt.AutoSnapshotRetain < 0 {
+		return fmt.Errorf("auto_snapshot.retain cannot be negative")
+	}
+	if rt.AutoSnapshotInterval > 0 {
+		if rt.AutoSnapshotPath == "" {
+			return fmt.Errorf("auto_snapshot.path must be set when data_dir is not")
+		}
+		if _, err := consul.ParseAutoSnapshotNameTemplate(rt.AutoSnapshotNameTemplate); err != nil {
+			return fmt.Errorf("auto_snapshot.name_template is invalid: %v", err)
+		}
+		if !rt.ServerMode {
+			b.warn("auto_snapshot is only used by servers and will be ignored")
+		}
+	}
+	if rt.KVHistoryRetainCount < 0 {
+		return fmt.Errorf("kv_history.retain_count cannot be negative")
+	}
+	if rt.KVHistoryRetainAge < 0 {
+		return fmt.Errorf("kv_history.retain_age cannot be negative")
+	}
+	if rt.KVHistoryRetainAge > 0 && rt.KVHistoryRetainCount == 0 {
+		return fmt.Errorf("kv_history.retain_age requires kv_history.retain_count to be set")
+	}
+	if rt.DNSAnswerSubsetSize < 1 {
+		return fmt.Errorf("dns_config.answer_subset_size must be at least 1")
+	}
+	if rl := rt.DNSRateLimit; rl.QueryRate < 0 || rl.ResponseRate < 0 {
+		return fmt.Errorf("dns_config.rate_limit rates cannot be negative")
+	}
+	if rl := rt.DNSRateLimit; rl.QueryRate > 0 && rl.QueryBurst < 1 {
+		return fmt.Errorf("dns_config.rate_limit.query_burst must be at least 1")
+	}
+	if rl := rt.DNSRateLimit; rl.ResponseRate > 0 && rl.ResponseBurst < 1 {
+		return fmt.Errorf("dns_config.rate_limit.response_burst must be at least 1")
+	}
+	if rt.DNSRateLimit.Slip < 0 {
+		return fmt.Errorf("dns_config.rate_limit.slip cannot be negative")
+	}
+	if l := rt.DNSRateLimit.IPv4PrefixLength; l < 0 || l > 32 {
+		return fmt.Errorf("dns_config.rate_limit.ipv4_prefix_length must be between 0 and 32")
+	}
+	if l := rt.DNSRateLimit.IPv6PrefixLength; l < 0 || l > 128 {
+		return fmt.Errorf("dns_config.rate_limit.ipv6_prefix_length must be between 0 and 128")
+	}
 	if !isValidAltDomain(rt.DNSAltDomain, rt.Datacenter) {
 		return fmt.Errorf("alt_domain cannot start with {service,connect,node,query,addr,%s}", rt.Datacenter)
 	}
@@ -1434,6 +1590,12 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		// we leave this for consistency
 		return err
 	}
+	if err := addrsUnique(inuse, "DNS TLS", rt.DNSTLSAddrs); err != nil {
+		return err
+	}
+	if err := addrsUnique(inuse, "DNS HTTPS", rt.DNSHTTPSAddrs); err != nil {
+		return err
+	}
 	if err := addrsUnique(inuse, "HTTP", rt.HTTPAddrs); err != nil {
 		return err
 	}
@@ -1629,6 +1791,7 @@ func (b *builder) checkVal(v *CheckDefinition) *structs.CheckDefinition {
 		Method:                         stringVal(v.Method),
 		Body:                           stringVal(v.Body),
 		DisableRedirects:               boolVal(v.DisableRedirects),
+		ResponseAssertions:             responseAssertionsVal(v.ResponseAssertions),
 		TCP:                            stringVal(v.TCP),
 		TCPUseTLS:                      boolVal(v.TCPUseTLS),
 		UDP:                            stringVal(v.UDP),
@@ -1641,20 +1804,68 @@ func (b *builder) checkVal(v *CheckDefinition) *structs.CheckDefinition {
 		TLSSkipVerify:                  boolVal(v.TLSSkipVerify),
 		AliasNode:                      stringVal(v.AliasNode),
 		AliasService:                   stringVal(v.AliasService),
+		CompositeMode:                  stringVal(v.CompositeMode),
+		CompositeQuorum:                intVal(v.CompositeQuorum),
+		CompositeMembers:               compositeMembersVal(v.CompositeMembers),
 		Timeout:                        b.durationVal(fmt.Sprintf("check[%s].timeout", id), v.Timeout),
 		TTL:                            b.durationVal(fmt.Sprintf("check[%s].ttl", id), v.TTL),
 		SuccessBeforePassing:           intVal(v.SuccessBeforePassing),
 		FailuresBeforeCritical:         intVal(v.FailuresBeforeCritical),
 		FailuresBeforeWarning:          intValWithDefault(v.FailuresBeforeWarning, intVal(v.FailuresBeforeCritical)),
+		FlapHalfLife:                   b.durationVal(fmt.Sprintf("check[%s].flap_half_life", id), v.FlapHalfLife),
+		FlapMaxSuppress:                b.durationVal(fmt.Sprintf("check[%s].flap_max_suppress", id), v.FlapMaxSuppress),
+		FlapSuppressThreshold:          intVal(v.FlapSuppressThreshold),
+		FlapReuseThreshold:             intVal(v.FlapReuseThreshold),
 		H2PING:                         stringVal(v.H2PING),
 		H2PingUseTLS:                   H2PingUseTLSVal,
 		OSService:                      stringVal(v.OSService),
+		CertExpiry:                     stringVal(v.CertExpiry),
+		DNS:                            stringVal(v.DNS),
+		DNSServer:                      stringVal(v.DNSServer),
+		FileFreshness:                  stringVal(v.FileFreshness),
+		Outlier:                        stringVal(v.Outlier),
+		WarningThreshold:               b.durationVal(fmt.Sprintf("check[%s].warning_threshold", id), v.WarningThreshold),
+		CriticalThreshold:              b.durationVal(fmt.Sprintf("check[%s].critical_threshold", id), v.CriticalThreshold),
 		DeregisterCriticalServiceAfter: b.durationVal(fmt.Sprintf("check[%s].deregister_critical_service_after", id), v.DeregisterCriticalServiceAfter),
 		OutputMaxSize:                  intValWithDefault(v.OutputMaxSize, checks.DefaultBufSize),
 		EnterpriseMeta:                 v.EnterpriseMeta.ToStructs(),
 	}
 }
 
+func responseAssertionsVal(v []CheckResponseAssertion) []structs.HTTPResponseAssertion {
+	if len(v) == 0 {
+		return nil
+	}
+	assertions := make([]structs.HTTPResponseAssertion, len(v))
+	for i, a := range v {
+		assertions[i] = structs.HTTPResponseAssertion{
+			BodyRegex: stringVal(a.BodyRegex),
+			JSONPath:  stringVal(a.JSONPath),
+			Header:    stringVal(a.Header),
+			Match:     stringVal(a.Match),
+			Status:    stringVal(a.Status),
+		}
+	}
+	return assertions
+}
+
+func compositeMembersVal(v []CheckCompositeMember) []structs.CompositeCheckMember {
+	if len(v) == 0 {
+		return nil
+	}
+	members := make([]structs.CompositeCheckMember, len(v))
+	for i, m := range v {
+		members[i] = structs.CompositeCheckMember{
+			Node:        stringVal(m.Node),
+			CheckID:     types.CheckID(stringVal(m.CheckID)),
+			ServiceID:   stringVal(m.ServiceID),
+			ServiceName: stringVal(m.ServiceName),
+			Weight:      intVal(m.Weight),
+		}
+	}
+	return members
+}
+
 func (b *builder) svcTaggedAddresses(v map[string]ServiceAddress) map[string]structs.ServiceAddress {
 	if len(v) <= 0 {
 		return nil
@@ -1842,6 +2053,22 @@ func (b *builder) dnsRecursorStrategyVal(v string) structs.RecursorStrategy {
 	return out
 }
 
+func (b *builder) dnsAnswerOrderingVal(name, v string) structs.DNSAnswerOrdering {
+	var out structs.DNSAnswerOrdering
+
+	switch structs.DNSAnswerOrdering(v) {
+	case structs.DNSAnswerOrderingRandom, "":
+		out = structs.DNSAnswerOrderingRandom
+	case structs.DNSAnswerOrderingWeighted,
+		structs.DNSAnswerOrderingNearest,
+		structs.DNSAnswerOrderingConsistentHash:
+		out = structs.DNSAnswerOrdering(v)
+	default:
+		b.err = multierror.Append(b.err, fmt.Errorf("%s: invalid ordering: %q", name, v))
+	}
+	return out
+}
+
 func (b *builder) requestsLimitsModeVal(v string) consulrate.Mode {
 	var out consulrate.Mode
 
@@ -739,6 +739,12 @@ func (o *RuntimeConfig) DeepCopy() *RuntimeConfig {
 			cp.DNSServiceTTL[k2] = v2
 		}
 	}
+	if o.DNSServiceOrdering != nil {
+		cp.DNSServiceOrdering = make(map[string]structs.DNSAnswerOrdering, len(o.DNSServiceOrdering))
+		for k2, v2 := range o.DNSServiceOrdering {
+			cp.DNSServiceOrdering[k2] = v2
+		}
+	}
 	if o.DNSRecursors != nil {
 		cp.DNSRecursors = make([]string, len(o.DNSRecursors))
 		copy(cp.DNSRecursors, o.DNSRecursors)
@@ -944,6 +950,14 @@ func (o *RuntimeConfig) DeepCopy() *RuntimeConfig {
 		cp.DNSAddrs = make([]net.Addr, len(o.DNSAddrs))
 		copy(cp.DNSAddrs, o.DNSAddrs)
 	}
+	if o.DNSTLSAddrs != nil {
+		cp.DNSTLSAddrs = make([]net.Addr, len(o.DNSTLSAddrs))
+		copy(cp.DNSTLSAddrs, o.DNSTLSAddrs)
+	}
+	if o.DNSHTTPSAddrs != nil {
+		cp.DNSHTTPSAddrs = make([]net.Addr, len(o.DNSHTTPSAddrs))
+		copy(cp.DNSHTTPSAddrs, o.DNSHTTPSAddrs)
+	}
 	if o.GRPCAddrs != nil {
 		cp.GRPCAddrs = make([]net.Addr, len(o.GRPCAddrs))
 		copy(cp.GRPCAddrs, o.GRPCAddrs)
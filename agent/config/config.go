@@ -146,6 +146,7 @@ type Config struct {
 	AdvertiseReconnectTimeout        *string             `mapstructure:"advertise_reconnect_timeout" json:"-"`
 	AutoConfig                       AutoConfigRaw       `mapstructure:"auto_config" json:"-"`
 	Autopilot                        Autopilot           `mapstructure:"autopilot" json:"-"`
+	AutoSnapshot                     AutoSnapshot        `mapstructure:"auto_snapshot" json:"-"`
 	BindAddr                         *string             `mapstructure:"bind_addr" json:"bind_addr,omitempty"`
 	Bootstrap                        *bool               `mapstructure:"bootstrap" json:"bootstrap,omitempty"`
 	BootstrapExpect                  *int                `mapstructure:"bootstrap_expect" json:"bootstrap_expect,omitempty"`
@@ -189,6 +190,7 @@ type Config struct {
 	GossipLAN                        GossipLANConfig     `mapstructure:"gossip_lan" json:"-"`
 	GossipWAN                        GossipWANConfig     `mapstructure:"gossip_wan" json:"-"`
 	HTTPConfig                       HTTPConfig          `mapstructure:"http_config" json:"-"`
+	KVHistory                        KVHistory           `mapstructure:"kv_history" json:"-"`
 	LeaveOnTerm                      *bool               `mapstructure:"leave_on_terminate" json:"leave_on_terminate,omitempty"`
 	LicensePath                      *string             `mapstructure:"license_path" json:"license_path,omitempty"`
 	Limits                           Limits              `mapstructure:"limits" json:"-"`
@@ -226,6 +228,7 @@ type Config struct {
 	RetryJoinMaxAttemptsLAN          *int                `mapstructure:"retry_max" json:"retry_max,omitempty"`
 	RetryJoinMaxAttemptsWAN          *int                `mapstructure:"retry_max_wan" json:"retry_max_wan,omitempty"`
 	RetryJoinWAN                     []string            `mapstructure:"retry_join_wan" json:"retry_join_wan,omitempty"`
+	ScriptCheckSandbox               ScriptCheckSandbox  `mapstructure:"script_check_sandbox" json:"-"`
 	SerfAllowedCIDRsLAN              []string            `mapstructure:"serf_lan_allowed_cidrs" json:"serf_lan_allowed_cidrs,omitempty"`
 	SerfAllowedCIDRsWAN              []string            `mapstructure:"serf_wan_allowed_cidrs" json:"serf_wan_allowed_cidrs,omitempty"`
 	SerfBindAddrLAN                  *string             `mapstructure:"serf_lan" json:"serf_lan,omitempty"`
@@ -351,11 +354,13 @@ type Consul struct {
 }
 
 type Addresses struct {
-	DNS     *string `mapstructure:"dns"`
-	HTTP    *string `mapstructure:"http"`
-	HTTPS   *string `mapstructure:"https"`
-	GRPC    *string `mapstructure:"grpc"`
-	GRPCTLS *string `mapstructure:"grpc_tls"`
+	DNS      *string `mapstructure:"dns"`
+	DNSTLS   *string `mapstructure:"dns_tls"`
+	DNSHTTPS *string `mapstructure:"dns_https"`
+	HTTP     *string `mapstructure:"http"`
+	HTTPS    *string `mapstructure:"https"`
+	GRPC     *string `mapstructure:"grpc"`
+	GRPCTLS  *string `mapstructure:"grpc_tls"`
 }
 
 type AdvertiseAddrsConfig struct {
@@ -379,6 +384,28 @@ type Autopilot struct {
 	UpgradeVersionTag *string `mapstructure:"upgrade_version_tag"`
 }
 
+type AutoSnapshot struct {
+	Interval     *string `mapstructure:"interval"`
+	Path         *string `mapstructure:"path"`
+	Retain       *int    `mapstructure:"retain"`
+	NameTemplate *string `mapstructure:"name_template"`
+}
+
+type KVHistory struct {
+	RetainCount *int    `mapstructure:"retain_count"`
+	RetainAge   *string `mapstructure:"retain_age"`
+}
+
+type ScriptCheckSandbox struct {
+	CgroupParent    *string  `mapstructure:"cgroup_parent"`
+	CPUMax          *float64 `mapstructure:"cpu_max"`
+	MemoryMaxMB     *int     `mapstructure:"memory_max_mb"`
+	User            *string  `mapstructure:"user"`
+	Group           *string  `mapstructure:"group"`
+	NoNewPrivileges *bool    `mapstructure:"no_new_privileges"`
+	EnvAllowlist    []string `mapstructure:"env_allowlist"`
+}
+
 // ServiceWeights defines the registration of weights used in DNS for a Service
 type ServiceWeights struct {
 	Passing *int `mapstructure:"passing"`
@@ -413,44 +440,79 @@ type ServiceDefinition struct {
 }
 
 type CheckDefinition struct {
-	ID                             *string             `mapstructure:"id"`
-	Name                           *string             `mapstructure:"name"`
-	Notes                          *string             `mapstructure:"notes"`
-	ServiceID                      *string             `mapstructure:"service_id" alias:"serviceid"`
-	Token                          *string             `mapstructure:"token"`
-	Status                         *string             `mapstructure:"status"`
-	ScriptArgs                     []string            `mapstructure:"args" alias:"scriptargs"`
-	HTTP                           *string             `mapstructure:"http"`
-	Header                         map[string][]string `mapstructure:"header"`
-	Method                         *string             `mapstructure:"method"`
-	Body                           *string             `mapstructure:"body"`
-	DisableRedirects               *bool               `mapstructure:"disable_redirects"`
-	OutputMaxSize                  *int                `mapstructure:"output_max_size"`
-	TCP                            *string             `mapstructure:"tcp"`
-	TCPUseTLS                      *bool               `mapstructure:"tcp_use_tls"`
-	UDP                            *string             `mapstructure:"udp"`
-	Interval                       *string             `mapstructure:"interval"`
-	DockerContainerID              *string             `mapstructure:"docker_container_id" alias:"dockercontainerid"`
-	Shell                          *string             `mapstructure:"shell"`
-	GRPC                           *string             `mapstructure:"grpc"`
-	GRPCUseTLS                     *bool               `mapstructure:"grpc_use_tls"`
-	TLSServerName                  *string             `mapstructure:"tls_server_name"`
-	TLSSkipVerify                  *bool               `mapstructure:"tls_skip_verify" alias:"tlsskipverify"`
-	AliasNode                      *string             `mapstructure:"alias_node"`
-	AliasService                   *string             `mapstructure:"alias_service"`
-	Timeout                        *string             `mapstructure:"timeout"`
-	TTL                            *string             `mapstructure:"ttl"`
-	H2PING                         *string             `mapstructure:"h2ping"`
-	H2PingUseTLS                   *bool               `mapstructure:"h2ping_use_tls"`
-	OSService                      *string             `mapstructure:"os_service"`
-	SuccessBeforePassing           *int                `mapstructure:"success_before_passing"`
-	FailuresBeforeWarning          *int                `mapstructure:"failures_before_warning"`
-	FailuresBeforeCritical         *int                `mapstructure:"failures_before_critical"`
-	DeregisterCriticalServiceAfter *string             `mapstructure:"deregister_critical_service_after" alias:"deregistercriticalserviceafter"`
+	ID                             *string                  `mapstructure:"id"`
+	Name                           *string                  `mapstructure:"name"`
+	Notes                          *string                  `mapstructure:"notes"`
+	ServiceID                      *string                  `mapstructure:"service_id" alias:"serviceid"`
+	Token                          *string                  `mapstructure:"token"`
+	Status                         *string                  `mapstructure:"status"`
+	ScriptArgs                     []string                 `mapstructure:"args" alias:"scriptargs"`
+	HTTP                           *string                  `mapstructure:"http"`
+	Header                         map[string][]string      `mapstructure:"header"`
+	Method                         *string                  `mapstructure:"method"`
+	Body                           *string                  `mapstructure:"body"`
+	DisableRedirects               *bool                    `mapstructure:"disable_redirects"`
+	ResponseAssertions             []CheckResponseAssertion `mapstructure:"response_assertions"`
+	OutputMaxSize                  *int                     `mapstructure:"output_max_size"`
+	TCP                            *string                  `mapstructure:"tcp"`
+	TCPUseTLS                      *bool                    `mapstructure:"tcp_use_tls"`
+	UDP                            *string                  `mapstructure:"udp"`
+	Interval                       *string                  `mapstructure:"interval"`
+	DockerContainerID              *string                  `mapstructure:"docker_container_id" alias:"dockercontainerid"`
+	Shell                          *string                  `mapstructure:"shell"`
+	GRPC                           *string                  `mapstructure:"grpc"`
+	GRPCUseTLS                     *bool                    `mapstructure:"grpc_use_tls"`
+	TLSServerName                  *string                  `mapstructure:"tls_server_name"`
+	TLSSkipVerify                  *bool                    `mapstructure:"tls_skip_verify" alias:"tlsskipverify"`
+	AliasNode                      *string                  `mapstructure:"alias_node"`
+	AliasService                   *string                  `mapstructure:"alias_service"`
+	CompositeMode                  *string                  `mapstructure:"composite_mode"`
+	CompositeQuorum                *int                     `mapstructure:"composite_quorum"`
+	CompositeMembers               []CheckCompositeMember   `mapstructure:"composite_members"`
+	Timeout                        *string                  `mapstructure:"timeout"`
+	TTL                            *string                  `mapstructure:"ttl"`
+	H2PING                         *string                  `mapstructure:"h2ping"`
+	H2PingUseTLS                   *bool                    `mapstructure:"h2ping_use_tls"`
+	OSService                      *string                  `mapstructure:"os_service"`
+	CertExpiry                     *string                  `mapstructure:"cert_expiry"`
+	DNS                            *string                  `mapstructure:"dns"`
+	DNSServer                      *string                  `mapstructure:"dns_server"`
+	FileFreshness                  *string                  `mapstructure:"file_freshness"`
+	Outlier                        *string                  `mapstructure:"outlier"`
+	WarningThreshold               *string                  `mapstructure:"warning_threshold"`
+	CriticalThreshold              *string                  `mapstructure:"critical_threshold"`
+	SuccessBeforePassing           *int                     `mapstructure:"success_before_passing"`
+	FailuresBeforeWarning          *int                     `mapstructure:"failures_before_warning"`
+	FailuresBeforeCritical         *int                     `mapstructure:"failures_before_critical"`
+	FlapHalfLife                   *string                  `mapstructure:"flap_half_life"`
+	FlapMaxSuppress                *string                  `mapstructure:"flap_max_suppress"`
+	FlapSuppressThreshold          *int                     `mapstructure:"flap_suppress_threshold"`
+	FlapReuseThreshold             *int                     `mapstructure:"flap_reuse_threshold"`
+	DeregisterCriticalServiceAfter *string                  `mapstructure:"deregister_critical_service_after" alias:"deregistercriticalserviceafter"`
 
 	EnterpriseMeta `mapstructure:",squash"`
 }
 
+// CheckResponseAssertion is a response_assertions block within an HTTP check
+// definition.
+type CheckResponseAssertion struct {
+	BodyRegex *string `mapstructure:"body_regex"`
+	JSONPath  *string `mapstructure:"json_path"`
+	Header    *string `mapstructure:"header"`
+	Match     *string `mapstructure:"match"`
+	Status    *string `mapstructure:"status"`
+}
+
+// CheckCompositeMember is a composite_members block within a composite check
+// definition.
+type CheckCompositeMember struct {
+	Node        *string `mapstructure:"node"`
+	CheckID     *string `mapstructure:"check_id"`
+	ServiceID   *string `mapstructure:"service_id"`
+	ServiceName *string `mapstructure:"service_name"`
+	Weight      *int    `mapstructure:"weight"`
+}
+
 // ServiceConnect is the connect block within a service registration
 type ServiceConnect struct {
 	// Native is true when this service can natively understand Connect.
@@ -647,9 +709,30 @@ type SOA struct {
 	Minttl  *uint32 `mapstructure:"min_ttl"`
 }
 
+// DNSSEC is the configuration of online DNSSEC signing for DNS
+type DNSSEC struct {
+	Enabled           *bool   `mapstructure:"enabled"`
+	PublicKeyFile     *string `mapstructure:"public_key_file"`
+	PrivateKeyFile    *string `mapstructure:"private_key_file"`
+	SignatureValidity *string `mapstructure:"signature_validity"`
+}
+
+// DNSRateLimit is the configuration of per-client rate limiting for DNS
+type DNSRateLimit struct {
+	QueryRate        *float64 `mapstructure:"query_rate"`
+	QueryBurst       *int     `mapstructure:"query_burst"`
+	ResponseRate     *float64 `mapstructure:"response_rate"`
+	ResponseBurst    *int     `mapstructure:"response_burst"`
+	Slip             *int     `mapstructure:"slip"`
+	IPv4PrefixLength *int     `mapstructure:"ipv4_prefix_length"`
+	IPv6PrefixLength *int     `mapstructure:"ipv6_prefix_length"`
+}
+
 type DNS struct {
 	AllowStale         *bool             `mapstructure:"allow_stale"`
 	ARecordLimit       *int              `mapstructure:"a_record_limit"`
+	AnswerOrdering     *string           `mapstructure:"answer_ordering"`
+	AnswerSubsetSize   *int              `mapstructure:"answer_subset_size"`
 	DisableCompression *bool             `mapstructure:"disable_compression"`
 	EnableTruncate     *bool             `mapstructure:"enable_truncate"`
 	MaxStale           *string           `mapstructure:"max_stale"`
@@ -658,9 +741,12 @@ type DNS struct {
 	RecursorStrategy   *string           `mapstructure:"recursor_strategy"`
 	RecursorTimeout    *string           `mapstructure:"recursor_timeout"`
 	ServiceTTL         map[string]string `mapstructure:"service_ttl"`
+	ServiceOrdering    map[string]string `mapstructure:"service_answer_ordering"`
 	UDPAnswerLimit     *int              `mapstructure:"udp_answer_limit"`
 	NodeMetaTXT        *bool             `mapstructure:"enable_additional_node_meta_txt"`
 	SOA                *SOA              `mapstructure:"soa"`
+	DNSSEC             *DNSSEC           `mapstructure:"dnssec"`
+	RateLimit          *DNSRateLimit     `mapstructure:"rate_limit"`
 	UseCache           *bool             `mapstructure:"use_cache"`
 	CacheMaxAge        *string           `mapstructure:"cache_max_age"`
 
@@ -715,6 +801,8 @@ type Telemetry struct {
 
 type Ports struct {
 	DNS            *int `mapstructure:"dns" json:"dns,omitempty"`
+	DNSTLS         *int `mapstructure:"dns_tls" json:"dns_tls,omitempty"`
+	DNSHTTPS       *int `mapstructure:"dns_https" json:"dns_https,omitempty"`
 	HTTP           *int `mapstructure:"http" json:"http,omitempty"`
 	HTTPS          *int `mapstructure:"https" json:"https,omitempty"`
 	SerfLAN        *int `mapstructure:"serf_lan" json:"serf_lan,omitempty"`
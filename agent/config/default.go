@@ -67,6 +67,11 @@ func DefaultSource() Source {
 			}
 		}
 
+		auto_snapshot = {
+			retain = 30
+			name_template = "` + consul.DefaultAutoSnapshotNameTemplate + `"
+		}
+
 		// TODO (slackpad) - Until #3744 is done, we need to keep these
 		// in sync with agent/consul/config.go.
 		autopilot = {
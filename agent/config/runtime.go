@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/consul"
 	consulrate "github.com/hashicorp/consul/agent/consul/rate"
+	"github.com/hashicorp/consul/agent/exec"
 	hcpconfig "github.com/hashicorp/consul/agent/hcp/config"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/token"
@@ -34,6 +35,44 @@ type RuntimeSOAConfig struct {
 	Minttl  uint32 // 0,
 }
 
+// RuntimeDNSSECConfig is the DNSSEC signing configuration of the DNS server.
+type RuntimeDNSSECConfig struct {
+	Enabled bool
+
+	// PublicKeyFile and PrivateKeyFile point to a DNSKEY in BIND format. When
+	// both are empty the signing key is derived from the gossip encryption
+	// key so that all agents sharing the keyring sign with the same key.
+	PublicKeyFile  string
+	PrivateKeyFile string
+
+	// SignatureValidity is how long generated RRSIG records are valid for.
+	SignatureValidity time.Duration
+}
+
+// RuntimeDNSRateLimitConfig is the per-client rate limiting configuration of
+// the DNS server. A rate of zero disables the corresponding limit.
+type RuntimeDNSRateLimitConfig struct {
+	// QueryRate and QueryBurst bound the number of queries per second
+	// accepted from a single source address.
+	QueryRate  float64
+	QueryBurst int
+
+	// ResponseRate and ResponseBurst bound the number of identical responses
+	// per second sent over UDP to a single client network (RRL).
+	ResponseRate  float64
+	ResponseBurst int
+
+	// Slip is how often a response suppressed by RRL is replaced by a
+	// truncated one, so that legitimate clients retry over TCP. Zero drops
+	// every suppressed response.
+	Slip int
+
+	// IPv4PrefixLength and IPv6PrefixLength size the client networks that
+	// share a response rate limit.
+	IPv4PrefixLength int
+	IPv6PrefixLength int
+}
+
 // StaticRuntimeConfig specifies the subset of configuration the consul agent actually
 // uses and that are not reloadable by configuration auto reload.
 type StaticRuntimeConfig struct {
@@ -162,6 +201,32 @@ type RuntimeConfig struct {
 	// hcl: autopilot { upgrade_version_tag = string }
 	AutopilotUpgradeVersionTag string
 
+	// AutoSnapshotInterval is how often the leader saves a snapshot of the
+	// state to AutoSnapshotPath. Automatic snapshots are disabled when it is
+	// zero.
+	//
+	// hcl: auto_snapshot { interval = "duration" }
+	AutoSnapshotInterval time.Duration
+
+	// AutoSnapshotPath is the local directory of the leader that automatic
+	// snapshots are saved to. Defaults to the "snapshots" directory in the
+	// data directory.
+	//
+	// hcl: auto_snapshot { path = string }
+	AutoSnapshotPath string
+
+	// AutoSnapshotRetain is the number of automatic snapshots kept in
+	// AutoSnapshotPath. All of them are kept when it is zero.
+	//
+	// hcl: auto_snapshot { retain = int }
+	AutoSnapshotRetain int
+
+	// AutoSnapshotNameTemplate is the text/template used to name the files
+	// of automatic snapshots.
+	//
+	// hcl: auto_snapshot { name_template = string }
+	AutoSnapshotNameTemplate string
+
 	// Cloud contains configuration for agents to connect to HCP.
 	//
 	// hcl: cloud { ... }
@@ -269,6 +334,28 @@ type RuntimeConfig struct {
 	// hcl: dns_config { service_ttl = map[string]"duration" }
 	DNSServiceTTL map[string]time.Duration
 
+	// DNSAnswerOrdering is the policy used to order the answers of service
+	// lookups: 'random' shuffles them, 'weighted' shuffles them favoring
+	// instances with higher weights, 'nearest' sorts them by round trip time
+	// from the agent and 'consistent_hash' answers each client with a stable
+	// subset of DNSAnswerSubsetSize instances.
+	//
+	// hcl: dns_config { answer_ordering = "(random|weighted|nearest|consistent_hash)" }
+	DNSAnswerOrdering structs.DNSAnswerOrdering
+
+	// DNSServiceOrdering overrides DNSAnswerOrdering for given services. Like
+	// DNSServiceTTL, keys ending with a "*" wildcard match service name
+	// prefixes.
+	//
+	// hcl: dns_config { service_answer_ordering = map[string]string }
+	DNSServiceOrdering map[string]structs.DNSAnswerOrdering
+
+	// DNSAnswerSubsetSize is the number of instances returned to each client
+	// with the 'consistent_hash' ordering.
+	//
+	// hcl: dns_config { answer_subset_size = int }
+	DNSAnswerSubsetSize int
+
 	// DNSUDPAnswerLimit is used to limit the maximum number of DNS Resource
 	// Records returned in the ANSWER section of a DNS response for UDP
 	// responses without EDNS support (limited to 512 bytes).
@@ -437,6 +524,16 @@ type RuntimeConfig struct {
 	//     header = map[string][]string
 	//     method = string
 	//     disable_redirects = (true|false)
+	//     response_assertions = [
+	//       {
+	//         body_regex = string
+	//         json_path = string
+	//         header = string
+	//         match = string
+	//         status = string
+	//       },
+	//       ...
+	//     ]
 	//     tcp = string
 	//     h2ping = string
 	//     interval = string
@@ -445,10 +542,33 @@ type RuntimeConfig struct {
 	//     tls_skip_verify = (true|false)
 	//     timeout = "duration"
 	//     ttl = "duration"
+	//     composite_mode = (all|any|quorum)
+	//     composite_quorum = int
+	//     composite_members = [
+	//       {
+	//         node = string
+	//         check_id = string
+	//         service_id = string
+	//         service_name = string
+	//         weight = int
+	//       },
+	//       ...
+	//     ]
 	//     os_service = string
+	//     cert_expiry = string
+	//     dns = string
+	//     dns_server = string
+	//     file_freshness = string
+	//     outlier = string
+	//     warning_threshold = "duration"
+	//     critical_threshold = "duration"
 	//     success_before_passing = int
 	//     failures_before_warning = int
 	//     failures_before_critical = int
+	//     flap_half_life = "duration"
+	//     flap_max_suppress = "duration"
+	//     flap_suppress_threshold = int
+	//     flap_reuse_threshold = int
 	//     deregister_critical_service_after = "duration"
 	//   },
 	//   ...
@@ -555,10 +675,58 @@ type RuntimeConfig struct {
 	// flags: -dns-port int
 	DNSPort int
 
+	// DNSTLSAddrs contains the list of TCP addresses the DNS-over-TLS
+	// (RFC 7858) server will bind to. If the endpoint is disabled
+	// (ports.dns_tls <= 0) the list is empty.
+	//
+	// The ip addresses are taken from 'addresses.dns_tls' which should
+	// contain a space separated list of ip addresses and/or go-sockaddr
+	// templates. If 'addresses.dns_tls' was not provided the 'client_addr'
+	// addresses are used.
+	//
+	// hcl: client_addr = string addresses { dns_tls = string } ports { dns_tls = int }
+	DNSTLSAddrs []net.Addr
+
+	// DNSTLSPort is the port the DNS-over-TLS server listens on. It is
+	// disabled by default. The conventional port is 853.
+	//
+	// hcl: ports { dns_tls = int }
+	DNSTLSPort int
+
+	// DNSHTTPSAddrs contains the list of TCP addresses the DNS-over-HTTPS
+	// (RFC 8484) server will bind to. If the endpoint is disabled
+	// (ports.dns_https <= 0) the list is empty.
+	//
+	// The ip addresses are taken from 'addresses.dns_https' which should
+	// contain a space separated list of ip addresses and/or go-sockaddr
+	// templates. If 'addresses.dns_https' was not provided the 'client_addr'
+	// addresses are used.
+	//
+	// hcl: client_addr = string addresses { dns_https = string } ports { dns_https = int }
+	DNSHTTPSAddrs []net.Addr
+
+	// DNSHTTPSPort is the port the DNS-over-HTTPS server listens on. It is
+	// disabled by default.
+	//
+	// hcl: ports { dns_https = int }
+	DNSHTTPSPort int
+
 	// DNSSOA is the settings applied for DNS SOA
 	// hcl: soa {}
 	DNSSOA RuntimeSOAConfig
 
+	// DNSSEC is the settings applied for online DNSSEC signing of answers
+	// from the Consul domains.
+	//
+	// hcl: dns_config { dnssec { ... } }
+	DNSSEC RuntimeDNSSECConfig
+
+	// DNSRateLimit is the settings applied for per-client query rate
+	// limiting and response rate limiting.
+	//
+	// hcl: dns_config { rate_limit { ... } }
+	DNSRateLimit RuntimeDNSRateLimitConfig
+
 	// DataDir is the path to the directory where the local state is stored.
 	//
 	// hcl: data_dir = string
@@ -678,6 +846,20 @@ type RuntimeConfig struct {
 	// flag: -enable-script-checks
 	EnableRemoteScriptChecks bool
 
+	// ScriptCheckSandbox restricts the environment that script checks run
+	// in. It is only supported on Linux, and is rejected on other platforms.
+	//
+	// hcl: script_check_sandbox {
+	//   cgroup_parent = string
+	//   cpu_max = float64
+	//   memory_max_mb = int
+	//   user = string
+	//   group = string
+	//   no_new_privileges = (true|false)
+	//   env_allowlist = []string
+	// }
+	ScriptCheckSandbox exec.Sandbox
+
 	// EncryptKey contains the encryption key to use for the Serf communication.
 	//
 	// hcl: encrypt = string
@@ -816,6 +998,19 @@ type RuntimeConfig struct {
 	// hcl: limits { kv_max_value_size = uint64 }
 	KVMaxValueSize uint64
 
+	// KVHistoryRetainCount is the number of revisions of each KV entry that
+	// the servers keep. KV history is disabled when it is zero.
+	//
+	// hcl: kv_history { retain_count = int }
+	KVHistoryRetainCount int
+
+	// KVHistoryRetainAge is how long the servers keep the revisions of KV
+	// entries. Revisions are only limited by KVHistoryRetainCount when it is
+	// zero.
+	//
+	// hcl: kv_history { retain_age = "duration" }
+	KVHistoryRetainAge time.Duration
+
 	// LeaveDrainTime is used to wait after a server has left the LAN Serf
 	// pool for RPCs to drain and new requests to be sent to other servers.
 	//
@@ -15,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
 	"testing"
@@ -30,6 +31,7 @@ import (
 	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/consul"
 	consulrate "github.com/hashicorp/consul/agent/consul/rate"
+	"github.com/hashicorp/consul/agent/exec"
 	hcpconfig "github.com/hashicorp/consul/agent/hcp/config"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/token"
@@ -2037,6 +2039,274 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 		hcl:         []string{`addresses = { dns = "unix:///foo" }`},
 		expectedErr: "DNS address cannot be a unix socket",
 	})
+	run(t, testCase{
+		desc: "dns tls does not allow socket",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "addresses": {"dns_tls": "unix:///foo" }, "ports": { "dns_tls": 853 } }`},
+		hcl:         []string{`addresses = { dns_tls = "unix:///foo" } ports = { dns_tls = 853 }`},
+		expectedErr: "DNS TLS address cannot be a unix socket",
+	})
+	run(t, testCase{
+		desc: "dns https requires a certificate",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "ports": { "dns_https": 8443 } }`},
+		hcl:         []string{`ports = { dns_https = 8443 }`},
+		expectedErr: "ports.dns_tls and ports.dns_https require a TLS certificate",
+	})
+	run(t, testCase{
+		desc: "dnssec requires a key",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "dns_config": { "dnssec": { "enabled": true } } }`},
+		hcl:         []string{`dns_config = { dnssec = { enabled = true } }`},
+		expectedErr: "dns_config.dnssec requires either a signing key or a gossip encryption key",
+	})
+	run(t, testCase{
+		desc: "dnssec key files must be set together",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "dns_config": { "dnssec": { "enabled": true, "public_key_file": "foo.key" } } }`},
+		hcl:         []string{`dns_config = { dnssec = { enabled = true public_key_file = "foo.key" } }`},
+		expectedErr: "dns_config.dnssec.public_key_file and dns_config.dnssec.private_key_file must be set together",
+	})
+	run(t, testCase{
+		desc: "dns answer ordering per service",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{ "dns_config": { "answer_ordering": "consistent_hash", "service_answer_ordering": { "web": "weighted" } } }`},
+		hcl:  []string{`dns_config = { answer_ordering = "consistent_hash" service_answer_ordering = { web = "weighted" } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.DNSAnswerOrdering = structs.DNSAnswerOrderingConsistentHash
+			rt.DNSServiceOrdering = map[string]structs.DNSAnswerOrdering{"web": structs.DNSAnswerOrderingWeighted}
+		},
+	})
+	run(t, testCase{
+		desc: "kv history",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{ "kv_history": { "retain_count": 10, "retain_age": "72h" } }`},
+		hcl:  []string{`kv_history { retain_count = 10 retain_age = "72h" }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.KVHistoryRetainCount = 10
+			rt.KVHistoryRetainAge = 72 * time.Hour
+		},
+	})
+	run(t, testCase{
+		desc: "kv history retain age without count",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "kv_history": { "retain_age": "72h" } }`},
+		hcl:         []string{`kv_history { retain_age = "72h" }`},
+		expectedErr: "kv_history.retain_age requires kv_history.retain_count to be set",
+	})
+	run(t, testCase{
+		desc: "kv history negative retain count",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "kv_history": { "retain_count": -1 } }`},
+		hcl:         []string{`kv_history { retain_count = -1 }`},
+		expectedErr: "kv_history.retain_count cannot be negative",
+	})
+	run(t, testCase{
+		desc: "auto snapshot",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+			`-server`,
+		},
+		json: []string{`{ "auto_snapshot": { "interval": "1h", "retain": 24 } }`},
+		hcl:  []string{`auto_snapshot { interval = "1h" retain = 24 }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.ServerMode = true
+			rt.TLS.ServerMode = true
+			rt.LeaveOnTerm = false
+			rt.SkipLeaveOnInt = true
+			rt.RPCConfig.EnableStreaming = true
+			rt.GRPCTLSPort = 8503
+			rt.GRPCTLSAddrs = []net.Addr{defaultGrpcTlsAddr}
+			rt.AutoSnapshotInterval = time.Hour
+			rt.AutoSnapshotPath = filepath.Join(dataDir, "snapshots")
+			rt.AutoSnapshotRetain = 24
+		},
+	})
+	run(t, testCase{
+		desc: "auto snapshot on client",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{ "auto_snapshot": { "interval": "1h", "path": "/backups" } }`},
+		hcl:  []string{`auto_snapshot { interval = "1h" path = "/backups" }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.AutoSnapshotInterval = time.Hour
+			rt.AutoSnapshotPath = "/backups"
+		},
+		expectedWarnings: []string{"auto_snapshot is only used by servers and will be ignored"},
+	})
+	run(t, testCase{
+		desc: "auto snapshot negative retain",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "auto_snapshot": { "retain": -1 } }`},
+		hcl:         []string{`auto_snapshot { retain = -1 }`},
+		expectedErr: "auto_snapshot.retain cannot be negative",
+	})
+	run(t, testCase{
+		desc: "auto snapshot invalid name template",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "auto_snapshot": { "interval": "1h", "name_template": "{{.Datacenter}}.snap" } }`},
+		hcl:         []string{`auto_snapshot { interval = "1h" name_template = "{{.Datacenter}}.snap" }`},
+		expectedErr: "auto_snapshot.name_template is invalid: must use the Index, Timestamp or Unix field",
+	})
+	if runtime.GOOS == "linux" {
+		run(t, testCase{
+			desc: "script check sandbox",
+			args: []string{
+				`-datacenter=a`,
+				`-data-dir=` + dataDir,
+			},
+			json: []string{`{ "script_check_sandbox": { "cgroup_parent": "/sys/fs/cgroup/checks", "memory_max_mb": 64, "user": "nobody", "env_allowlist": ["PATH"] } }`},
+			hcl:  []string{`script_check_sandbox { cgroup_parent = "/sys/fs/cgroup/checks" memory_max_mb = 64 user = "nobody" env_allowlist = ["PATH"] }`},
+			expected: func(rt *RuntimeConfig) {
+				rt.DataDir = dataDir
+				rt.Datacenter = "a"
+				rt.PrimaryDatacenter = "a"
+				rt.ScriptCheckSandbox = exec.Sandbox{
+					CgroupParent: "/sys/fs/cgroup/checks",
+					MemoryMax:    64 * 1024 * 1024,
+					User:         "nobody",
+					EnvAllowlist: []string{"PATH"},
+				}
+			},
+		})
+	} else {
+		run(t, testCase{
+			desc: "script check sandbox on other platforms",
+			args: []string{
+				`-datacenter=a`,
+				`-data-dir=` + dataDir,
+			},
+			json:        []string{`{ "script_check_sandbox": { "user": "nobody" } }`},
+			hcl:         []string{`script_check_sandbox { user = "nobody" }`},
+			expectedErr: "script_check_sandbox is only supported on Linux",
+		})
+	}
+	run(t, testCase{
+		desc: "script check sandbox negative cpu_max",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "script_check_sandbox": { "cgroup_parent": "/sys/fs/cgroup/checks", "cpu_max": -1 } }`},
+		hcl:         []string{`script_check_sandbox { cgroup_parent = "/sys/fs/cgroup/checks" cpu_max = -1 }`},
+		expectedErr: "script_check_sandbox.cpu_max cannot be negative",
+	})
+	run(t, testCase{
+		desc: "script check sandbox limits without cgroup_parent",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "script_check_sandbox": { "cpu_max": 2 } }`},
+		hcl:         []string{`script_check_sandbox { cpu_max = 2 }`},
+		expectedErr: "script_check_sandbox.cgroup_parent must be set to limit CPU or memory",
+	})
+	run(t, testCase{
+		desc: "dns answer ordering invalid",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "dns_config": { "service_answer_ordering": { "web": "fastest" } } }`},
+		hcl:         []string{`dns_config = { service_answer_ordering = { web = "fastest" } }`},
+		expectedErr: `dns_config.service_answer_ordering["web"]: invalid ordering: "fastest"`,
+	})
+	run(t, testCase{
+		desc: "dns rate limit burst defaults to rate",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{ "dns_config": { "rate_limit": { "query_rate": 2.5, "response_rate": 5 } } }`},
+		hcl:  []string{`dns_config = { rate_limit = { query_rate = 2.5 response_rate = 5 } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.DNSRateLimit = RuntimeDNSRateLimitConfig{
+				QueryRate:        2.5,
+				QueryBurst:       3,
+				ResponseRate:     5,
+				ResponseBurst:    5,
+				Slip:             2,
+				IPv4PrefixLength: 24,
+				IPv6PrefixLength: 56,
+			}
+		},
+	})
+	run(t, testCase{
+		desc: "dns rate limit prefix length out of range",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json:        []string{`{ "dns_config": { "rate_limit": { "response_rate": 5, "ipv4_prefix_length": 33 } } }`},
+		hcl:         []string{`dns_config = { rate_limit = { response_rate = 5 ipv4_prefix_length = 33 } }`},
+		expectedErr: "dns_config.rate_limit.ipv4_prefix_length must be between 0 and 32",
+	})
+	run(t, testCase{
+		desc: "dns tls with certificate",
+		args: []string{
+			`-datacenter=a`,
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{ "ports": { "dns_tls": 853 }, "tls": { "https": { "cert_file": "foo", "key_file": "bar" } } }`},
+		hcl:  []string{`ports = { dns_tls = 853 } tls = { https = { cert_file = "foo" key_file = "bar" } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.Datacenter = "a"
+			rt.PrimaryDatacenter = "a"
+			rt.DNSTLSPort = 853
+			rt.DNSTLSAddrs = []net.Addr{tcpAddr("127.0.0.1:853")}
+			rt.TLS.HTTPS.CertFile = "foo"
+			rt.TLS.HTTPS.KeyFile = "bar"
+		},
+	})
 	run(t, testCase{
 		desc: "ui enabled and dir specified",
 		args: []string{
@@ -2648,6 +2918,55 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			rt.DataDir = dataDir
 		},
 	})
+	run(t, testCase{
+		desc: "composite check",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{
+			`{ "check": { "name": "a", "composite_mode": "quorum", "composite_quorum": 3, "composite_members": [
+				{ "service_id": "web", "weight": 2 },
+				{ "node": "db-1", "service_name": "db" },
+				{ "check_id": "mem" }
+			] } }`,
+		},
+		hcl: []string{
+			`check = { name = "a", composite_mode = "quorum", composite_quorum = 3, composite_members = [
+				{ service_id = "web", weight = 2 },
+				{ node = "db-1", service_name = "db" },
+				{ check_id = "mem" },
+			] }`,
+		},
+		expected: func(rt *RuntimeConfig) {
+			rt.Checks = []*structs.CheckDefinition{
+				{
+					Name:            "a",
+					CompositeMode:   "quorum",
+					CompositeQuorum: 3,
+					CompositeMembers: []structs.CompositeCheckMember{
+						{ServiceID: "web", Weight: 2},
+						{Node: "db-1", ServiceName: "db"},
+						{CheckID: "mem"},
+					},
+					OutputMaxSize: checks.DefaultBufSize,
+				},
+			}
+			rt.DataDir = dataDir
+		},
+	})
+	run(t, testCase{
+		desc: "composite check with ttl",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{
+			`{ "check": { "name": "a", "ttl": "10s", "composite_members": [ { "service_id": "web" } ] } }`,
+		},
+		hcl: []string{
+			`check = { name = "a", ttl = "10s", composite_members = [ { service_id = "web" } ] }`,
+		},
+		expectedErr: `TTL must be not be set for Composite checks`,
+	})
 	run(t, testCase{
 		desc: "os_service check no interval",
 		args: []string{
@@ -6330,6 +6649,10 @@ func TestLoad_FullConfig(t *testing.T) {
 		AutopilotRedundancyZoneTag:       "3IsufDJf",
 		AutopilotServerStabilizationTime: 23057 * time.Second,
 		AutopilotUpgradeVersionTag:       "W9pDwFAL",
+		AutoSnapshotInterval:             2873 * time.Second,
+		AutoSnapshotPath:                 "/srv/consul/snapshots",
+		AutoSnapshotRetain:               17,
+		AutoSnapshotNameTemplate:         "{{.Node}}-{{.Index}}.snap",
 		BindAddr:                         ipAddr("16.99.34.17"),
 		BootstrapExpect:                  53,
 		Cache: cache.Options{
@@ -6411,15 +6734,30 @@ func TestLoad_FullConfig(t *testing.T) {
 					"hBq0zn1q": {"2a9o9ZKP", "vKwA5lR6"},
 					"f3r6xFtM": {"RyuIdDWv", "QbxEcIUM"},
 				},
-				Method:                         "Dou0nGT5",
-				Body:                           "5PBQd2OT",
-				DisableRedirects:               true,
+				Method:           "Dou0nGT5",
+				Body:             "5PBQd2OT",
+				DisableRedirects: true,
+				ResponseAssertions: []structs.HTTPResponseAssertion{
+					{JSONPath: "$.qVWm5tTi", Match: "oRa7Cefz", Status: "warning"},
+					{BodyRegex: "Yx2zQz4R", Status: "critical"},
+				},
 				OutputMaxSize:                  checks.DefaultBufSize,
 				TCP:                            "JY6fTTcw",
 				TCPUseTLS:                      false,
 				H2PING:                         "rQ8eyCSF",
 				H2PingUseTLS:                   false,
 				OSService:                      "aZaCAXww",
+				CertExpiry:                     "k3Ptx9Wb",
+				DNS:                            "Vq2nTz8R",
+				DNSServer:                      "Gh4sYd1M",
+				FileFreshness:                  "Lp7eWj3C",
+				Outlier:                        "Rm5cXv8N",
+				WarningThreshold:               17392 * time.Second,
+				CriticalThreshold:              9427 * time.Second,
+				FlapHalfLife:                   3841 * time.Second,
+				FlapMaxSuppress:                27193 * time.Second,
+				FlapSuppressThreshold:          6,
+				FlapReuseThreshold:             2,
 				Interval:                       18714 * time.Second,
 				DockerContainerID:              "qF66POS9",
 				Shell:                          "sOnDy228",
@@ -6519,11 +6857,20 @@ func TestLoad_FullConfig(t *testing.T) {
 		DNSNodeTTL:                       7084 * time.Second,
 		DNSOnlyPassing:                   true,
 		DNSPort:                          7001,
+		DNSTLSAddrs:                      []net.Addr{tcpAddr("93.95.95.82:7853")},
+		DNSTLSPort:                       7853,
+		DNSHTTPSAddrs:                    []net.Addr{tcpAddr("93.95.95.83:7443")},
+		DNSHTTPSPort:                     7443,
 		DNSRecursorStrategy:              "sequential",
 		DNSRecursorTimeout:               4427 * time.Second,
 		DNSRecursors:                     []string{"63.38.39.58", "92.49.18.18"},
 		DNSSOA:                           RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0},
+		DNSSEC:                           RuntimeDNSSECConfig{Enabled: true, PublicKeyFile: "Kq2bvRxA.key", PrivateKeyFile: "Kq2bvRxA.private", SignatureValidity: 34812 * time.Second},
+		DNSRateLimit:                     RuntimeDNSRateLimitConfig{QueryRate: 4183.5, QueryBurst: 9171, ResponseRate: 2237.5, ResponseBurst: 6410, Slip: 5, IPv4PrefixLength: 22, IPv6PrefixLength: 48},
 		DNSServiceTTL:                    map[string]time.Duration{"*": 32030 * time.Second},
+		DNSAnswerOrdering:                structs.DNSAnswerOrderingWeighted,
+		DNSServiceOrdering:               map[string]structs.DNSAnswerOrdering{"*": structs.DNSAnswerOrderingNearest},
+		DNSAnswerSubsetSize:              7194,
 		DNSUDPAnswerLimit:                29909,
 		DNSNodeMetaTXT:                   true,
 		DNSUseCache:                      true,
@@ -6547,6 +6894,15 @@ func TestLoad_FullConfig(t *testing.T) {
 		EnableLocalScriptChecks:          true,
 		EncryptKey:                       "A4wELWqH",
 		Experiments:                      []string{"foo"},
+		ScriptCheckSandbox: exec.Sandbox{
+			CgroupParent:    "/sys/fs/cgroup/consul-checks",
+			CPUMax:          0.5,
+			MemoryMax:       192 * 1024 * 1024,
+			User:            "Hm3rVbQf",
+			Group:           "zX9uWc4k",
+			NoNewPrivileges: true,
+			EnvAllowlist:    []string{"PATH", "LC_*"},
+		},
 		StaticRuntimeConfig: StaticRuntimeConfig{
 			EncryptVerifyIncoming: true,
 			EncryptVerifyOutgoing: true,
@@ -6570,6 +6926,8 @@ func TestLoad_FullConfig(t *testing.T) {
 		HTTPSPort:             15127,
 		HTTPUseCache:          false,
 		KVMaxValueSize:        1234567800,
+		KVHistoryRetainCount:  6741,
+		KVHistoryRetainAge:    41813 * time.Second,
 		LeaveDrainTime:        8265 * time.Second,
 		LeaveOnTerm:           true,
 		Locality: &Locality{
@@ -44,6 +44,8 @@ acl = {
 }
 addresses = {
     dns = "93.95.95.81"
+    dns_tls = "93.95.95.82"
+    dns_https = "93.95.95.83"
     http = "83.39.91.39"
     https = "95.17.17.19"
     grpc = "32.31.61.91"
@@ -89,6 +91,12 @@ autopilot = {
     server_stabilization_time = "23057s"
     upgrade_version_tag = "W9pDwFAL"
 }
+auto_snapshot {
+    interval = "2873s"
+    path = "/srv/consul/snapshots"
+    retain = 17
+    name_template = "{{.Node}}-{{.Index}}.snap"
+}
 bind_addr = "16.99.34.17"
 bootstrap_expect = 53
 cache = {
@@ -115,6 +123,17 @@ check = {
     method = "Dou0nGT5"
     body = "5PBQd2OT"
     disable_redirects = true
+    response_assertions = [
+        {
+            json_path = "$.qVWm5tTi"
+            match = "oRa7Cefz"
+            status = "warning"
+        },
+        {
+            body_regex = "Yx2zQz4R"
+            status = "critical"
+        },
+    ]
     tcp = "JY6fTTcw"
     h2ping = "rQ8eyCSF"
     h2ping_use_tls = false
@@ -123,6 +142,17 @@ check = {
     docker_container_id = "qF66POS9"
     shell = "sOnDy228"
     os_service = "aZaCAXww"
+    cert_expiry = "k3Ptx9Wb"
+    dns = "Vq2nTz8R"
+    dns_server = "Gh4sYd1M"
+    file_freshness = "Lp7eWj3C"
+    outlier = "Rm5cXv8N"
+    warning_threshold = "17392s"
+    critical_threshold = "9427s"
+    flap_half_life = "3841s"
+    flap_max_suppress = "27193s"
+    flap_suppress_threshold = 6
+    flap_reuse_threshold = 2
     tls_server_name = "7BdnzBYk"
     tls_skip_verify = true
     timeout = "5954s"
@@ -261,12 +291,17 @@ alt_domain = "1789hsd"
 dns_config {
     allow_stale = true
     a_record_limit = 29907
+    answer_ordering = "weighted"
+    answer_subset_size = 7194
     disable_compression = true
     enable_truncate = true
     max_stale = "29685s"
     node_ttl = "7084s"
     only_passing = true
     recursor_timeout = "4427s"
+    service_answer_ordering = {
+        "*" = "nearest"
+    }
     service_ttl = {
         "*" = "32030s"
     }
@@ -274,6 +309,21 @@ dns_config {
     use_cache = true
     cache_max_age = "5m"
     prefer_namespace = true
+    dnssec {
+        enabled = true
+        public_key_file = "Kq2bvRxA.key"
+        private_key_file = "Kq2bvRxA.private"
+        signature_validity = "34812s"
+    }
+    rate_limit {
+        query_rate = 4183.5
+        query_burst = 9171
+        response_rate = 2237.5
+        response_burst = 6410
+        slip = 5
+        ipv4_prefix_length = 22
+        ipv6_prefix_length = 48
+    }
 }
 enable_acl_replication = true
 enable_agent_tls_for_checks = true
@@ -299,6 +349,10 @@ http_config {
     max_header_bytes = 10
 }
 key_file = "IEkkwgIA"
+kv_history {
+    retain_count = 6741
+    retain_age = "41813s"
+}
 leave_on_terminate = true
 license_path = "/path/to/license.lic"
 limits {
@@ -346,6 +400,8 @@ performance {
 pid_file = "43xN80Km"
 ports {
     dns = 7001
+    dns_tls = 7853
+    dns_https = 7443
     http = 7999
     https = 15127
     server = 3757
@@ -399,6 +455,15 @@ retry_max_wan = 23160
 rpc {
     enable_streaming = true
 }
+script_check_sandbox {
+    cgroup_parent = "/sys/fs/cgroup/consul-checks"
+    cpu_max = 0.5
+    memory_max_mb = 192
+    user = "Hm3rVbQf"
+    group = "zX9uWc4k"
+    no_new_privileges = true
+    env_allowlist = [ "PATH", "LC_*" ]
+}
 segment_limit = 123
 serf_lan = "99.43.63.15"
 serf_wan = "67.88.33.19"
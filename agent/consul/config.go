@@ -263,6 +263,33 @@ type Config struct {
 	// to reduce overhead. It is unlikely a user would ever need to tune this.
 	TombstoneTTLGranularity time.Duration
 
+	// AutoSnapshotInterval is how often the leader saves a snapshot of the
+	// state to AutoSnapshotPath. Automatic snapshots are disabled when it is
+	// zero.
+	AutoSnapshotInterval time.Duration
+
+	// AutoSnapshotPath is the local directory automatic snapshots are saved
+	// to.
+	AutoSnapshotPath string
+
+	// AutoSnapshotRetain is the number of automatic snapshots to keep, or
+	// zero to keep all of them.
+	AutoSnapshotRetain int
+
+	// AutoSnapshotNameTemplate names the files of automatic snapshots. See
+	// ParseAutoSnapshotNameTemplate.
+	AutoSnapshotNameTemplate string
+
+	// KVHistoryRetainCount is the number of revisions of each KV entry to
+	// keep. The leader shares it with the other servers through the system
+	// metadata, and KV history is disabled when it is zero.
+	KVHistoryRetainCount int
+
+	// KVHistoryRetainAge is how long revisions of KV entries are kept before
+	// the leader deletes them. Zero keeps them until they are pushed out by
+	// KVHistoryRetainCount.
+	KVHistoryRetainAge time.Duration
+
 	// Minimum Session TTL
 	SessionTTLMin time.Duration
 
@@ -547,6 +574,9 @@ func DefaultConfig() *Config {
 		// to the max possible duration (approx 290 years).
 		ACLTokenMaxExpirationTTL: 1<<63 - 1,
 
+		AutoSnapshotRetain:       30,
+		AutoSnapshotNameTemplate: DefaultAutoSnapshotNameTemplate,
+
 		// These are tuned to provide a total throughput of 128 updates
 		// per second. If you update these, you should update the client-side
 		// SyncCoordinateRateTarget parameter accordingly.
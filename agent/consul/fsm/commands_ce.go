@@ -42,6 +42,10 @@ var CommandsSummaries = []prometheus.SummaryDefinition{
 		Name: []string{"fsm", "tombstone"},
 		Help: "Measures the time it takes to apply the given tombstone operation to the FSM.",
 	},
+	{
+		Name: []string{"fsm", "kvs_revision"},
+		Help: "Measures the time it takes to apply the given KV revision operation to the FSM.",
+	},
 	{
 		Name: []string{"fsm", "coordinate", "batch-update"},
 		Help: "Measures the time it takes to apply the given batch coordinate update to the FSM.",
@@ -148,6 +152,7 @@ func init() {
 	registerCommand(structs.PeeringSecretsWriteType, (*FSM).applyPeeringSecretsWrite)
 	registerCommand(structs.ResourceOperationType, (*FSM).applyResourceOperation)
 	registerCommand(structs.UpdateVirtualIPRequestType, (*FSM).applyManualVirtualIPs)
+	registerCommand(structs.KVSRevisionRequestType, (*FSM).applyKVSRevisionOperation)
 }
 
 func (c *FSM) applyRegister(buf []byte, index uint64) interface{} {
@@ -217,15 +222,15 @@ func (c *FSM) applyKVSOperation(buf []byte, index uint64) interface{} {
 	case api.KVSet:
 		return c.state.KVSSet(index, &req.DirEnt)
 	case api.KVDelete:
-		return c.state.KVSDelete(index, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta)
+		return c.state.KVSDelete(index, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta, req.DirEnt.RevisionTime)
 	case api.KVDeleteCAS:
-		act, err := c.state.KVSDeleteCAS(index, req.DirEnt.ModifyIndex, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta)
+		act, err := c.state.KVSDeleteCAS(index, req.DirEnt.ModifyIndex, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta, req.DirEnt.RevisionTime)
 		if err != nil {
 			return err
 		}
 		return act
 	case api.KVDeleteTree:
-		return c.state.KVSDeleteTree(index, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta)
+		return c.state.KVSDeleteTree(index, req.DirEnt.Key, &req.DirEnt.EnterpriseMeta, req.DirEnt.RevisionTime)
 	case api.KVCAS:
 		act, err := c.state.KVSSetCAS(index, &req.DirEnt)
 		if err != nil {
@@ -296,6 +301,22 @@ func (c *FSM) applyTombstoneOperation(buf []byte, index uint64) interface{} {
 	}
 }
 
+func (c *FSM) applyKVSRevisionOperation(buf []byte, index uint64) interface{} {
+	var req structs.KVSRevisionRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "kvs_revision"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: string(req.Op)}})
+	switch req.Op {
+	case structs.KVSRevisionReap:
+		return c.state.KVSRevisionsReap(index, req.Revisions)
+	default:
+		c.logger.Warn("Invalid KVS revision operation", "operation", req.Op)
+		return fmt.Errorf("Invalid KVS revision operation '%s'", req.Op)
+	}
+}
+
 // applyCoordinateBatchUpdate processes a batch of coordinate updates and applies
 // them in a single underlying transaction. This interface isn't 1:1 with the outer
 // update interface that the coordinate endpoint exposes, so we made it single
@@ -963,7 +963,7 @@ func TestFSM_TombstoneReap(t *testing.T) {
 		t.Fatalf("err: %v", err)
 	}
 
-	err = fsm.state.KVSDelete(12, "/remove", nil)
+	err = fsm.state.KVSDelete(12, "/remove", nil, nil)
 	if err != nil {
 		t.Fatalf("err: %v", err)
 	}
@@ -1003,6 +1003,39 @@ func TestFSM_TombstoneReap(t *testing.T) {
 	}
 }
 
+func TestFSM_KVSRevisionReap(t *testing.T) {
+	t.Parallel()
+	logger := testutil.Logger(t)
+	fsm, err := New(nil, logger)
+	require.NoError(t, err)
+
+	require.NoError(t, fsm.state.SystemMetadataSet(1, &structs.SystemMetadataEntry{
+		Key:   structs.SystemMetadataKVHistoryRetainCount,
+		Value: "5",
+	}))
+	require.NoError(t, fsm.state.KVSSet(2, &structs.DirEntry{Key: "foo", Value: []byte("one")}))
+	require.NoError(t, fsm.state.KVSSet(3, &structs.DirEntry{Key: "foo", Value: []byte("two")}))
+
+	req := structs.KVSRevisionRequest{
+		Datacenter: "dc1",
+		Op:         structs.KVSRevisionReap,
+		Revisions: structs.KVSRevisions{
+			{DirEntry: structs.DirEntry{Key: "foo", RaftIndex: structs.RaftIndex{ModifyIndex: 2}}},
+		},
+	}
+	buf, err := structs.Encode(structs.KVSRevisionRequestType, req)
+	require.NoError(t, err)
+	resp := fsm.Apply(makeLog(buf))
+	if err, ok := resp.(error); ok {
+		t.Fatalf("resp: %v", err)
+	}
+
+	_, revs, err := fsm.state.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	require.EqualValues(t, 3, revs[0].ModifyIndex)
+}
+
 func TestFSM_Txn(t *testing.T) {
 	t.Parallel()
 	logger := testutil.Logger(t)
@@ -22,6 +22,7 @@ func init() {
 	registerRestorer(structs.RegisterRequestType, restoreRegistration)
 	registerRestorer(structs.KVSRequestType, restoreKV)
 	registerRestorer(structs.TombstoneRequestType, restoreTombstone)
+	registerRestorer(structs.KVSRevisionRequestType, restoreKVSRevision)
 	registerRestorer(structs.SessionRequestType, restoreSession)
 	registerRestorer(structs.CoordinateBatchUpdateType, restoreCoordinates)
 	registerRestorer(structs.PreparedQueryRequestType, restorePreparedQuery)
@@ -65,6 +66,9 @@ func persistCE(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) erro
 	if err := s.persistTombstones(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistKVSRevisions(sink, encoder); err != nil {
+		return err
+	}
 	if err := s.persistPreparedQueries(sink, encoder); err != nil {
 		return err
 	}
@@ -298,6 +302,24 @@ func (s *snapshot) persistKVs(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *snapshot) persistKVSRevisions(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	revs, err := s.state.KVSRevisions()
+	if err != nil {
+		return err
+	}
+
+	for rev := revs.Next(); rev != nil; rev = revs.Next() {
+		if _, err := sink.Write([]byte{byte(structs.KVSRevisionRequestType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(rev.(*structs.KVSRevision)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *snapshot) persistTombstones(sink raft.SnapshotSink,
 	encoder *codec.Encoder) error {
 	stones, err := s.state.Tombstones()
@@ -672,6 +694,17 @@ func restoreTombstone(header *SnapshotHeader, restore *state.Restore, decoder *c
 	return nil
 }
 
+func restoreKVSRevision(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.KVSRevision
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.KVSRevision(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
 func restoreSession(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	var req structs.Session
 	if err := decoder.Decode(&req); err != nil {
@@ -171,7 +171,7 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 		Key:   "/remove",
 		Value: []byte("foo"),
 	})
-	fsm.state.KVSDelete(12, "/remove", nil)
+	fsm.state.KVSDelete(12, "/remove", nil, nil)
 	idx, _, err := fsm.state.KVSList(nil, "/remove", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, 12, idx, "bad index")
@@ -1025,6 +1025,52 @@ func TestFSM_BadSnapshot_NilCAConfig(t *testing.T) {
 	require.Nil(t, config)
 }
 
+func TestFSM_SnapshotRestore_KVSRevisions(t *testing.T) {
+	t.Parallel()
+
+	logger := testutil.Logger(t)
+	newFSM := func() *FSM {
+		return NewFromDeps(Deps{
+			Logger: logger,
+			NewStateStore: func() *state.Store {
+				return state.NewStateStore(nil)
+			},
+			StorageBackend: newStorageBackend(t, nil),
+		})
+	}
+	fsm := newFSM()
+
+	require.NoError(t, fsm.state.SystemMetadataSet(1, &structs.SystemMetadataEntry{
+		Key:   structs.SystemMetadataKVHistoryRetainCount,
+		Value: "5",
+	}))
+	require.NoError(t, fsm.state.KVSSet(2, &structs.DirEntry{Key: "foo", Value: []byte("one")}))
+	require.NoError(t, fsm.state.KVSSet(3, &structs.DirEntry{Key: "foo", Value: []byte("two")}))
+	require.NoError(t, fsm.state.KVSDelete(4, "foo", nil, nil))
+
+	// Snapshot
+	snap, err := fsm.Snapshot()
+	require.NoError(t, err)
+	defer snap.Release()
+
+	// Persist
+	buf := bytes.NewBuffer(nil)
+	sink := &MockSink{buf, false}
+	require.NoError(t, snap.Persist(sink))
+
+	// Do a restore on a new FSM
+	fsm2 := newFSM()
+	require.NoError(t, fsm2.Restore(sink))
+
+	idx, revs, err := fsm2.state.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, idx)
+	require.Len(t, revs, 3)
+	require.True(t, revs[0].Deleted)
+	require.Equal(t, []byte("two"), revs[1].Value)
+	require.Equal(t, []byte("one"), revs[2].Value)
+}
+
 // This test asserts that ServiceVirtualIP, which made a breaking change
 // in 1.13.0, can still restore from older snapshots which use the old
 // state.ServiceVirtualIP type.
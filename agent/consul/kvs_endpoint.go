@@ -38,7 +38,7 @@ type KVS struct {
 // must only be done on the leader.
 func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api.KVOp, dirEnt *structs.DirEntry) (bool, error) {
 	// Verify the entry.
-	if dirEnt.Key == "" && op != api.KVDeleteTree {
+	if dirEnt.Key == "" && op != api.KVDeleteTree && op != api.KVComparePrefixCount {
 		return false, fmt.Errorf("Must provide key")
 	}
 
@@ -56,7 +56,7 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api
 	case api.KVGet, api.KVGetTree, api.KVGetOrEmpty:
 		// Filtering for GETs is done on the output side.
 
-	case api.KVCheckSession, api.KVCheckIndex:
+	case api.KVCheckSession, api.KVCheckIndex, api.KVCompareValue:
 		// These could reveal information based on the outcome
 		// of the transaction, and they operate on individual
 		// keys so we check them here.
@@ -67,6 +67,16 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api
 			return false, err
 		}
 
+	case api.KVComparePrefixCount:
+		// This reveals how many keys there are under the prefix, which
+		// is what listing the prefix does.
+		var authzContext acl.AuthorizerContext
+		dirEnt.FillAuthzContext(&authzContext)
+
+		if err := authz.ToAllowAuthorizer().KeyListAllowed(dirEnt.Key, &authzContext); err != nil {
+			return false, err
+		}
+
 	case api.KVCheckNotExists, api.KVUnlock, api.KVLock, api.KVCAS, api.KVDeleteCAS, api.KVDelete, api.KVSet:
 		var authzContext acl.AuthorizerContext
 		dirEnt.FillAuthzContext(&authzContext)
@@ -78,6 +88,34 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api
 		return false, fmt.Errorf("unknown KV operation: %s", op)
 	}
 
+	// The KV history records changes at the wall-time of the leader, which
+	// is part of the Raft log so that all peers agree on it.
+	now := time.Now().UTC()
+	dirEnt.RevisionTime = &now
+
+	// Entries that are written get their expiry from their TTL. Like the
+	// lock-delay below, this uses the wall-time of the leader and the expiry
+	// is part of the Raft log, so that all peers agree on it.
+	dirEnt.Expires = nil
+	if dirEnt.TTL != "" {
+		switch op {
+		case api.KVSet, api.KVCAS, api.KVLock, api.KVUnlock:
+		default:
+			return false, fmt.Errorf("TTL is not supported by the %s operation", op)
+		}
+		// Older servers would keep the entry forever since they don't
+		// track its expiry, and can't apply the reaping transaction.
+		if ok, _ := ServersInDCMeetMinimumVersion(srv, srv.config.Datacenter, minKVSTTLVersion); !ok {
+			return false, fmt.Errorf("all servers must be >= %s to set a TTL on a key", minKVSTTLVersion.String())
+		}
+		ttl, err := time.ParseDuration(dirEnt.TTL)
+		if err != nil || ttl <= 0 {
+			return false, fmt.Errorf("Invalid TTL: %q", dirEnt.TTL)
+		}
+		expires := now.Add(ttl)
+		dirEnt.Expires = &expires
+	}
+
 	// If this is a lock, we must check for a lock-delay. Since lock-delay
 	// is based on wall-time, each peer would expire the lock-delay at a slightly
 	// different time. This means the enforcement of lock-delay cannot be done
@@ -178,6 +216,45 @@ func (k *KVS) Get(args *structs.KeyRequest, reply *structs.IndexedDirEntries) er
 		})
 }
 
+// History is used to look up the recorded revisions of a key, newest first.
+func (k *KVS) History(args *structs.KeyRequest, reply *structs.IndexedKVSRevisions) error {
+	if done, err := k.srv.ForwardRPC("KVS.History", args, reply); done {
+		return err
+	}
+
+	if args.Key == "" {
+		return fmt.Errorf("Must provide a key")
+	}
+
+	var authzContext acl.AuthorizerContext
+	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return err
+	}
+
+	if err := k.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	if err := authz.ToAllowAuthorizer().KeyReadAllowed(args.Key, &authzContext); err != nil {
+		return err
+	}
+
+	return k.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, revs, err := state.KVSRevisions(ws, args.Key, &args.EnterpriseMeta)
+			if err != nil {
+				return err
+			}
+
+			reply.Index = index
+			reply.Revisions = revs
+			return nil
+		})
+}
+
 // List is used to list all keys with a given prefix.
 func (k *KVS) List(args *structs.KeyRequest, reply *structs.IndexedDirEntries) error {
 	if done, err := k.srv.ForwardRPC("KVS.List", args, reply); done {
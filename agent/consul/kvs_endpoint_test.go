@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 )
 
@@ -79,6 +80,114 @@ func TestKVS_Apply(t *testing.T) {
 	}
 }
 
+func TestKVS_Apply_TTL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1")
+
+	arg := structs.KVSRequest{
+		Datacenter: "dc1",
+		Op:         api.KVSet,
+		DirEnt: structs.DirEntry{
+			Key:   "test",
+			Value: []byte("test"),
+			TTL:   "1h",
+		},
+	}
+	var out bool
+	start := time.Now()
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+
+	// The expiry is computed by the leader from the TTL.
+	state := s1.fsm.State()
+	_, d, err := state.KVSGet(nil, "test", &arg.DirEnt.EnterpriseMeta)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	require.Equal(t, "1h", d.TTL)
+	require.NotNil(t, d.Expires)
+	require.WithinDuration(t, start.Add(time.Hour), *d.Expires, time.Minute)
+
+	// A client supplied expiry is ignored.
+	arg.DirEnt.TTL = ""
+	arg.DirEnt.Expires = &start
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+	_, d, err = state.KVSGet(nil, "test", &arg.DirEnt.EnterpriseMeta)
+	require.NoError(t, err)
+	require.Empty(t, d.TTL)
+	require.Nil(t, d.Expires)
+
+	// Invalid TTLs are rejected.
+	for _, ttl := range []string{"nope", "0s", "-1m"} {
+		arg.DirEnt.TTL = ttl
+		err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Invalid TTL")
+	}
+
+	// TTLs are only supported when writing a value.
+	arg.Op = api.KVDelete
+	arg.DirEnt.TTL = "1h"
+	err = msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "TTL is not supported by the delete operation")
+}
+
+func TestKVS_Apply_TTL_MinVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Bootstrap = false
+		c.Build = "1.21.0"
+	})
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	joinLAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	arg := structs.KVSRequest{
+		Datacenter: "dc1",
+		Op:         api.KVSet,
+		DirEnt: structs.DirEntry{
+			Key:   "test",
+			Value: []byte("test"),
+			TTL:   "1h",
+		},
+	}
+	var out bool
+	retry.Run(t, func(r *retry.R) {
+		err := msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out)
+		require.ErrorContains(r, err, "all servers must be >= 1.22.0 to set a TTL on a key")
+	})
+
+	// Writes without a TTL are still allowed.
+	arg.DirEnt.TTL = ""
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+	_, d, err := s1.fsm.State().KVSGet(nil, "test", &arg.DirEnt.EnterpriseMeta)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	require.Nil(t, d.Expires)
+}
+
 func TestKVS_Apply_ACLDeny(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -229,6 +338,73 @@ func TestKVS_Get_ACLDeny(t *testing.T) {
 
 }
 
+func TestKVS_History(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+		c.KVHistoryRetainCount = 2
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1", testrpc.WithToken("root"))
+
+	set := func(t require.TestingT, value string) {
+		arg := structs.KVSRequest{
+			Datacenter:   "dc1",
+			Op:           api.KVSet,
+			DirEnt:       structs.DirEntry{Key: "zip", Value: []byte(value)},
+			WriteRequest: structs.WriteRequest{Token: "root"},
+		}
+		var out bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+	}
+	history := func(token string) (structs.IndexedKVSRevisions, error) {
+		getR := structs.KeyRequest{
+			Datacenter:   "dc1",
+			Key:          "zip",
+			QueryOptions: structs.QueryOptions{Token: token},
+		}
+		var out structs.IndexedKVSRevisions
+		err := msgpackrpc.CallWithCodec(codec, "KVS.History", &getR, &out)
+		return out, err
+	}
+
+	// Recording starts once the leader has shared the retain count.
+	retry.Run(t, func(r *retry.R) {
+		set(r, "one")
+		out, err := history("root")
+		require.NoError(r, err)
+		require.NotEmpty(r, out.Revisions)
+	})
+	start := time.Now()
+	set(t, "two")
+	set(t, "three")
+
+	out, err := history("root")
+	require.NoError(t, err)
+	require.NotZero(t, out.Index)
+	require.Len(t, out.Revisions, 2)
+	require.Equal(t, []byte("three"), out.Revisions[0].Value)
+	require.Equal(t, []byte("two"), out.Revisions[1].Value)
+
+	// The revisions are recorded at the time the leader accepted them.
+	require.False(t, out.Revisions[1].Time.Before(start))
+	require.False(t, out.Revisions[0].Time.Before(out.Revisions[1].Time))
+
+	_, err = history("")
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+}
+
 func TestKVSEndpoint_List(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
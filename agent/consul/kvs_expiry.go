@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-version"
+	"golang.org/x/time/rate"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// kvsReapingRateLimit is the number of batch KV reaping requests per
+	// second allowed.
+	kvsReapingRateLimit rate.Limit = 1.0
+
+	// kvsReapingBurst is the number of batch KV reaping requests per second
+	// that can burst after a period of idleness.
+	kvsReapingBurst = 5
+
+	// kvsBatchDeleteSize is the number of expired KV entries to delete in a
+	// single Raft transaction.
+	kvsBatchDeleteSize = 1024
+)
+
+// minKVSTTLVersion is the minimum version for all Consul servers for KV
+// entries to have a TTL, since they are reaped with conditional transaction
+// operations.
+var minKVSTTLVersion = version.Must(version.NewVersion("1.22.0"))
+
+func (s *Server) reapExpiredKVsLoop(ctx context.Context) error {
+	limiter := rate.NewLimiter(kvsReapingRateLimit, kvsReapingBurst)
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		if ok, _ := ServersInDCMeetMinimumVersion(s, s.config.Datacenter, minKVSTTLVersion); !ok {
+			continue
+		}
+		if _, err := s.reapExpiredKVs(); err != nil {
+			s.logger.Error("error reaping expired KV entries", "error", err)
+		}
+	}
+}
+
+func (s *Server) startKVSReaping(ctx context.Context) {
+	s.leaderRoutineManager.Start(ctx, kvsReapingRoutineName, s.reapExpiredKVsLoop)
+}
+
+func (s *Server) stopKVSReaping() {
+	s.leaderRoutineManager.Stop(kvsReapingRoutineName)
+}
+
+// reapExpiredKVs deletes a batch of the KV entries whose TTL has passed, and
+// returns the number of entries it deleted.
+func (s *Server) reapExpiredKVs() (int, error) {
+	entries, err := s.fsm.State().KVSListExpired(time.Now(), kvsBatchDeleteSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+	defer metrics.MeasureSince([]string{"leader", "reapExpiredKVs"}, time.Now())
+
+	// An entry may have been written again since it was listed, which also
+	// sets its expiry again, so each entry is only deleted if it is unchanged.
+	now := time.Now().UTC()
+	req := structs.TxnRequest{Ops: make(structs.TxnOps, 0, len(entries))}
+	for _, entry := range entries {
+		req.Ops = append(req.Ops, &structs.TxnOp{
+			If: &structs.TxnIfOp{
+				Conditions: structs.TxnOps{
+					{
+						KV: &structs.TxnKVOp{
+							Verb: api.KVCheckIndex,
+							DirEnt: structs.DirEntry{
+								Key:            entry.Key,
+								EnterpriseMeta: entry.EnterpriseMeta,
+								RaftIndex:      structs.RaftIndex{ModifyIndex: entry.ModifyIndex},
+							},
+						},
+					},
+				},
+				Then: structs.TxnOps{
+					{
+						KV: &structs.TxnKVOp{
+							Verb: api.KVDelete,
+							DirEnt: structs.DirEntry{
+								Key:            entry.Key,
+								RevisionTime:   &now,
+								EnterpriseMeta: entry.EnterpriseMeta,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	s.logger.Info("deleting expired KV entries", "amount", len(entries))
+
+	resp, err := s.leaderRaftApply("Txn.Apply", structs.TxnRequestType, &req)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to apply KV expiration deletions: %v", err)
+	}
+	if txnResp, ok := resp.(structs.TxnResponse); ok && len(txnResp.Errors) > 0 {
+		return 0, fmt.Errorf("Failed to apply KV expiration deletions: %v", txnResp.Error())
+	}
+
+	return len(entries), nil
+}
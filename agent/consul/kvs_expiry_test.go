@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestKVSReap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	set := func(t *testing.T, key, ttl string) {
+		t.Helper()
+		arg := structs.KVSRequest{
+			Datacenter: "dc1",
+			Op:         api.KVSet,
+			DirEnt: structs.DirEntry{
+				Key:   key,
+				Value: []byte(key),
+				TTL:   ttl,
+			},
+		}
+		var out bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+	}
+	exists := func(t *testing.T, key string) bool {
+		t.Helper()
+		_, d, err := s1.fsm.State().KVSGet(nil, key, nil)
+		require.NoError(t, err)
+		return d != nil
+	}
+
+	t.Run("nothing expired", func(t *testing.T) {
+		set(t, "forever", "")
+		set(t, "later", "1h")
+
+		n, err := s1.reapExpiredKVs()
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+		require.True(t, exists(t, "forever"))
+		require.True(t, exists(t, "later"))
+	})
+
+	t.Run("expired keys are deleted by the leader", func(t *testing.T) {
+		set(t, "short", "1s")
+		require.True(t, exists(t, "short"))
+
+		retry.Run(t, func(r *retry.R) {
+			_, d, err := s1.fsm.State().KVSGet(nil, "short", nil)
+			require.NoError(r, err)
+			require.Nil(r, d)
+		})
+		require.True(t, exists(t, "forever"))
+		require.True(t, exists(t, "later"))
+	})
+
+	t.Run("refreshed keys are kept", func(t *testing.T) {
+		set(t, "refreshed", "1s")
+		set(t, "refreshed", "1h")
+
+		time.Sleep(2 * time.Second)
+
+		n, err := s1.reapExpiredKVs()
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+		require.True(t, exists(t, "refreshed"))
+	})
+}
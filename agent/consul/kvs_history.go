@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-version"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// kvsHistoryInterval is how often the leader checks the KV history
+	// settings and deletes the revisions older than the retention age.
+	kvsHistoryInterval = 10 * time.Second
+
+	// kvsRevisionsBatchDeleteSize is the number of KV revisions to delete in
+	// a single Raft transaction.
+	kvsRevisionsBatchDeleteSize = 1024
+)
+
+// minKVSHistoryVersion is the minimum version for all Consul servers for KV
+// history to be enabled. Older servers don't record revisions, and can't
+// restore them from snapshots.
+var minKVSHistoryVersion = version.Must(version.NewVersion("1.22.0"))
+
+func (s *Server) startKVSHistory(ctx context.Context) {
+	s.leaderRoutineManager.Start(ctx, kvsHistoryRoutineName, s.runKVSHistory)
+}
+
+func (s *Server) stopKVSHistory() {
+	s.leaderRoutineManager.Stop(kvsHistoryRoutineName)
+}
+
+func (s *Server) runKVSHistory(ctx context.Context) error {
+	ticker := time.NewTicker(kvsHistoryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.setKVSHistoryRetainCount(); err != nil {
+			s.logger.Error("error setting the KV history retain count", "error", err)
+		} else if err := s.reapKVSRevisions(); err != nil {
+			s.logger.Error("error reaping KV revisions", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// setKVSHistoryRetainCount shares the configured retain count with the other
+// servers through the system metadata, which enables the recording of KV
+// revisions in the state store.
+func (s *Server) setKVSHistoryRetainCount() error {
+	current, err := s.GetSystemMetadata(structs.SystemMetadataKVHistoryRetainCount)
+	if err != nil {
+		return err
+	}
+
+	count := s.config.KVHistoryRetainCount
+	switch {
+	case count <= 0 && current != "":
+		return s.deleteSystemMetadataKey(structs.SystemMetadataKVHistoryRetainCount)
+	case count > 0 && current != strconv.Itoa(count):
+		if ok, _ := ServersInDCMeetMinimumVersion(s, s.config.Datacenter, minKVSHistoryVersion); !ok {
+			return fmt.Errorf("can't enable KV history until all servers >= %s", minKVSHistoryVersion.String())
+		}
+		return s.SetSystemMetadataKey(structs.SystemMetadataKVHistoryRetainCount, strconv.Itoa(count))
+	}
+	return nil
+}
+
+// reapKVSRevisions deletes the KV revisions that are older than the retention
+// age, or all of them if KV history is disabled.
+func (s *Server) reapKVSRevisions() error {
+	var asOf time.Time
+	switch {
+	case s.config.KVHistoryRetainCount <= 0:
+		asOf = time.Now()
+	case s.config.KVHistoryRetainAge > 0:
+		asOf = time.Now().Add(-s.config.KVHistoryRetainAge)
+	default:
+		return nil
+	}
+
+	for {
+		n, err := s.reapKVSRevisionsBatch(asOf)
+		if err != nil {
+			return err
+		}
+		if n < kvsRevisionsBatchDeleteSize {
+			return nil
+		}
+	}
+}
+
+func (s *Server) reapKVSRevisionsBatch(asOf time.Time) (int, error) {
+	revs, err := s.fsm.State().KVSRevisionsListExpired(asOf, kvsRevisionsBatchDeleteSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(revs) == 0 {
+		return 0, nil
+	}
+	defer metrics.MeasureSince([]string{"leader", "reapKVSRevisions"}, time.Now())
+
+	// Only the identity of the revisions is needed to delete them.
+	req := structs.KVSRevisionRequest{
+		Datacenter: s.config.Datacenter,
+		Op:         structs.KVSRevisionReap,
+		Revisions:  make(structs.KVSRevisions, 0, len(revs)),
+	}
+	for _, rev := range revs {
+		req.Revisions = append(req.Revisions, &structs.KVSRevision{
+			DirEntry: structs.DirEntry{
+				Key:            rev.Key,
+				EnterpriseMeta: rev.EnterpriseMeta,
+				RaftIndex:      structs.RaftIndex{ModifyIndex: rev.ModifyIndex},
+			},
+		})
+	}
+
+	s.logger.Debug("deleting KV revisions", "amount", len(revs))
+
+	// Revisions only exist once all servers know about them, but older
+	// servers that join later can safely ignore their deletion.
+	typ := structs.KVSRevisionRequestType | structs.IgnoreUnknownTypeFlag
+	if _, err := s.leaderRaftApply("KVS.ReapRevisions", typ, &req); err != nil {
+		return 0, fmt.Errorf("Failed to apply KV revision deletions: %v", err)
+	}
+	return len(revs), nil
+}
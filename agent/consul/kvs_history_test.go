@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestKVSHistory_Reap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.KVHistoryRetainCount = 10
+		c.KVHistoryRetainAge = time.Second
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	set := func(t require.TestingT, key string) {
+		arg := structs.KVSRequest{
+			Datacenter: "dc1",
+			Op:         api.KVSet,
+			DirEnt:     structs.DirEntry{Key: key, Value: []byte(key)},
+		}
+		var out bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out))
+	}
+	revisions := func(t require.TestingT, key string) structs.KVSRevisions {
+		_, revs, err := s1.fsm.State().KVSRevisions(nil, key, nil)
+		require.NoError(t, err)
+		return revs
+	}
+
+	// Recording starts once the leader has shared the retain count.
+	retry.Run(t, func(r *retry.R) {
+		set(r, "old")
+		require.NotEmpty(r, revisions(r, "old"))
+	})
+
+	time.Sleep(2 * time.Second)
+	set(t, "new")
+
+	require.NoError(t, s1.reapKVSRevisions())
+	require.Empty(t, revisions(t, "old"))
+	require.Len(t, revisions(t, "new"), 1)
+
+	// The key itself is not affected by reaping its history.
+	_, d, err := s1.fsm.State().KVSGet(nil, "old", nil)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+}
+
+func TestKVSHistory_Disabled(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Revisions left over from when history was enabled are purged.
+	state := s1.fsm.State()
+	require.NoError(t, state.SystemMetadataSet(1, &structs.SystemMetadataEntry{
+		Key:   structs.SystemMetadataKVHistoryRetainCount,
+		Value: "5",
+	}))
+	require.NoError(t, state.KVSSet(2, &structs.DirEntry{Key: "foo", Value: []byte("bar")}))
+
+	require.NoError(t, s1.setKVSHistoryRetainCount())
+	require.NoError(t, s1.reapKVSRevisions())
+
+	_, revs, err := state.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Empty(t, revs)
+
+	count, err := s1.GetSystemMetadata(structs.SystemMetadataKVHistoryRetainCount)
+	require.NoError(t, err)
+	require.Empty(t, count)
+}
+
+func TestKVSHistory_MinVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.KVHistoryRetainCount = 5
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Bootstrap = false
+		c.Build = "1.21.0"
+	})
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	joinLAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	retry.Run(t, func(r *retry.R) {
+		require.Len(r, s1.LANMembersInAgentPartition(), 2)
+	})
+
+	// The leader may have enabled history before the older server joined.
+	require.NoError(t, s1.deleteSystemMetadataKey(structs.SystemMetadataKVHistoryRetainCount))
+
+	// History stays disabled while an older server is in the datacenter.
+	retry.Run(t, func(r *retry.R) {
+		err := s1.setKVSHistoryRetainCount()
+		require.ErrorContains(r, err, "can't enable KV history until all servers >= 1.22.0")
+	})
+
+	count, err := s1.GetSystemMetadata(structs.SystemMetadataKVHistoryRetainCount)
+	require.NoError(t, err)
+	require.Empty(t, count)
+}
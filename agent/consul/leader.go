@@ -45,6 +45,18 @@ var LeaderSummaries = []prometheus.SummaryDefinition{
 		Name: []string{"leader", "reapTombstones"},
 		Help: "Measures the time spent clearing tombstones.",
 	},
+	{
+		Name: []string{"leader", "reapExpiredKVs"},
+		Help: "Measures the time spent deleting KV entries whose TTL has passed.",
+	},
+	{
+		Name: []string{"leader", "reapKVSRevisions"},
+		Help: "Measures the time spent deleting KV revisions that are older than the retention age.",
+	},
+	{
+		Name: []string{"leader", "auto_snapshot"},
+		Help: "Measures the time spent saving an automatic snapshot.",
+	},
 }
 
 const (
@@ -304,6 +316,14 @@ func (s *Server) establishLeadership(ctx context.Context) error {
 		return err
 	}
 
+	// Start deleting the KV entries whose TTL has passed. Like the session
+	// timers, this is the responsibility of the leader.
+	s.startKVSReaping(ctx)
+
+	// Share the KV history settings with the other servers and delete the
+	// revisions that are past their retention age.
+	s.startKVSHistory(ctx)
+
 	if err := s.establishEnterpriseLeadership(ctx); err != nil {
 		return err
 	}
@@ -340,6 +360,8 @@ func (s *Server) establishLeadership(ctx context.Context) error {
 		s.startLogVerification(ctx)
 	}
 
+	s.startAutoSnapshots(ctx)
+
 	if s.config.Reporting.License.Enabled && s.reportingManager != nil {
 		s.reportingManager.StartReportingAgent()
 	}
@@ -354,6 +376,8 @@ func (s *Server) revokeLeadership() {
 
 	s.stopLogVerification()
 
+	s.stopAutoSnapshots()
+
 	// Disable the tombstone GC, since it is only useful as a leader
 	s.tombstoneGC.SetEnabled(false)
 
@@ -361,6 +385,9 @@ func (s *Server) revokeLeadership() {
 	// are no longer responsible for session expirations.
 	s.clearAllSessionTimers()
 
+	s.stopKVSReaping()
+	s.stopKVSHistory()
+
 	s.revokeEnterpriseLeadership()
 
 	s.stopDeferredDeletion()
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+
+	"github.com/hashicorp/consul/lib/file"
+	"github.com/hashicorp/consul/snapshot"
+)
+
+// DefaultAutoSnapshotNameTemplate is the default name of the files of
+// automatic snapshots.
+const DefaultAutoSnapshotNameTemplate = "consul-{{.Datacenter}}-{{.Timestamp}}-{{.Index}}.snap"
+
+// autoSnapshotTimeFormat is the format of the Timestamp field of the name
+// template, which sorts in the order the snapshots were saved.
+const autoSnapshotTimeFormat = "20060102T150405Z"
+
+// autoSnapshotRetryInterval is the longest time to wait before trying to save
+// a snapshot again after a failure.
+const autoSnapshotRetryInterval = time.Minute
+
+var AutoSnapshotGauges = []prometheus.GaugeDefinition{
+	{
+		Name: []string{"leader", "auto_snapshot", "last_success"},
+		Help: "The Unix time in seconds of the last automatic snapshot saved by the leader.",
+	},
+}
+
+var AutoSnapshotCounters = []prometheus.CounterDefinition{
+	{
+		Name: []string{"leader", "auto_snapshot", "failure"},
+		Help: "Increments when the leader fails to save an automatic snapshot.",
+	},
+}
+
+// autoSnapshotName holds the fields available to the name template of
+// automatic snapshots.
+type autoSnapshotName struct {
+	Datacenter string
+	Node       string
+	Index      string
+	Timestamp  string
+	Unix       string
+}
+
+// ParseAutoSnapshotNameTemplate parses the text/template that names the files
+// of automatic snapshots. The template can use the Datacenter, Node, Index,
+// Timestamp and Unix fields, and must name a file within the snapshot
+// directory which differs for each snapshot.
+func ParseAutoSnapshotNameTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("name_template").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := renderAutoSnapshotName(tmpl, autoSnapshotName{
+		Datacenter: "dc1",
+		Node:       "node1",
+		Index:      "1",
+		Timestamp:  "20060102T150405Z",
+		Unix:       "1136214245",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if first == "" || first == "." || first == ".." || strings.ContainsAny(first, `/\`) {
+		return nil, fmt.Errorf("must render a file name without a directory, got %q", first)
+	}
+
+	second, err := renderAutoSnapshotName(tmpl, autoSnapshotName{
+		Datacenter: "dc1",
+		Node:       "node1",
+		Index:      "2",
+		Timestamp:  "20060102T160405Z",
+		Unix:       "1136217845",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if first == second {
+		return nil, fmt.Errorf("must use the Index, Timestamp or Unix field so that each snapshot has its own name")
+	}
+	return tmpl, nil
+}
+
+func renderAutoSnapshotName(tmpl *template.Template, data autoSnapshotName) (string, error) {
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// autoSnapshotPattern returns the expression matching the files that the
+// template names for the datacenter. The fields must have the format of the
+// values they are rendered with, so that other files which happen to share
+// the prefix and suffix of the template, such as snapshots saved by hand, are
+// never pruned.
+func autoSnapshotPattern(tmpl *template.Template, datacenter string) (*regexp.Regexp, error) {
+	// The fields are rendered as placeholders, which can't be part of a file
+	// name, and are replaced by their expression once the rest is quoted.
+	name, err := renderAutoSnapshotName(tmpl, autoSnapshotName{
+		Datacenter: "\x00Datacenter\x00",
+		Node:       "\x00Node\x00",
+		Index:      "\x00Index\x00",
+		Timestamp:  "\x00Timestamp\x00",
+		Unix:       "\x00Unix\x00",
+	})
+	if err != nil {
+		return nil, err
+	}
+	expr := strings.NewReplacer(
+		"\x00Datacenter\x00", regexp.QuoteMeta(datacenter),
+		"\x00Node\x00", `[^/\\]+`,
+		"\x00Index\x00", `[0-9]+`,
+		"\x00Timestamp\x00", `[0-9]{8}T[0-9]{6}Z`,
+		"\x00Unix\x00", `[0-9]+`,
+	).Replace(regexp.QuoteMeta(name))
+	return regexp.Compile("^" + expr + "$")
+}
+
+func (s *Server) startAutoSnapshots(ctx context.Context) {
+	if s.config.AutoSnapshotInterval <= 0 {
+		return
+	}
+	s.leaderRoutineManager.Start(ctx, autoSnapshotRoutineName, s.runAutoSnapshots)
+}
+
+func (s *Server) stopAutoSnapshots() {
+	s.leaderRoutineManager.Stop(autoSnapshotRoutineName)
+}
+
+func (s *Server) runAutoSnapshots(ctx context.Context) error {
+	tmpl, err := ParseAutoSnapshotNameTemplate(s.config.AutoSnapshotNameTemplate)
+	if err != nil {
+		return fmt.Errorf("invalid automatic snapshot name template: %w", err)
+	}
+	interval := s.config.AutoSnapshotInterval
+
+	// Follow on from the last snapshot in the directory, so that changes of
+	// leader do not reset the schedule of a server that led before.
+	var wait time.Duration
+	last, err := s.lastAutoSnapshot(tmpl)
+	if err != nil {
+		s.logger.Warn("failed to find the last automatic snapshot", "error", err)
+	} else if !last.IsZero() {
+		wait = time.Until(last.Add(interval))
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		wait = interval
+		if err := s.saveAutoSnapshot(tmpl); err != nil {
+			metrics.IncrCounter([]string{"leader", "auto_snapshot", "failure"}, 1)
+			s.logger.Error("failed to save automatic snapshot", "error", err)
+			if wait > autoSnapshotRetryInterval {
+				wait = autoSnapshotRetryInterval
+			}
+		}
+		timer.Reset(wait)
+	}
+}
+
+// saveAutoSnapshot saves a snapshot of the state to the snapshot directory,
+// and removes the oldest snapshots beyond the number to retain.
+func (s *Server) saveAutoSnapshot(tmpl *template.Template) error {
+	defer metrics.MeasureSince([]string{"leader", "auto_snapshot"}, time.Now())
+
+	snap, err := snapshot.New(s.logger, s.raft)
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	now := time.Now().UTC()
+	name, err := renderAutoSnapshotName(tmpl, autoSnapshotName{
+		Datacenter: s.config.Datacenter,
+		Node:       s.config.NodeName,
+		Index:      strconv.FormatUint(snap.Index(), 10),
+		Timestamp:  now.Format(autoSnapshotTimeFormat),
+		Unix:       strconv.FormatInt(now.Unix(), 10),
+	})
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.config.AutoSnapshotPath, name)
+	if err := file.WriteAtomicFromReader(path, snap); err != nil {
+		return err
+	}
+
+	metrics.SetGauge([]string{"leader", "auto_snapshot", "last_success"}, float32(now.Unix()))
+	s.logger.Info("saved automatic snapshot", "path", path, "index", snap.Index())
+
+	if err := s.pruneAutoSnapshots(tmpl); err != nil {
+		s.logger.Warn("failed to remove old automatic snapshots", "error", err)
+	}
+	return nil
+}
+
+// autoSnapshotFiles returns the snapshot files named by the template, from
+// the oldest to the newest.
+func (s *Server) autoSnapshotFiles(tmpl *template.Template) ([]os.FileInfo, error) {
+	pattern, err := autoSnapshotPattern(tmpl, s.config.Datacenter)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(s.config.AutoSnapshotPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]os.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		if !pattern.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if info.Mode().IsRegular() {
+			files = append(files, info)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].ModTime().Equal(files[j].ModTime()) {
+			return files[i].Name() < files[j].Name()
+		}
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	return files, nil
+}
+
+// lastAutoSnapshot returns the time the newest snapshot was saved, or zero if
+// there is none.
+func (s *Server) lastAutoSnapshot(tmpl *template.Template) (time.Time, error) {
+	files, err := s.autoSnapshotFiles(tmpl)
+	if err != nil || len(files) == 0 {
+		return time.Time{}, err
+	}
+	return files[len(files)-1].ModTime(), nil
+}
+
+// pruneAutoSnapshots removes the oldest snapshots beyond the number to
+// retain.
+func (s *Server) pruneAutoSnapshots(tmpl *template.Template) error {
+	if s.config.AutoSnapshotRetain <= 0 {
+		return nil
+	}
+	files, err := s.autoSnapshotFiles(tmpl)
+	if err != nil {
+		return err
+	}
+	for len(files) > s.config.AutoSnapshotRetain {
+		path := filepath.Join(s.config.AutoSnapshotPath, files[0].Name())
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		s.logger.Debug("removed old automatic snapshot", "path", path)
+		files = files[1:]
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/snapshot"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestParseAutoSnapshotNameTemplate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		DefaultAutoSnapshotNameTemplate:    "",
+		"{{.Node}}-{{.Unix}}.snap":         "",
+		"{{.Datacenter}}/{{.Index}}.snap":  "must render a file name without a directory",
+		"{{.Datacenter}}.snap":             "must use the Index, Timestamp or Unix field",
+		"{{.Term}}.snap":                   "can't evaluate field Term",
+		"{{.Index":                         "unclosed action",
+		"":                                 "must render a file name without a directory",
+		"{{if false}}{{.Index}}{{end}}":    "must render a file name without a directory",
+		"..{{if false}}{{.Index}}{{end}}":  "must render a file name without a directory",
+		"{{.Timestamp}}-{{.Datacenter}}.x": "",
+	}
+	for text, expected := range cases {
+		t.Run(text, func(t *testing.T) {
+			_, err := ParseAutoSnapshotNameTemplate(text)
+			if expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestLeader_AutoSnapshot(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir := testutil.TempDir(t, "auto-snapshot")
+
+	// A file from a previous leader is pruned as it is the oldest, and other
+	// files are left alone.
+	old := filepath.Join(dir, "consul-dc1-20060102T150405Z-1.snap")
+	require.NoError(t, os.WriteFile(old, []byte("old"), 0600))
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(old, past, past))
+	other := filepath.Join(dir, "other.txt")
+	require.NoError(t, os.WriteFile(other, []byte("other"), 0600))
+	manual := filepath.Join(dir, "consul-dc1-manual-x.snap")
+	require.NoError(t, os.WriteFile(manual, []byte("manual"), 0600))
+	require.NoError(t, os.Chtimes(manual, past, past))
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.AutoSnapshotInterval = 200 * time.Millisecond
+		c.AutoSnapshotPath = dir
+		c.AutoSnapshotRetain = 2
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	retry.Run(t, func(r *retry.R) {
+		_, err := os.Stat(old)
+		require.True(r, os.IsNotExist(err), "old snapshot was not pruned")
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		matches, err := filepath.Glob(filepath.Join(dir, "consul-dc1-*T*Z-*.snap"))
+		require.NoError(r, err)
+		require.Len(r, matches, 2)
+		for _, match := range matches {
+			f, err := os.Open(match)
+			require.NoError(r, err)
+			_, err = snapshot.Verify(f)
+			f.Close()
+			require.NoError(r, err)
+		}
+	})
+	require.FileExists(t, other)
+	require.FileExists(t, manual)
+}
+
+func TestAutoSnapshotPattern(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		template string
+		matches  []string
+		others   []string
+	}{
+		"default": {
+			template: DefaultAutoSnapshotNameTemplate,
+			matches:  []string{"consul-dc1-20060102T150405Z-1.snap", "consul-dc1-20250101T000000Z-12345.snap"},
+			others: []string{
+				"consul-dc1-manual-x.snap",
+				"consul-dc2-20060102T150405Z-1.snap",
+				"consul-dc1-20060102T150405Z-1.snap.tmp",
+				"consul-dc1-20060102T150405Z-x.snap",
+			},
+		},
+		"node and unix": {
+			template: "{{.Node}}.{{.Unix}}.snap",
+			matches:  []string{"node1.1136214245.snap"},
+			others:   []string{".1136214245.snap", "node1.x.snap", "node1.1136214245xsnap"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tmpl, err := ParseAutoSnapshotNameTemplate(tc.template)
+			require.NoError(t, err)
+			pattern, err := autoSnapshotPattern(tmpl, "dc1")
+			require.NoError(t, err)
+			for _, name := range tc.matches {
+				require.True(t, pattern.MatchString(name), name)
+			}
+			for _, name := range tc.others {
+				require.False(t, pattern.MatchString(name), name)
+			}
+		})
+	}
+}
@@ -139,6 +139,9 @@ const (
 	federationStateAntiEntropyRoutineName = "federation state anti-entropy"
 	federationStatePruningRoutineName     = "federation state pruning"
 	intentionMigrationRoutineName         = "intention config entry migration"
+	kvsReapingRoutineName                 = "kvs reaping"
+	kvsHistoryRoutineName                 = "kvs history"
+	autoSnapshotRoutineName               = "auto snapshot"
 	secondaryCARootWatchRoutineName       = "secondary CA roots watch"
 	intermediateCertRenewWatchRoutineName = "intermediate cert renew watch"
 	backgroundCAInitializationRoutineName = "CA initialization"
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/go-version"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/consul/state"
@@ -29,6 +30,11 @@ var SessionEndpointSummaries = []prometheus.SummaryDefinition{
 	},
 }
 
+// minSessionTransferVersion is the minimum version for all Consul servers for
+// sessions with the transfer behavior to be created. Older servers would
+// release the locks instead of handing them to a successor.
+var minSessionTransferVersion = version.Must(version.NewVersion("1.22.0"))
+
 // Session endpoint is used to manipulate sessions for KV
 type Session struct {
 	srv    *Server
@@ -105,9 +111,28 @@ func (s *Session) Apply(args *structs.SessionRequest, reply *string) error {
 		args.Session.Behavior = structs.SessionKeysRelease
 	case structs.SessionKeysRelease:
 	case structs.SessionKeysDelete:
+	case structs.SessionKeysTransfer:
+		if args.Op == structs.SessionCreate && len(args.Session.Successors) == 0 {
+			return fmt.Errorf("Behavior '%s' requires at least one successor session", structs.SessionKeysTransfer)
+		}
+		if args.Op == structs.SessionCreate {
+			if ok, _ := ServersInDCMeetMinimumVersion(s.srv, s.srv.config.Datacenter, minSessionTransferVersion); !ok {
+				return fmt.Errorf("all servers must be >= %s to use the '%s' behavior", minSessionTransferVersion.String(), structs.SessionKeysTransfer)
+			}
+		}
 	default:
 		return fmt.Errorf("Invalid Behavior setting '%s'", args.Session.Behavior)
 	}
+	if args.Op == structs.SessionCreate && len(args.Session.Successors) > 0 {
+		if args.Session.Behavior != structs.SessionKeysTransfer {
+			return fmt.Errorf("Successors are only used by the '%s' behavior", structs.SessionKeysTransfer)
+		}
+		for _, id := range args.Session.Successors {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("Successor '%s' is not a valid session ID", id)
+			}
+		}
+	}
 
 	// Ensure the Session TTL is valid if provided
 	if args.Session.TTL != "" {
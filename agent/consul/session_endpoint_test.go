@@ -14,7 +14,9 @@ import (
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/lib/stringslice"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 )
 
@@ -152,6 +154,140 @@ func TestSession_DeleteApply(t *testing.T) {
 	}
 }
 
+func TestSession_TransferApply(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Just add a node
+	s1.fsm.State().EnsureNode(1, &structs.Node{Node: "foo", Address: "127.0.0.1"})
+
+	create := func(sess structs.Session) (string, error) {
+		arg := structs.SessionRequest{
+			Datacenter: "dc1",
+			Op:         structs.SessionCreate,
+			Session:    sess,
+		}
+		var out string
+		err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out)
+		return out, err
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := create(structs.Session{Node: "foo", Behavior: structs.SessionKeysTransfer})
+		require.ErrorContains(t, err, "requires at least one successor session")
+
+		_, err = create(structs.Session{Node: "foo", Behavior: structs.SessionKeysRelease, Successors: []string{generateUUID()}})
+		require.ErrorContains(t, err, "Successors are only used by the 'transfer' behavior")
+
+		_, err = create(structs.Session{Node: "foo", Behavior: structs.SessionKeysTransfer, Successors: []string{"nope"}})
+		require.ErrorContains(t, err, "Successor 'nope' is not a valid session ID")
+	})
+
+	successor, err := create(structs.Session{Node: "foo"})
+	require.NoError(t, err)
+	id, err := create(structs.Session{
+		Node:       "foo",
+		Behavior:   structs.SessionKeysTransfer,
+		Successors: []string{successor},
+	})
+	require.NoError(t, err)
+
+	state := s1.fsm.State()
+	_, sess, err := state.SessionGet(nil, id, nil)
+	require.NoError(t, err)
+	require.NotNil(t, sess)
+	require.Equal(t, structs.SessionKeysTransfer, sess.Behavior)
+	require.Equal(t, []string{successor}, sess.Successors)
+
+	// Lock a key and destroy the session holding it.
+	kvArg := structs.KVSRequest{
+		Datacenter: "dc1",
+		Op:         api.KVLock,
+		DirEnt: structs.DirEntry{
+			Key:     "leader",
+			Value:   []byte("work"),
+			Session: id,
+		},
+	}
+	var locked bool
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "KVS.Apply", &kvArg, &locked))
+	require.True(t, locked)
+
+	arg := structs.SessionRequest{
+		Datacenter: "dc1",
+		Op:         structs.SessionDestroy,
+		Session:    structs.Session{ID: id},
+	}
+	var out string
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out))
+
+	_, d, err := state.KVSGet(nil, "leader", nil)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	require.Equal(t, successor, d.Session)
+	require.Equal(t, []byte("work"), d.Value)
+	require.Equal(t, uint64(2), d.LockIndex)
+}
+
+func TestSession_TransferApply_MinVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Bootstrap = false
+		c.Build = "1.21.0"
+	})
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	joinLAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	// Just add a node
+	s1.fsm.State().EnsureNode(1, &structs.Node{Node: "foo", Address: "127.0.0.1"})
+
+	arg := structs.SessionRequest{
+		Datacenter: "dc1",
+		Op:         structs.SessionCreate,
+		Session: structs.Session{
+			Node:       "foo",
+			Behavior:   structs.SessionKeysTransfer,
+			Successors: []string{generateUUID()},
+		},
+	}
+	var out string
+	retry.Run(t, func(r *retry.R) {
+		err := msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out)
+		require.ErrorContains(r, err, "all servers must be >= 1.22.0 to use the 'transfer' behavior")
+	})
+
+	// Sessions with the other behaviors can still be created.
+	arg.Session.Behavior = structs.SessionKeysRelease
+	arg.Session.Successors = nil
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Session.Apply", &arg, &out))
+	require.NotEmpty(t, out)
+}
+
 func TestSession_Apply_ACLDeny(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -18,6 +18,7 @@ const (
 	tableTombstones = "tombstones"
 
 	indexSession = "session"
+	indexExpires = "expires"
 )
 
 // kvsTableSchema returns a new table schema used for storing structs.DirEntry
@@ -39,10 +40,32 @@ func kvsTableSchema() *memdb.TableSchema {
 					Field: "Session",
 				},
 			},
+			indexExpires: {
+				Name:         indexExpires,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: indexerSingle[*TimeQuery, *structs.DirEntry]{
+					readIndex:  indexFromTimeQuery,
+					writeIndex: indexExpiresFromDirEntry,
+				},
+			},
 		},
 	}
 }
 
+func indexExpiresFromDirEntry(e *structs.DirEntry) ([]byte, error) {
+	if e.Expires == nil || e.Expires.IsZero() {
+		return nil, errMissingValueForIndex
+	}
+	if e.Expires.Unix() < 0 {
+		return nil, fmt.Errorf("kvs expiration time cannot be before the unix epoch: %s", e.Expires)
+	}
+
+	var b indexBuilder
+	b.Time(*e.Expires)
+	return b.Bytes(), nil
+}
+
 // indexFromIDValue creates an index key from any struct that implements singleValueID
 func indexFromIDValue(e singleValueID) ([]byte, error) {
 	v := e.IDValue()
@@ -133,6 +156,10 @@ func (s *Store) KVSSet(idx uint64, entry *structs.DirEntry) error {
 // session (should be validated before calling this). Otherwise, we will keep
 // whatever the existing session is.
 func kvsSetTxn(tx WriteTxn, idx uint64, entry *structs.DirEntry, updateSession bool) error {
+	// The revision time only travels with the request, it is not stored.
+	revisionTime := entry.RevisionTime
+	entry.RevisionTime = nil
+
 	existingNode, err := tx.First(tableKVs, indexID, entry)
 	if err != nil {
 		return fmt.Errorf("failed kvs lookup: %s", err)
@@ -171,7 +198,30 @@ func kvsSetTxn(tx WriteTxn, idx uint64, entry *structs.DirEntry, updateSession b
 		return fmt.Errorf("failed inserting kvs entry: %s", err)
 	}
 
-	return nil
+	return kvsRecordRevisionTxn(tx, idx, entry, false, revisionTime)
+}
+
+// KVSListExpired lists the entries that are expired as of the provided time,
+// the earliest expired first. The returned set will be no larger than the max
+// value provided.
+func (s *Store) KVSListExpired(asOf time.Time, max int) (structs.DirEntries, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	iter, err := tx.Get(tableKVs, indexExpires)
+	if err != nil {
+		return nil, fmt.Errorf("failed kvs lookup: %s", err)
+	}
+
+	var entries structs.DirEntries
+	for raw := iter.Next(); raw != nil && len(entries) < max; raw = iter.Next() {
+		entry := raw.(*structs.DirEntry)
+		if !entry.Expires.Before(asOf) {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
 
 // KVSGet is used to retrieve a key/value pair from the state store.
@@ -260,13 +310,14 @@ func (s *Store) kvsListTxn(tx ReadTxn,
 }
 
 // KVSDelete is used to perform a shallow delete on a single key in the
-// the state store.
-func (s *Store) KVSDelete(idx uint64, key string, entMeta *acl.EnterpriseMeta) error {
+// the state store. The revisionTime is the time the deletion is recorded at
+// in the KV history, as set by the leader.
+func (s *Store) KVSDelete(idx uint64, key string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) error {
 	tx := s.db.WriteTxn(idx)
 	defer tx.Abort()
 
 	// Perform the actual delete
-	if err := s.kvsDeleteTxn(tx, idx, key, entMeta); err != nil {
+	if err := s.kvsDeleteTxn(tx, idx, key, entMeta, revisionTime); err != nil {
 		return err
 	}
 
@@ -275,7 +326,7 @@ func (s *Store) KVSDelete(idx uint64, key string, entMeta *acl.EnterpriseMeta) e
 
 // kvsDeleteTxn is the inner method used to perform the actual deletion
 // of a key/value pair within an existing transaction.
-func (s *Store) kvsDeleteTxn(tx WriteTxn, idx uint64, key string, entMeta *acl.EnterpriseMeta) error {
+func (s *Store) kvsDeleteTxn(tx WriteTxn, idx uint64, key string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) error {
 
 	if entMeta == nil {
 		entMeta = structs.DefaultEnterpriseMetaInDefaultPartition()
@@ -295,18 +346,21 @@ func (s *Store) kvsDeleteTxn(tx WriteTxn, idx uint64, key string, entMeta *acl.E
 		return fmt.Errorf("failed adding to graveyard: %s", err)
 	}
 
-	return kvsDeleteWithEntry(tx, entry.(*structs.DirEntry), idx)
+	if err := kvsDeleteWithEntry(tx, entry.(*structs.DirEntry), idx); err != nil {
+		return err
+	}
+	return kvsRecordRevisionTxn(tx, idx, entry.(*structs.DirEntry), true, revisionTime)
 }
 
 // KVSDeleteCAS is used to try doing a KV delete operation with a given
 // raft index. If the CAS index specified is not equal to the last
 // observed index for the given key, then the call is a noop, otherwise
 // a normal KV delete is invoked.
-func (s *Store) KVSDeleteCAS(idx, cidx uint64, key string, entMeta *acl.EnterpriseMeta) (bool, error) {
+func (s *Store) KVSDeleteCAS(idx, cidx uint64, key string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) (bool, error) {
 	tx := s.db.WriteTxn(idx)
 	defer tx.Abort()
 
-	set, err := s.kvsDeleteCASTxn(tx, idx, cidx, key, entMeta)
+	set, err := s.kvsDeleteCASTxn(tx, idx, cidx, key, entMeta, revisionTime)
 	if !set || err != nil {
 		return false, err
 	}
@@ -317,7 +371,7 @@ func (s *Store) KVSDeleteCAS(idx, cidx uint64, key string, entMeta *acl.Enterpri
 
 // kvsDeleteCASTxn is the inner method that does a CAS delete within an existing
 // transaction.
-func (s *Store) kvsDeleteCASTxn(tx WriteTxn, idx, cidx uint64, key string, entMeta *acl.EnterpriseMeta) (bool, error) {
+func (s *Store) kvsDeleteCASTxn(tx WriteTxn, idx, cidx uint64, key string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) (bool, error) {
 	if entMeta == nil {
 		entMeta = structs.DefaultEnterpriseMetaInDefaultPartition()
 	}
@@ -335,7 +389,7 @@ func (s *Store) kvsDeleteCASTxn(tx WriteTxn, idx, cidx uint64, key string, entMe
 	}
 
 	// Call the actual deletion if the above passed.
-	if err := s.kvsDeleteTxn(tx, idx, key, entMeta); err != nil {
+	if err := s.kvsDeleteTxn(tx, idx, key, entMeta, revisionTime); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -389,11 +443,11 @@ func kvsSetCASTxn(tx WriteTxn, idx uint64, entry *structs.DirEntry) (bool, error
 // KVSDeleteTree is used to do a recursive delete on a key prefix
 // in the state store. If any keys are modified, the last index is
 // set, otherwise this is a no-op.
-func (s *Store) KVSDeleteTree(idx uint64, prefix string, entMeta *acl.EnterpriseMeta) error {
+func (s *Store) KVSDeleteTree(idx uint64, prefix string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) error {
 	tx := s.db.WriteTxn(idx)
 	defer tx.Abort()
 
-	if err := s.kvsDeleteTreeTxn(tx, idx, prefix, entMeta); err != nil {
+	if err := s.kvsDeleteTreeTxn(tx, idx, prefix, entMeta, revisionTime); err != nil {
 		return err
 	}
 
@@ -8,6 +8,7 @@ package state
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -42,6 +43,37 @@ func prefixIndexForIDValue(arg interface{}) ([]byte, error) {
 	return nil, fmt.Errorf("unexpected type %T for singleValueID prefix index", arg)
 }
 
+func kvsRevisionsIndexer() indexerSingleWithPrefix[*structs.KVSRevision, *structs.KVSRevision, Query] {
+	return indexerSingleWithPrefix[*structs.KVSRevision, *structs.KVSRevision, Query]{
+		readIndex:   indexFromKVSRevision,
+		writeIndex:  indexFromKVSRevision,
+		prefixIndex: prefixIndexForKVSRevisions,
+	}
+}
+
+// prefixIndexForKVSRevisions matches all the revisions of the key in the
+// query, or all revisions if the key is empty.
+func prefixIndexForKVSRevisions(q Query) ([]byte, error) {
+	if q.Value == "" {
+		return nil, nil
+	}
+
+	var b indexBuilder
+	b.String(q.Value)
+	return b.Bytes(), nil
+}
+
+func indexFromKVSRevision(r *structs.KVSRevision) ([]byte, error) {
+	if r.Key == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	var b indexBuilder
+	b.String(r.Key)
+	b.Raw(kvsRevisionIndexBytes(r.ModifyIndex))
+	return b.Bytes(), nil
+}
+
 func insertKVTxn(tx WriteTxn, entry *structs.DirEntry, updateMax bool, _ bool) error {
 	if err := tx.Insert(tableKVs, entry); err != nil {
 		return err
@@ -82,13 +114,37 @@ func kvsListEntriesTxn(tx ReadTxn, ws memdb.WatchSet, prefix string, entMeta acl
 
 // kvsDeleteTreeTxn is the inner method that does a recursive delete inside an
 // existing transaction.
-func (s *Store) kvsDeleteTreeTxn(tx WriteTxn, idx uint64, prefix string, entMeta *acl.EnterpriseMeta) error {
+func (s *Store) kvsDeleteTreeTxn(tx WriteTxn, idx uint64, prefix string, entMeta *acl.EnterpriseMeta, revisionTime *time.Time) error {
+	if entMeta == nil {
+		entMeta = structs.DefaultEnterpriseMetaInDefaultPartition()
+	}
+
+	// Gather the entries first so their deletions can be recorded in the
+	// history, if it is enabled.
+	count, err := kvsHistoryRetainCountTxn(tx)
+	if err != nil {
+		return err
+	}
+	var entries structs.DirEntries
+	if count > 0 {
+		_, entries, err = kvsListEntriesTxn(tx, nil, prefix, *entMeta)
+		if err != nil {
+			return err
+		}
+	}
+
 	// For prefix deletes, only insert one tombstone and delete the entire subtree
 	deleted, err := tx.DeletePrefix(tableKVs, indexID+"_prefix", prefix)
 	if err != nil {
 		return fmt.Errorf("failed recursive deleting kvs entry: %s", err)
 	}
 
+	for _, entry := range entries {
+		if err := kvsRecordRevisionTxn(tx, idx, entry, true, revisionTime); err != nil {
+			return err
+		}
+	}
+
 	if deleted {
 		if prefix != "" { // don't insert a tombstone if the entire tree is deleted, all watchers on keys will see the max_index of the tree
 			if err := s.kvsGraveyard.InsertTxn(tx, prefix, idx, entMeta); err != nil {
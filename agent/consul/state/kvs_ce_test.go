@@ -6,11 +6,14 @@
 package state
 
 import (
+	"time"
+
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
 )
 
 func testIndexerTableKVs() map[string]indexerTestCase {
+	expires := time.Unix(1600000000, 0)
 	return map[string]indexerTestCase{
 		indexID: {
 			read: indexValue{
@@ -36,6 +39,24 @@ func testIndexerTableKVs() map[string]indexerTestCase {
 				},
 			},
 		},
+		indexExpires: {
+			read: indexValue{
+				source:   &TimeQuery{Value: expires},
+				expected: []byte{0x0, 0x0, 0x0, 0x0, 0x5f, 0x5e, 0x10, 0x0},
+			},
+			write: indexValue{
+				source:   &structs.DirEntry{Key: "TheKey", Expires: &expires},
+				expected: []byte{0x0, 0x0, 0x0, 0x0, 0x5f, 0x5e, 0x10, 0x0},
+			},
+			extra: []indexerTestCase{
+				{
+					write: indexValue{
+						source:               &structs.DirEntry{Key: "TheKey"},
+						expectedIndexMissing: true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -67,3 +88,43 @@ func testIndexerTableTombstones() map[string]indexerTestCase {
 		},
 	}
 }
+
+func testIndexerTableKVSRevisions() map[string]indexerTestCase {
+	recorded := time.Unix(1600000000, 0)
+	return map[string]indexerTestCase{
+		indexID: {
+			read: indexValue{
+				source: &structs.KVSRevision{
+					DirEntry: structs.DirEntry{Key: "TheKey", RaftIndex: structs.RaftIndex{ModifyIndex: 7}},
+				},
+				expected: []byte("TheKey\x00\x00\x00\x00\x00\x00\x00\x00\x07"),
+			},
+			write: indexValue{
+				source: &structs.KVSRevision{
+					DirEntry: structs.DirEntry{Key: "TheKey", RaftIndex: structs.RaftIndex{ModifyIndex: 7}},
+				},
+				expected: []byte("TheKey\x00\x00\x00\x00\x00\x00\x00\x00\x07"),
+			},
+			prefix: []indexValue{
+				{
+					source:   Query{},
+					expected: nil,
+				},
+				{
+					source:   Query{Value: "TheKey"},
+					expected: []byte("TheKey\x00"),
+				},
+			},
+		},
+		indexRevisionTime: {
+			read: indexValue{
+				source:   &TimeQuery{Value: recorded},
+				expected: []byte{0x0, 0x0, 0x0, 0x0, 0x5f, 0x5e, 0x10, 0x0},
+			},
+			write: indexValue{
+				source:   &structs.KVSRevision{DirEntry: structs.DirEntry{Key: "TheKey"}, Time: recorded},
+				expected: []byte{0x0, 0x0, 0x0, 0x0, 0x5f, 0x5e, 0x10, 0x0},
+			},
+		},
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	tableKVSRevisions = "kvs-revisions"
+
+	indexRevisionTime = "time"
+)
+
+// kvsRevisionsTableSchema returns a new table schema used for storing the
+// past values of KV entries while KV history is enabled.
+func kvsRevisionsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableKVSRevisions,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer:      kvsRevisionsIndexer(),
+			},
+			indexRevisionTime: {
+				Name:         indexRevisionTime,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: indexerSingle[*TimeQuery, *structs.KVSRevision]{
+					readIndex:  indexFromTimeQuery,
+					writeIndex: indexTimeFromKVSRevision,
+				},
+			},
+		},
+	}
+}
+
+func indexTimeFromKVSRevision(r *structs.KVSRevision) ([]byte, error) {
+	if r.Time.Unix() < 0 {
+		return nil, fmt.Errorf("kvs revision time cannot be before the unix epoch: %s", r.Time)
+	}
+
+	var b indexBuilder
+	b.Time(r.Time)
+	return b.Bytes(), nil
+}
+
+// kvsRevisionIndexBytes encodes the index of a revision so that the revisions
+// of a key sort by the index they were written at.
+func kvsRevisionIndexBytes(idx uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, idx)
+	return buf
+}
+
+// kvsHistoryRetainCountTxn returns the number of revisions to keep for each
+// key, or zero if KV history is disabled. The leader sets it in the system
+// metadata from its configuration, so that all servers agree on it. Write
+// transactions of the store use the count cached by
+// refreshKVSHistoryRetainCount instead of looking it up.
+func kvsHistoryRetainCountTxn(tx ReadTxn) (int, error) {
+	if t, ok := tx.(*txn); ok && t.kvsHistoryRetainCount != nil {
+		return int(t.kvsHistoryRetainCount.Load()), nil
+	}
+	return kvsHistoryRetainCountMetadataTxn(tx)
+}
+
+// refreshKVSHistoryRetainCount caches the KV history retain count of the
+// system metadata. It must be called after every commit that may change it.
+func (s *Store) refreshKVSHistoryRetainCount() error {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	count, err := kvsHistoryRetainCountMetadataTxn(tx)
+	if err != nil {
+		return err
+	}
+	s.db.kvsHistoryRetainCount.Store(int64(count))
+	return nil
+}
+
+// kvsHistoryRetainCountMetadataTxn looks the KV history retain count up in
+// the system metadata.
+func kvsHistoryRetainCountMetadataTxn(tx ReadTxn) (int, error) {
+	_, entry, err := systemMetadataGetTxn(tx, nil, structs.SystemMetadataKVHistoryRetainCount)
+	if err != nil {
+		return 0, fmt.Errorf("failed system metadata lookup: %s", err)
+	}
+	if entry == nil || entry.Value == "" {
+		return 0, nil
+	}
+
+	count, err := strconv.Atoi(entry.Value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid kv history retain count %q: %v", entry.Value, err)
+	}
+	return count, nil
+}
+
+// kvsRecordRevisionTxn records a revision of the given entry if KV history is
+// enabled, and deletes the oldest revisions of the key beyond the retain
+// count.
+//
+// Changes that went through the leader carry its wall-time in the Raft log as
+// revisionTime. Changes the servers make on their own, like releasing the
+// locks of an invalidated session, have none and are recorded at the time of
+// the previous revision of the key instead, so that all servers agree on it.
+func kvsRecordRevisionTxn(tx WriteTxn, idx uint64, entry *structs.DirEntry, deleted bool, revisionTime *time.Time) error {
+	count, err := kvsHistoryRetainCountTxn(tx)
+	if err != nil {
+		return err
+	}
+	if count <= 0 {
+		return nil
+	}
+
+	revs, err := kvsRevisionsTxn(tx, nil, entry.Key, entry.EnterpriseMeta)
+	if err != nil {
+		return err
+	}
+
+	rev := &structs.KVSRevision{
+		DirEntry: *entry.Clone(),
+		Deleted:  deleted,
+	}
+	switch {
+	case revisionTime != nil:
+		rev.Time = revisionTime.UTC()
+	case len(revs) > 0:
+		rev.Time = revs[len(revs)-1].Time
+	default:
+		rev.Time = time.Unix(0, 0).UTC()
+	}
+	if deleted {
+		rev.DirEntry = structs.DirEntry{
+			Key:            entry.Key,
+			EnterpriseMeta: entry.EnterpriseMeta,
+		}
+	}
+	rev.ModifyIndex = idx
+
+	// Changing the key again in the same transaction replaces its revision.
+	if n := len(revs); n > 0 && revs[n-1].ModifyIndex == idx {
+		revs = revs[:n-1]
+	}
+
+	if err := tx.Insert(tableKVSRevisions, rev); err != nil {
+		return fmt.Errorf("failed inserting kvs revision: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableKVSRevisions, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	revs = append(revs, rev)
+	for len(revs) > count {
+		if err := tx.Delete(tableKVSRevisions, revs[0]); err != nil {
+			return fmt.Errorf("failed deleting kvs revision: %s", err)
+		}
+		revs = revs[1:]
+	}
+	return nil
+}
+
+// kvsRevisionsTxn returns the revisions of a key, oldest first.
+func kvsRevisionsTxn(tx ReadTxn, ws memdb.WatchSet, key string, entMeta acl.EnterpriseMeta) (structs.KVSRevisions, error) {
+	iter, err := tx.Get(tableKVSRevisions, indexID+"_prefix", Query{Value: key, EnterpriseMeta: entMeta})
+	if err != nil {
+		return nil, fmt.Errorf("failed kvs revisions lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var revs structs.KVSRevisions
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		revs = append(revs, raw.(*structs.KVSRevision))
+	}
+	return revs, nil
+}
+
+// KVSRevisions returns the recorded revisions of a key, newest first.
+func (s *Store) KVSRevisions(ws memdb.WatchSet, key string, entMeta *acl.EnterpriseMeta) (uint64, structs.KVSRevisions, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	if entMeta == nil {
+		entMeta = structs.DefaultEnterpriseMetaInDefaultPartition()
+	}
+
+	idx := maxIndexTxn(tx, tableKVSRevisions)
+
+	revs, err := kvsRevisionsTxn(tx, ws, key, *entMeta)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i, j := 0, len(revs)-1; i < j; i, j = i+1, j-1 {
+		revs[i], revs[j] = revs[j], revs[i]
+	}
+	return idx, revs, nil
+}
+
+// KVSRevisionsListExpired lists the revisions that were recorded before the
+// provided time, the oldest first. The returned set will be no larger than
+// the max value provided.
+func (s *Store) KVSRevisionsListExpired(asOf time.Time, max int) (structs.KVSRevisions, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	iter, err := tx.Get(tableKVSRevisions, indexRevisionTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed kvs revisions lookup: %s", err)
+	}
+
+	var revs structs.KVSRevisions
+	for raw := iter.Next(); raw != nil && len(revs) < max; raw = iter.Next() {
+		rev := raw.(*structs.KVSRevision)
+		if !rev.Time.Before(asOf) {
+			break
+		}
+		revs = append(revs, rev)
+	}
+	return revs, nil
+}
+
+// KVSRevisionsReap deletes the given revisions. Only the keys, enterprise
+// metadata and modify indexes of the revisions are used, and revisions that
+// no longer exist are ignored.
+func (s *Store) KVSRevisionsReap(idx uint64, revisions structs.KVSRevisions) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	var deleted bool
+	for _, rev := range revisions {
+		existing, err := tx.First(tableKVSRevisions, indexID, rev)
+		if err != nil {
+			return fmt.Errorf("failed kvs revision lookup: %s", err)
+		}
+		if existing == nil {
+			continue
+		}
+		if err := tx.Delete(tableKVSRevisions, existing); err != nil {
+			return fmt.Errorf("failed deleting kvs revision: %s", err)
+		}
+		deleted = true
+	}
+
+	if deleted {
+		if err := tx.Insert(tableIndex, &IndexEntry{tableKVSRevisions, idx}); err != nil {
+			return fmt.Errorf("failed updating index: %s", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// KVSRevisions is used to pull the full list of KV revisions for use during
+// snapshots.
+func (s *Snapshot) KVSRevisions() (memdb.ResultIterator, error) {
+	return s.tx.Get(tableKVSRevisions, indexID)
+}
+
+// KVSRevision is used when restoring from a snapshot.
+func (s *Restore) KVSRevision(rev *structs.KVSRevision) error {
+	if err := s.tx.Insert(tableKVSRevisions, rev); err != nil {
+		return fmt.Errorf("failed restoring kvs revision: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, rev.ModifyIndex, tableKVSRevisions); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	return nil
+}
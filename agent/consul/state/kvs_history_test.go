@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func testEnableKVSHistory(t *testing.T, s *Store, count string) {
+	t.Helper()
+	require.NoError(t, s.SystemMetadataSet(0, &structs.SystemMetadataEntry{
+		Key:   structs.SystemMetadataKVHistoryRetainCount,
+		Value: count,
+	}))
+}
+
+func testSetKeyAt(t *testing.T, s *Store, idx uint64, key, value string, at time.Time) {
+	t.Helper()
+	require.NoError(t, s.KVSSet(idx, &structs.DirEntry{Key: key, Value: []byte(value), RevisionTime: &at}))
+}
+
+func revisionIndexes(revs structs.KVSRevisions) []uint64 {
+	var out []uint64
+	for _, rev := range revs {
+		out = append(out, rev.ModifyIndex)
+	}
+	return out
+}
+
+func TestStateStore_KVSRevisions_Disabled(t *testing.T) {
+	s := testStateStore(t)
+
+	testSetKey(t, s, 1, "foo", "bar", nil)
+	require.NoError(t, s.KVSDelete(2, "foo", nil, nil))
+
+	idx, revs, err := s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Empty(t, revs)
+
+	// Deleting the retain count disables the recording again.
+	testEnableKVSHistory(t, s, "10")
+	testSetKey(t, s, 3, "foo", "bar", nil)
+	require.NoError(t, s.SystemMetadataDelete(4, &structs.SystemMetadataEntry{
+		Key: structs.SystemMetadataKVHistoryRetainCount,
+	}))
+	testSetKey(t, s, 5, "foo", "baz", nil)
+
+	_, revs, err = s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, revisionIndexes(revs))
+}
+
+func TestStateStore_KVSRevisions(t *testing.T) {
+	s := testStateStore(t)
+	testEnableKVSHistory(t, s, "3")
+
+	ws := memdb.NewWatchSet()
+	_, revs, err := s.KVSRevisions(ws, "foo", nil)
+	require.NoError(t, err)
+	require.Empty(t, revs)
+
+	written := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testSetKeyAt(t, s, 1, "foo", "one", written)
+	require.True(t, watchFired(ws))
+
+	testSetKey(t, s, 2, "foo/bar", "other", nil)
+	testSetKeyAt(t, s, 3, "foo", "two", written.Add(time.Minute))
+	deleted := written.Add(time.Hour)
+	require.NoError(t, s.KVSDelete(4, "foo", nil, &deleted))
+
+	idx, revs, err := s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), idx)
+	require.Equal(t, []uint64{4, 3, 1}, revisionIndexes(revs))
+	require.True(t, revs[0].Deleted)
+	require.Nil(t, revs[0].Value)
+	require.Equal(t, []byte("two"), revs[1].Value)
+	require.Equal(t, []byte("one"), revs[2].Value)
+	require.Equal(t, []time.Time{deleted, written.Add(time.Minute), written},
+		[]time.Time{revs[0].Time, revs[1].Time, revs[2].Time})
+
+	// The revision time is not stored with the entry.
+	_, entry, err := s.KVSGet(nil, "foo/bar", nil)
+	require.NoError(t, err)
+	require.Nil(t, entry.RevisionTime)
+
+	// Only the newest revisions are kept.
+	testSetKey(t, s, 5, "foo", "three", nil)
+	_, revs, err = s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 4, 3}, revisionIndexes(revs))
+
+	// Changes without a leader time, such as the release of a lock by an
+	// invalidated session, are recorded at the time of the previous revision.
+	testSetKey(t, s, 6, "foo", "four", nil)
+	_, revs, err = s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), revs[0].ModifyIndex)
+	require.Equal(t, revs[1].Time, revs[0].Time)
+
+	// Deleting a tree records a deletion for every key.
+	require.NoError(t, s.KVSDeleteTree(7, "foo", nil, nil))
+	_, revs, err = s.KVSRevisions(nil, "foo/bar", nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 2}, revisionIndexes(revs))
+	require.True(t, revs[0].Deleted)
+}
+
+func TestStateStore_KVSRevisions_ListExpiredAndReap(t *testing.T) {
+	s := testStateStore(t)
+	testEnableKVSHistory(t, s, "10")
+
+	now := time.Now()
+	testSetKeyAt(t, s, 1, "foo", "one", now)
+	testSetKeyAt(t, s, 2, "bar", "one", now)
+	testSetKeyAt(t, s, 3, "foo", "two", now)
+
+	revs, err := s.KVSRevisionsListExpired(now.Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Empty(t, revs)
+
+	revs, err = s.KVSRevisionsListExpired(now.Add(time.Hour), 2)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+
+	ws := memdb.NewWatchSet()
+	_, _, err = s.KVSRevisions(ws, "foo", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, s.KVSRevisionsReap(4, revs))
+	require.True(t, watchFired(ws))
+
+	revs, err = s.KVSRevisionsListExpired(now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, revisionIndexes(revs))
+
+	// Reaping revisions that no longer exist is a no-op.
+	require.NoError(t, s.KVSRevisionsReap(5, structs.KVSRevisions{
+		{DirEntry: structs.DirEntry{Key: "foo", RaftIndex: structs.RaftIndex{ModifyIndex: 1}}},
+	}))
+	idx, revs, err := s.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), idx)
+	require.Equal(t, []uint64{3}, revisionIndexes(revs))
+}
+
+func TestStateStore_KVSRevisions_Snapshot_Restore(t *testing.T) {
+	s := testStateStore(t)
+	testEnableKVSHistory(t, s, "10")
+
+	testSetKey(t, s, 1, "foo", "one", nil)
+	require.NoError(t, s.KVSDelete(2, "foo", nil, nil))
+
+	snap := s.Snapshot()
+	defer snap.Close()
+
+	iter, err := snap.KVSRevisions()
+	require.NoError(t, err)
+	var dump structs.KVSRevisions
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		dump = append(dump, raw.(*structs.KVSRevision))
+	}
+	require.Len(t, dump, 2)
+
+	s2 := testStateStore(t)
+	restore := s2.Restore()
+	for _, rev := range dump {
+		require.NoError(t, restore.KVSRevision(rev))
+	}
+	require.NoError(t, restore.SystemMetadataEntry(&structs.SystemMetadataEntry{
+		Key:   structs.SystemMetadataKVHistoryRetainCount,
+		Value: "10",
+	}))
+	require.NoError(t, restore.Commit())
+
+	idx, revs, err := s2.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), idx)
+	require.Equal(t, []uint64{2, 1}, revisionIndexes(revs))
+
+	// The restored retain count enables the recording.
+	testSetKey(t, s2, 3, "foo", "two", nil)
+	_, revs, err = s2.KVSRevisions(nil, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 2, 1}, revisionIndexes(revs))
+}
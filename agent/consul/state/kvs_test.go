@@ -27,10 +27,10 @@ func TestStateStore_ReapTombstones(t *testing.T) {
 	testSetKey(t, s, 5, "foo/zoo", "bar", nil)
 
 	// Call a delete on some specific keys.
-	if err := s.KVSDelete(6, "foo/baz", nil); err != nil {
+	if err := s.KVSDelete(6, "foo/baz", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
-	if err := s.KVSDelete(7, "foo/moo", nil); err != nil {
+	if err := s.KVSDelete(7, "foo/moo", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 
@@ -105,7 +105,7 @@ func TestStateStore_GC(t *testing.T) {
 	testSetKey(t, s, 5, "foo/zoo", "bar", nil)
 
 	// Delete a key and make sure the GC sees it.
-	if err := s.KVSDelete(6, "foo/zoo", nil); err != nil {
+	if err := s.KVSDelete(6, "foo/zoo", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	select {
@@ -118,7 +118,7 @@ func TestStateStore_GC(t *testing.T) {
 	}
 
 	// Check for the same behavior with a tree delete.
-	if err := s.KVSDeleteTree(7, "foo/moo", nil); err != nil {
+	if err := s.KVSDeleteTree(7, "foo/moo", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	select {
@@ -131,7 +131,7 @@ func TestStateStore_GC(t *testing.T) {
 	}
 
 	// Check for the same behavior with a CAS delete.
-	if ok, err := s.KVSDeleteCAS(8, 3, "foo/baz", nil); !ok || err != nil {
+	if ok, err := s.KVSDeleteCAS(8, 3, "foo/baz", nil, nil); !ok || err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	select {
@@ -434,7 +434,7 @@ func TestStateStore_KVSList(t *testing.T) {
 	if err != nil {
 		t.Fatalf("err: %s", err)
 	}
-	if err := s.KVSDelete(6, "foo/bar/baz", nil); err != nil {
+	if err := s.KVSDelete(6, "foo/bar/baz", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	if !watchFired(ws) {
@@ -488,6 +488,67 @@ func TestStateStore_KVSList(t *testing.T) {
 	}
 }
 
+func TestStateStore_KVSListExpired(t *testing.T) {
+	s := testStateStore(t)
+	now := time.Now()
+
+	setWithExpiry := func(idx uint64, key string, expires *time.Time) {
+		t.Helper()
+		entry := &structs.DirEntry{Key: key, Value: []byte(key), Expires: expires}
+		require.NoError(t, s.KVSSet(idx, entry))
+	}
+	expiresAt := func(d time.Duration) *time.Time {
+		ts := now.Add(d)
+		return &ts
+	}
+
+	// Nothing has expired in an empty store.
+	entries, err := s.KVSListExpired(now, 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	setWithExpiry(1, "no-ttl", nil)
+	setWithExpiry(2, "later", expiresAt(time.Hour))
+	setWithExpiry(3, "first", expiresAt(-3*time.Minute))
+	setWithExpiry(4, "second", expiresAt(-2*time.Minute))
+	setWithExpiry(5, "third", expiresAt(-time.Minute))
+
+	keys := func(entries structs.DirEntries) []string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.Key)
+		}
+		return out
+	}
+
+	// Only the expired entries are returned, earliest first.
+	entries, err = s.KVSListExpired(now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "third"}, keys(entries))
+
+	// The number of entries returned is capped.
+	entries, err = s.KVSListExpired(now, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, keys(entries))
+
+	// Writing a key again without a TTL removes its expiry.
+	setWithExpiry(6, "first", nil)
+	entries, err = s.KVSListExpired(now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"second", "third"}, keys(entries))
+
+	// Deleted keys are no longer listed.
+	require.NoError(t, s.KVSDelete(7, "second", nil, nil))
+	entries, err = s.KVSListExpired(now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"third"}, keys(entries))
+
+	// Everything with a TTL has expired eventually.
+	entries, err = s.KVSListExpired(now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"third", "later"}, keys(entries))
+}
+
 func TestStateStore_KVSDelete(t *testing.T) {
 	s := testStateStore(t)
 
@@ -496,7 +557,7 @@ func TestStateStore_KVSDelete(t *testing.T) {
 	testSetKey(t, s, 2, "foo/bar", "bar", nil)
 
 	// Call a delete on a specific key
-	if err := s.KVSDelete(3, "foo", nil); err != nil {
+	if err := s.KVSDelete(3, "foo", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 
@@ -551,7 +612,7 @@ func TestStateStore_KVSDelete(t *testing.T) {
 
 	// Deleting a nonexistent key should be idempotent and not return an
 	// error
-	if err := s.KVSDelete(5, "foo", nil); err != nil {
+	if err := s.KVSDelete(5, "foo", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	if idx := s.maxIndex(partitionedIndexEntryName(tableKVs, "default")); idx != 3 {
@@ -568,7 +629,7 @@ func TestStateStore_KVSDeleteCAS(t *testing.T) {
 	testSetKey(t, s, 3, "baz", "baz", nil)
 
 	// Do a CAS delete with an index lower than the entry
-	ok, err := s.KVSDeleteCAS(4, 1, "bar", nil)
+	ok, err := s.KVSDeleteCAS(4, 1, "bar", nil, nil)
 	if ok || err != nil {
 		t.Fatalf("expected (false, nil), got: (%v, %#v)", ok, err)
 	}
@@ -588,7 +649,7 @@ func TestStateStore_KVSDeleteCAS(t *testing.T) {
 
 	// Do another CAS delete, this time with the correct index
 	// which should cause the delete to take place.
-	ok, err = s.KVSDeleteCAS(4, 2, "bar", nil)
+	ok, err = s.KVSDeleteCAS(4, 2, "bar", nil, nil)
 	if !ok || err != nil {
 		t.Fatalf("expected (true, nil), got: (%v, %#v)", ok, err)
 	}
@@ -633,7 +694,7 @@ func TestStateStore_KVSDeleteCAS(t *testing.T) {
 
 	// A delete on a nonexistent key should be idempotent and not return an
 	// error
-	ok, err = s.KVSDeleteCAS(7, 2, "bar", nil)
+	ok, err = s.KVSDeleteCAS(7, 2, "bar", nil, nil)
 	if !ok || err != nil {
 		t.Fatalf("expected (true, nil), got: (%v, %#v)", ok, err)
 	}
@@ -853,7 +914,7 @@ func TestStateStore_KVSDeleteTree(t *testing.T) {
 
 	// Calling tree deletion which affects nothing does not
 	// modify the table index.
-	if err := s.KVSDeleteTree(9, "bar", nil); err != nil {
+	if err := s.KVSDeleteTree(9, "bar", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 	if idx := s.maxIndex(partitionedIndexEntryName(tableKVs, "default")); idx != 4 {
@@ -861,7 +922,7 @@ func TestStateStore_KVSDeleteTree(t *testing.T) {
 	}
 
 	// Call tree deletion with a nested prefix.
-	if err := s.KVSDeleteTree(5, "foo/bar", nil); err != nil {
+	if err := s.KVSDeleteTree(5, "foo/bar", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 
@@ -937,7 +998,7 @@ func TestStateStore_Watches_PrefixDelete(t *testing.T) {
 	}
 
 	// Delete a key and make sure the index comes from the tombstone.
-	if err := s.KVSDeleteTree(7, "foo/bar/zip", nil); err != nil {
+	if err := s.KVSDeleteTree(7, "foo/bar/zip", nil, nil); err != nil {
 		t.Fatalf("unexpected err: %s", err)
 	}
 	// Make sure watch fires
@@ -1002,7 +1063,7 @@ func TestStateStore_Watches_PrefixDelete(t *testing.T) {
 	}
 
 	// Delete all the keys, special case where tombstones are not inserted
-	if err := s.KVSDeleteTree(10, "", nil); err != nil {
+	if err := s.KVSDeleteTree(10, "", nil, nil); err != nil {
 		t.Fatalf("unexpected err: %s", err)
 	}
 	wantIndex = 10
@@ -1375,7 +1436,7 @@ func TestStateStore_Tombstone_Snapshot_Restore(t *testing.T) {
 	testSetKey(t, s, 1, "foo/bar", "bar", nil)
 	testSetKey(t, s, 2, "foo/bar/baz", "bar", nil)
 	testSetKey(t, s, 3, "foo/bar/zoo", "bar", nil)
-	if err := s.KVSDelete(4, "foo/bar", nil); err != nil {
+	if err := s.KVSDelete(4, "foo/bar", nil, nil); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 
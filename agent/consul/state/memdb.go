@@ -5,6 +5,7 @@ package state
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -56,6 +57,10 @@ type changeTrackerDB struct {
 	db             *memdb.MemDB
 	publisher      EventPublisher
 	processChanges func(ReadTxn, Changes) ([]stream.Event, error)
+
+	// kvsHistoryRetainCount caches the KV history retain count of the system
+	// metadata, so that KV writes don't look it up while history is disabled.
+	kvsHistoryRetainCount atomic.Int64
 }
 
 type EventPublisher interface {
@@ -93,10 +98,11 @@ func (c *changeTrackerDB) ReadTxn() *memdb.Txn {
 // data directly into the DB. These cases may use WriteTxnRestore.
 func (c *changeTrackerDB) WriteTxn(idx uint64) *txn {
 	t := &txn{
-		Txn:        c.db.Txn(true),
-		Index:      idx,
-		publish:    c.publisher.Publish,
-		prePublish: c.processChanges,
+		Txn:                   c.db.Txn(true),
+		Index:                 idx,
+		publish:               c.publisher.Publish,
+		prePublish:            c.processChanges,
+		kvsHistoryRetainCount: &c.kvsHistoryRetainCount,
 	}
 	t.Txn.TrackChanges()
 	return t
@@ -141,6 +147,10 @@ type txn struct {
 
 	prePublish prePublishFuncType
 
+	// kvsHistoryRetainCount is the cached KV history retain count of the
+	// store. It is nil for WriteTxnRestore transactions.
+	kvsHistoryRetainCount *atomic.Int64
+
 	commitLock sync.Mutex
 }
 
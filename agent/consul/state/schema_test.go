@@ -57,8 +57,9 @@ func TestNewDBSchema_Indexers(t *testing.T) {
 		tableServiceVirtualIPs: testIndexerTableServiceVirtualIPs,
 		tableKindServiceNames:  testIndexerTableKindServiceNames,
 		// KV
-		tableKVs:        testIndexerTableKVs,
-		tableTombstones: testIndexerTableTombstones,
+		tableKVs:          testIndexerTableKVs,
+		tableTombstones:   testIndexerTableTombstones,
+		tableKVSRevisions: testIndexerTableKVSRevisions,
 		// config
 		tableConfigEntries: testIndexerTableConfigEntries,
 		// peerings
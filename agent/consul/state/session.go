@@ -243,6 +243,7 @@ func (s *Store) sessionCreateTxn(tx WriteTxn, idx uint64, sess *structs.Session)
 		sess.Behavior = structs.SessionKeysRelease
 	case structs.SessionKeysRelease:
 	case structs.SessionKeysDelete:
+	case structs.SessionKeysTransfer:
 	default:
 		return fmt.Errorf("Invalid session behavior: %s", sess.Behavior)
 	}
@@ -374,8 +375,34 @@ func (s *Store) deleteSessionTxn(tx WriteTxn, idx uint64, sessionID string, entM
 		kvs = append(kvs, entry)
 	}
 
+	behavior := session.Behavior
+	var successor *structs.Session
+	if behavior == structs.SessionKeysTransfer {
+		successor, err = sessionSuccessorTxn(tx, session, entMeta)
+		if err != nil {
+			return err
+		}
+		if successor == nil {
+			// There is no one left to take over, fall back to releasing the
+			// locks.
+			behavior = structs.SessionKeysRelease
+		}
+	}
+
 	// Invalidate any held locks.
-	switch session.Behavior {
+	switch behavior {
+	case structs.SessionKeysTransfer:
+		for _, obj := range kvs {
+			// The successor takes over the lock as if it had acquired it, so
+			// the lock index is bumped but the value and flags are kept. No
+			// lock delay is applied since the key never becomes free.
+			e := obj.(*structs.DirEntry).Clone()
+			e.Session = successor.ID
+			e.LockIndex++
+			if err := kvsSetTxn(tx, idx, e, true); err != nil {
+				return fmt.Errorf("failed kvs update: %s", err)
+			}
+		}
 	case structs.SessionKeysRelease:
 		for _, obj := range kvs {
 			// Note that we clone here since we are modifying the
@@ -395,7 +422,7 @@ func (s *Store) deleteSessionTxn(tx WriteTxn, idx uint64, sessionID string, entM
 	case structs.SessionKeysDelete:
 		for _, obj := range kvs {
 			e := obj.(*structs.DirEntry)
-			if err := s.kvsDeleteTxn(tx, idx, e.Key, entMeta); err != nil {
+			if err := s.kvsDeleteTxn(tx, idx, e.Key, entMeta, nil); err != nil {
 				return fmt.Errorf("failed kvs delete: %s", err)
 			}
 
@@ -453,6 +480,24 @@ func (s *Store) deleteSessionTxn(tx WriteTxn, idx uint64, sessionID string, entM
 	return s.updateSessionCheck(tx, idx, session, api.HealthCritical)
 }
 
+// sessionSuccessorTxn returns the first successor of session that still
+// exists, or nil if there is none.
+func sessionSuccessorTxn(tx ReadTxn, session *structs.Session, entMeta *acl.EnterpriseMeta) (*structs.Session, error) {
+	for _, id := range session.Successors {
+		if id == session.ID {
+			continue
+		}
+		sess, err := tx.First(tableSessions, indexID, Query{Value: id, EnterpriseMeta: *entMeta})
+		if err != nil {
+			return nil, fmt.Errorf("failed session lookup: %s", err)
+		}
+		if sess != nil {
+			return sess.(*structs.Session), nil
+		}
+	}
+	return nil, nil
+}
+
 // updateSessionCheck The method updates the health-checks associated with the session
 func (s *Store) updateSessionCheck(tx WriteTxn, idx uint64, session *structs.Session, checkState string) error {
 	// Find all checks for the given Node
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -900,6 +901,94 @@ func TestStateStore_Session_Invalidate_Key_Delete_Behavior(t *testing.T) {
 	}
 }
 
+func TestStateStore_Session_Invalidate_Key_Transfer_Behavior(t *testing.T) {
+	s := testStateStore(t)
+
+	// Set up our test environment.
+	testRegisterNode(t, s, 1, "foo")
+	testRegisterNode(t, s, 2, "bar")
+	successor := &structs.Session{ID: testUUID(), Node: "bar"}
+	require.NoError(t, s.SessionCreate(3, successor))
+	session := &structs.Session{
+		ID:        testUUID(),
+		Node:      "foo",
+		LockDelay: 50 * time.Millisecond,
+		Behavior:  structs.SessionKeysTransfer,
+		// The first successor does not exist, so the second one is picked.
+		Successors: []string{testUUID(), successor.ID},
+	}
+	require.NoError(t, s.SessionCreate(4, session))
+
+	// Lock a key with the session.
+	ok, err := s.KVSLock(5, &structs.DirEntry{
+		Key:     "/bar",
+		Flags:   42,
+		Value:   []byte("test"),
+		Session: session.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Delete the node and make sure the watches fire.
+	ws := memdb.NewWatchSet()
+	_, _, err = s.KVSGet(ws, "/bar", nil)
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteNode(6, "foo", nil, ""))
+	require.True(t, watchFired(ws))
+
+	// The key is held by the successor with its contents intact.
+	idx, d, err := s.KVSGet(nil, "/bar", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), idx)
+	require.NotNil(t, d)
+	require.Equal(t, successor.ID, d.Session)
+	require.Equal(t, []byte("test"), d.Value)
+	require.Equal(t, uint64(42), d.Flags)
+	require.Equal(t, uint64(2), d.LockIndex)
+	require.Equal(t, uint64(6), d.ModifyIndex)
+
+	// The key never became free, so there is no lock delay.
+	require.True(t, s.KVSLockDelay("/bar", nil).IsZero())
+
+	// The successor can release the lock.
+	ok, err = s.KVSUnlock(7, &structs.DirEntry{Key: "/bar", Value: []byte("test"), Session: successor.ID})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestStateStore_Session_Invalidate_Key_Transfer_Behavior_NoSuccessor(t *testing.T) {
+	s := testStateStore(t)
+
+	// Set up our test environment.
+	testRegisterNode(t, s, 1, "foo")
+	session := &structs.Session{
+		ID:         testUUID(),
+		Node:       "foo",
+		LockDelay:  50 * time.Millisecond,
+		Behavior:   structs.SessionKeysTransfer,
+		Successors: []string{testUUID()},
+	}
+	require.NoError(t, s.SessionCreate(2, session))
+
+	ok, err := s.KVSLock(3, &structs.DirEntry{Key: "/bar", Value: []byte("test"), Session: session.ID})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, s.SessionDestroy(4, session.ID, nil))
+
+	// With no successor left the lock is released.
+	_, d, err := s.KVSGet(nil, "/bar", nil)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	require.Empty(t, d.Session)
+	require.Equal(t, []byte("test"), d.Value)
+	require.Equal(t, uint64(1), d.LockIndex)
+
+	// Key should have a lock delay.
+	expires := s.KVSLockDelay("/bar", nil)
+	require.True(t, expires.After(time.Now().Add(30*time.Millisecond)), "bad: %v", expires)
+}
+
 func TestStateStore_Session_Invalidate_PreparedQuery_Delete(t *testing.T) {
 	s := testStateStore(t)
 
@@ -250,7 +250,10 @@ func (s *Restore) Abort() {
 // Commit commits the changes made by a restore. This or Abort should always be
 // called.
 func (s *Restore) Commit() error {
-	return s.tx.Commit()
+	if err := s.tx.Commit(); err != nil {
+		return err
+	}
+	return s.store.refreshKVSHistoryRetainCount()
 }
 
 // AbandonCh returns a channel you can wait on to know if the state store was
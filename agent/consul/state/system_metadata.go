@@ -67,7 +67,10 @@ func (s *Store) SystemMetadataSet(idx uint64, entry *structs.SystemMetadataEntry
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return s.refreshKVSHistoryRetainCount()
 }
 
 // systemMetadataSetTxn upserts a system metadata inside of a transaction.
@@ -170,7 +173,10 @@ func (s *Store) SystemMetadataDelete(idx uint64, entry *structs.SystemMetadataEn
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return s.refreshKVSHistoryRetainCount()
 }
 
 func systemMetadataDeleteTxn(tx WriteTxn, idx uint64, key string) error {
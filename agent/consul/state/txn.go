@@ -4,8 +4,10 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
@@ -35,17 +37,17 @@ func (s *Store) txnKVS(tx WriteTxn, idx uint64, op *structs.TxnKVOp) (structs.Tx
 		err = kvsSetTxn(tx, idx, entry, false)
 
 	case api.KVDelete:
-		err = s.kvsDeleteTxn(tx, idx, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta)
+		err = s.kvsDeleteTxn(tx, idx, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta, op.DirEnt.RevisionTime)
 
 	case api.KVDeleteCAS:
 		var ok bool
-		ok, err = s.kvsDeleteCASTxn(tx, idx, op.DirEnt.ModifyIndex, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta)
+		ok, err = s.kvsDeleteCASTxn(tx, idx, op.DirEnt.ModifyIndex, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta, op.DirEnt.RevisionTime)
 		if !ok && err == nil {
 			err = fmt.Errorf("failed to delete key %q, index is stale", op.DirEnt.Key)
 		}
 
 	case api.KVDeleteTree:
-		err = s.kvsDeleteTreeTxn(tx, idx, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta)
+		err = s.kvsDeleteTreeTxn(tx, idx, op.DirEnt.Key, &op.DirEnt.EnterpriseMeta, op.DirEnt.RevisionTime)
 
 	case api.KVCAS:
 		var ok bool
@@ -108,6 +110,32 @@ func (s *Store) txnKVS(tx WriteTxn, idx uint64, op *structs.TxnKVOp) (structs.Tx
 			err = fmt.Errorf("key %q exists", op.DirEnt.Key)
 		}
 
+	case api.KVCompareValue:
+		_, entry, err = kvsGetTxn(tx, nil, op.DirEnt.Key, op.DirEnt.EnterpriseMeta)
+		if entry == nil && err == nil {
+			err = fmt.Errorf("key %q doesn't exist", op.DirEnt.Key)
+			break
+		}
+		if err == nil {
+			var ok bool
+			ok, err = kvCompare(op.Comparison, bytes.Compare(entry.Value, op.DirEnt.Value))
+			if !ok && err == nil {
+				err = fmt.Errorf("value of key %q failed the %q comparison", op.DirEnt.Key, comparisonOrDefault(op.Comparison))
+			}
+		}
+
+	case api.KVComparePrefixCount:
+		var entries structs.DirEntries
+		_, entries, err = kvsListEntriesTxn(tx, nil, op.DirEnt.Key, op.DirEnt.EnterpriseMeta)
+		if err == nil {
+			count := uint64(len(entries))
+			var ok bool
+			ok, err = kvCompare(op.Comparison, compareUint64(count, op.Count))
+			if !ok && err == nil {
+				err = fmt.Errorf("prefix %q has %d keys, which failed the %q comparison with %d", op.DirEnt.Key, count, comparisonOrDefault(op.Comparison), op.Count)
+			}
+		}
+
 	default:
 		err = &UnsupportedFSMApplyPanicError{fmt.Errorf("unknown KV verb %q", op.Verb)}
 	}
@@ -133,6 +161,49 @@ func (s *Store) txnKVS(tx WriteTxn, idx uint64, op *structs.TxnKVOp) (structs.Tx
 	return nil, nil
 }
 
+// kvCompare reports whether the result of comparing two values, as returned
+// by bytes.Compare, satisfies the given comparison.
+func kvCompare(comparison api.KVComparison, cmp int) (bool, error) {
+	// enumcover:api.KVComparison
+	switch comparisonOrDefault(comparison) {
+	case api.KVCompareEqual:
+		return cmp == 0, nil
+	case api.KVCompareNotEqual:
+		return cmp != 0, nil
+	case api.KVCompareLess:
+		return cmp < 0, nil
+	case api.KVCompareLessOrEqual:
+		return cmp <= 0, nil
+	case api.KVCompareGreater:
+		return cmp > 0, nil
+	case api.KVCompareGreaterOrEqual:
+		return cmp >= 0, nil
+	default:
+		return false, fmt.Errorf("unknown comparison %q", comparison)
+	}
+}
+
+// comparisonOrDefault returns the given comparison, or equal if it is empty.
+func comparisonOrDefault(comparison api.KVComparison) api.KVComparison {
+	if comparison == "" {
+		return api.KVCompareEqual
+	}
+	return comparison
+}
+
+// compareUint64 compares two integers the way bytes.Compare compares byte
+// slices.
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // txnSession handles all Session-related operations.
 func txnSession(tx WriteTxn, idx uint64, op *structs.TxnSessionOp) error {
 	var err error
@@ -291,11 +362,50 @@ func (s *Store) txnService(tx WriteTxn, idx uint64, op *structs.TxnServiceOp) (s
 		}
 		return nil, err
 
+	case api.ServiceCheckHealth:
+		return txnServiceCheckHealth(tx, op)
+
 	default:
 		return nil, &UnsupportedFSMApplyPanicError{fmt.Errorf("unknown Service verb %q", op.Verb)}
 	}
 }
 
+// txnServiceCheckHealth fails unless the service instance and the node it is
+// registered on only have passing health checks. The service must have the
+// name given in the operation, which is what the ACL check was done on.
+func txnServiceCheckHealth(tx ReadTxn, op *structs.TxnServiceOp) (structs.TxnResults, error) {
+	id := op.Service.ID
+	if id == "" {
+		id = op.Service.Service
+	}
+	entry, err := getNodeServiceTxn(tx, nil, op.Node, id, &op.Service.EnterpriseMeta, op.Service.PeerName)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || entry.Service != op.Service.Service {
+		return nil, fmt.Errorf("service %q on node %q doesn't exist", id, op.Node)
+	}
+
+	iter, err := catalogListChecksByNode(tx, Query{
+		Value:          op.Node,
+		EnterpriseMeta: op.Service.EnterpriseMeta,
+		PeerName:       op.Service.PeerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed check lookup: %s", err)
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		check := raw.(*structs.HealthCheck)
+		if check.ServiceID != "" && check.ServiceID != id {
+			continue
+		}
+		if check.Status != api.HealthPassing {
+			return nil, fmt.Errorf("service %q on node %q is not healthy, check %q is %s", id, op.Node, check.CheckID, check.Status)
+		}
+	}
+	return newTxnResultFromNodeServiceEntry(entry), nil
+}
+
 // newTxnResultFromNodeServiceEntry returns a TxnResults with a single result,
 // a copy of entry. The entry is copied to prevent modification of the state
 // store.
@@ -370,33 +480,74 @@ func (s *Store) txnCheck(tx WriteTxn, idx uint64, op *structs.TxnCheckOp) (struc
 	return nil, nil
 }
 
+// txnIf evaluates the conditions of a conditional operation and runs the
+// operations of the branch they select. A condition that doesn't hold is not
+// an error, it selects the Else branch.
+func (s *Store) txnIf(tx WriteTxn, idx uint64, op *structs.TxnIfOp) (structs.TxnResults, error) {
+	succeeded := true
+	for i, cond := range op.Conditions {
+		if !cond.IsCondition() {
+			return nil, fmt.Errorf("condition %d is not a check operation", i)
+		}
+		_, err := s.txnOp(tx, idx, cond)
+		var panicErr *UnsupportedFSMApplyPanicError
+		if errors.As(err, &panicErr) {
+			return nil, err
+		}
+		if err != nil {
+			succeeded = false
+			break
+		}
+	}
+
+	branch, ops := "then", op.Then
+	if !succeeded {
+		branch, ops = "else", op.Else
+	}
+	results, errs := s.txnDispatch(tx, idx, ops)
+	if len(errs) > 0 {
+		whats := make([]string, 0, len(errs))
+		for _, e := range errs {
+			whats = append(whats, fmt.Sprintf("%s op %d: %s", branch, e.OpIndex, e.What))
+		}
+		return nil, errors.New(strings.Join(whats, "; "))
+	}
+
+	result := structs.TxnResult{If: &structs.TxnIfResult{Succeeded: succeeded}}
+	return append(structs.TxnResults{&result}, results...), nil
+}
+
+// txnOp runs a single operation inside the state store transaction.
+func (s *Store) txnOp(tx WriteTxn, idx uint64, op *structs.TxnOp) (structs.TxnResults, error) {
+	// Dispatch based on the type of operation.
+	switch {
+	case op.KV != nil:
+		return s.txnKVS(tx, idx, op.KV)
+	case op.Node != nil:
+		return s.txnNode(tx, idx, op.Node)
+	case op.Service != nil:
+		return s.txnService(tx, idx, op.Service)
+	case op.Check != nil:
+		return s.txnCheck(tx, idx, op.Check)
+	case op.Session != nil:
+		return nil, txnSession(tx, idx, op.Session)
+	case op.If != nil:
+		return s.txnIf(tx, idx, op.If)
+	case op.Intention != nil:
+		// NOTE: this branch is deprecated and exists for backwards
+		// compatibility with pre-1.9.0 raft logs and during upgrades.
+		return nil, txnLegacyIntention(tx, idx, op.Intention)
+	default:
+		panic("no operation specified")
+	}
+}
+
 // txnDispatch runs the given operations inside the state store transaction.
 func (s *Store) txnDispatch(tx WriteTxn, idx uint64, ops structs.TxnOps) (structs.TxnResults, structs.TxnErrors) {
 	results := make(structs.TxnResults, 0, len(ops))
 	errs := make(structs.TxnErrors, 0, len(ops))
 	for i, op := range ops {
-		var ret structs.TxnResults
-		var err error
-
-		// Dispatch based on the type of operation.
-		switch {
-		case op.KV != nil:
-			ret, err = s.txnKVS(tx, idx, op.KV)
-		case op.Node != nil:
-			ret, err = s.txnNode(tx, idx, op.Node)
-		case op.Service != nil:
-			ret, err = s.txnService(tx, idx, op.Service)
-		case op.Check != nil:
-			ret, err = s.txnCheck(tx, idx, op.Check)
-		case op.Session != nil:
-			err = txnSession(tx, idx, op.Session)
-		case op.Intention != nil:
-			// NOTE: this branch is deprecated and exists for backwards
-			// compatibility with pre-1.9.0 raft logs and during upgrades.
-			err = txnLegacyIntention(tx, idx, op.Intention)
-		default:
-			panic("no operation specified")
-		}
+		ret, err := s.txnOp(tx, idx, op)
 
 		// Accumulate the results.
 		results = append(results, ret...)
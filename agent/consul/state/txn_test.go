@@ -1412,6 +1412,228 @@ func TestStateStore_Txn_KVS_ModifyIndexes(t *testing.T) {
 // sync with other agents that applied the operation. In the case of responding to a local endpoint, we require
 // that the operation type be validated prior to being sent to the state store.
 // See NET-9016 for historical context.
+func TestStateStore_Txn_KVS_Compare(t *testing.T) {
+	s := testStateStore(t)
+
+	testSetKey(t, s, 1, "foo", "bar", nil)
+	testSetKey(t, s, 2, "foo/a", "1", nil)
+	testSetKey(t, s, 3, "foo/b", "2", nil)
+
+	compareValue := func(key, value string, comparison api.KVComparison) *structs.TxnOp {
+		return &structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb:       api.KVCompareValue,
+				DirEnt:     structs.DirEntry{Key: key, Value: []byte(value)},
+				Comparison: comparison,
+			},
+		}
+	}
+	comparePrefixCount := func(prefix string, count uint64, comparison api.KVComparison) *structs.TxnOp {
+		return &structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb:       api.KVComparePrefixCount,
+				DirEnt:     structs.DirEntry{Key: prefix},
+				Comparison: comparison,
+				Count:      count,
+			},
+		}
+	}
+
+	cases := []struct {
+		name string
+		op   *structs.TxnOp
+		err  string
+	}{
+		{"value equal", compareValue("foo", "bar", ""), ""},
+		{"value not equal", compareValue("foo", "baz", api.KVCompareNotEqual), ""},
+		{"value less", compareValue("foo", "baz", api.KVCompareLess), ""},
+		{"value less or equal", compareValue("foo", "bar", api.KVCompareLessOrEqual), ""},
+		{"value greater", compareValue("foo", "bar", api.KVCompareGreater), `value of key "foo" failed the "greater" comparison`},
+		{"value greater or equal", compareValue("foo", "ba", api.KVCompareGreaterOrEqual), ""},
+		{"value mismatch", compareValue("foo", "baz", ""), `value of key "foo" failed the "equal" comparison`},
+		{"value missing key", compareValue("nope", "", ""), `key "nope" doesn't exist`},
+		{"count equal", comparePrefixCount("foo/", 2, ""), ""},
+		{"count all keys", comparePrefixCount("", 3, api.KVCompareEqual), ""},
+		{"count less", comparePrefixCount("foo/", 3, api.KVCompareLess), ""},
+		{"count greater", comparePrefixCount("foo/", 2, api.KVCompareGreater), `prefix "foo/" has 2 keys, which failed the "greater" comparison with 2`},
+		{"count empty prefix", comparePrefixCount("bar/", 0, ""), ""},
+		{"unknown comparison", compareValue("foo", "bar", "about"), `unknown comparison "about"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, errors := s.TxnRO(structs.TxnOps{tc.op})
+			if tc.err == "" {
+				require.Empty(t, errors)
+				return
+			}
+			require.Nil(t, results)
+			require.Len(t, errors, 1)
+			require.Equal(t, tc.err, errors[0].What)
+		})
+	}
+
+	// A successful value comparison returns the entry without its value.
+	results, errors := s.TxnRO(structs.TxnOps{compareValue("foo", "bar", "")})
+	require.Empty(t, errors)
+	require.Len(t, results, 1)
+	require.Equal(t, "foo", results[0].KV.Key)
+	require.Nil(t, results[0].KV.Value)
+}
+
+func TestStateStore_Txn_Service_CheckHealth(t *testing.T) {
+	s := testStateStore(t)
+
+	testRegisterNode(t, s, 1, "node1")
+	testRegisterService(t, s, 2, "node1", "web")
+	testRegisterService(t, s, 3, "node1", "db")
+	testRegisterCheck(t, s, 4, "node1", "", "serfHealth", api.HealthPassing)
+	testRegisterCheck(t, s, 5, "node1", "web", "web-check", api.HealthPassing)
+	testRegisterCheck(t, s, 6, "node1", "db", "db-check", api.HealthCritical)
+
+	checkHealth := func(id, name string) structs.TxnOps {
+		return structs.TxnOps{
+			&structs.TxnOp{
+				Service: &structs.TxnServiceOp{
+					Verb:    api.ServiceCheckHealth,
+					Node:    "node1",
+					Service: structs.NodeService{ID: id, Service: name},
+				},
+			},
+		}
+	}
+
+	// The service ID defaults to the name.
+	results, errors := s.TxnRO(checkHealth("", "web"))
+	require.Empty(t, errors)
+	require.Len(t, results, 1)
+	require.Equal(t, "web", results[0].Service.ID)
+
+	// The check of another service doesn't matter, its own does.
+	_, errors = s.TxnRO(checkHealth("db", "db"))
+	require.Len(t, errors, 1)
+	require.Equal(t, `service "db" on node "node1" is not healthy, check "db-check" is critical`, errors[0].What)
+
+	// The name must match the registered service.
+	_, errors = s.TxnRO(checkHealth("web", "db"))
+	require.Len(t, errors, 1)
+	require.Equal(t, `service "web" on node "node1" doesn't exist`, errors[0].What)
+
+	// A failing node check fails every service on the node.
+	testRegisterCheck(t, s, 7, "node1", "", "serfHealth", api.HealthWarning)
+	_, errors = s.TxnRO(checkHealth("web", "web"))
+	require.Len(t, errors, 1)
+	require.Equal(t, `service "web" on node "node1" is not healthy, check "serfHealth" is warning`, errors[0].What)
+}
+
+func TestStateStore_Txn_If(t *testing.T) {
+	s := testStateStore(t)
+
+	testSetKey(t, s, 1, "config/version", "1", nil)
+
+	// Bump the version only if it's still the one we read, and record which
+	// branch ran in another key.
+	ifOp := func(version string) structs.TxnOps {
+		return structs.TxnOps{
+			&structs.TxnOp{
+				If: &structs.TxnIfOp{
+					Conditions: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVCompareValue,
+								DirEnt: structs.DirEntry{Key: "config/version", Value: []byte(version)},
+							},
+						},
+					},
+					Then: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVSet,
+								DirEnt: structs.DirEntry{Key: "config/version", Value: []byte("2")},
+							},
+						},
+					},
+					Else: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVGet,
+								DirEnt: structs.DirEntry{Key: "config/version"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	results, errors := s.TxnRW(2, ifOp("1"))
+	require.Empty(t, errors)
+	require.Len(t, results, 2)
+	require.Equal(t, &structs.TxnIfResult{Succeeded: true}, results[0].If)
+	require.Equal(t, "config/version", results[1].KV.Key)
+
+	_, entry, err := s.KVSGet(nil, "config/version", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), entry.Value)
+
+	// The version changed, so the else branch reads the current one.
+	results, errors = s.TxnRW(3, ifOp("1"))
+	require.Empty(t, errors)
+	require.Len(t, results, 2)
+	require.Equal(t, &structs.TxnIfResult{Succeeded: false}, results[0].If)
+	require.Equal(t, []byte("2"), results[1].KV.Value)
+
+	// An error in the selected branch fails the whole transaction.
+	ops := structs.TxnOps{
+		&structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb:   api.KVSet,
+				DirEnt: structs.DirEntry{Key: "other", Value: []byte("x")},
+			},
+		},
+		&structs.TxnOp{
+			If: &structs.TxnIfOp{
+				Then: structs.TxnOps{
+					&structs.TxnOp{
+						KV: &structs.TxnKVOp{
+							Verb:   api.KVCheckNotExists,
+							DirEnt: structs.DirEntry{Key: "config/version"},
+						},
+					},
+				},
+			},
+		},
+	}
+	results, errors = s.TxnRW(4, ops)
+	require.Nil(t, results)
+	require.Equal(t, structs.TxnErrors{
+		{OpIndex: 1, What: `then op 0: key "config/version" exists`},
+	}, errors)
+
+	_, entry, err = s.KVSGet(nil, "other", nil)
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	// Only check operations can be conditions.
+	ops = structs.TxnOps{
+		&structs.TxnOp{
+			If: &structs.TxnIfOp{
+				Conditions: structs.TxnOps{
+					&structs.TxnOp{
+						KV: &structs.TxnKVOp{
+							Verb:   api.KVDelete,
+							DirEnt: structs.DirEntry{Key: "config/version"},
+						},
+					},
+				},
+			},
+		},
+	}
+	_, errors = s.TxnRW(5, ops)
+	require.Equal(t, structs.TxnErrors{
+		{OpIndex: 0, What: "condition 0 is not a check operation"},
+	}, errors)
+}
+
 func TestStateStore_UnknownTxnOperationsPanic(t *testing.T) {
 	s := testStateStore(t)
 
@@ -1461,6 +1683,19 @@ func TestStateStore_UnknownTxnOperationsPanic(t *testing.T) {
 				},
 			},
 		},
+		{
+			&structs.TxnOp{
+				If: &structs.TxnIfOp{
+					Then: structs.TxnOps{
+						&structs.TxnOp{
+							Node: &structs.TxnNodeOp{
+								Verb: "mow-the-lawn",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,7 +144,7 @@ func TestStateStore_Usage_KVUsage_Delete(t *testing.T) {
 	require.Equal(t, idx, uint64(2))
 	require.Equal(t, usage.KVCount, 2)
 
-	require.NoError(t, s.KVSDelete(3, "key-2", nil))
+	require.NoError(t, s.KVSDelete(3, "key-2", nil, nil))
 	idx, usage, err = s.KVUsage()
 	require.NoError(t, err)
 	require.Equal(t, idx, uint64(3))
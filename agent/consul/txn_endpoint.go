@@ -10,6 +10,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-version"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/acl/resolver"
@@ -28,6 +29,11 @@ var TxnSummaries = []prometheus.SummaryDefinition{
 	},
 }
 
+// minTxnConditionalOpsVersion is the minimum version for all Consul servers
+// for conditional operations, comparisons and service health checks to be
+// accepted in transactions. Older servers can't apply them from the Raft log.
+var minTxnConditionalOpsVersion = version.Must(version.NewVersion("1.22.0"))
+
 // Txn endpoint is used to perform multi-object atomic transactions.
 type Txn struct {
 	srv    *Server
@@ -43,6 +49,13 @@ func (t *Txn) preCheck(authorizer resolver.Result, ops structs.TxnOps) structs.T
 	for i, op := range ops {
 		switch {
 		case op.KV != nil:
+			if err := kvComparisonValidate(op.KV); err != nil {
+				errors = append(errors, &structs.TxnError{
+					OpIndex: i,
+					What:    err.Error(),
+				})
+				break
+			}
 			ok, err := kvsPreApply(t.logger, t.srv, authorizer, op.KV.Verb, &op.KV.DirEnt)
 			if err != nil {
 				errors = append(errors, &structs.TxnError{
@@ -94,6 +107,17 @@ func (t *Txn) preCheck(authorizer resolver.Result, ops structs.TxnOps) structs.T
 				})
 				break
 			}
+			if op.Service.Verb == api.ServiceCheckHealth {
+				// The outcome reveals the health of the service and its
+				// node, so check that the token can read both.
+				if err := vetServiceHealthTxnOp(op.Service, authorizer); err != nil {
+					errors = append(errors, &structs.TxnError{
+						OpIndex: i,
+						What:    err.Error(),
+					})
+				}
+				break
+			}
 			if !requiresPreApply {
 				break
 			}
@@ -141,6 +165,13 @@ func (t *Txn) preCheck(authorizer resolver.Result, ops structs.TxnOps) structs.T
 					What:    err.Error(),
 				})
 			}
+		case op.If != nil:
+			for _, err := range t.preCheckIf(authorizer, op.If) {
+				errors = append(errors, &structs.TxnError{
+					OpIndex: i,
+					What:    err.Error(),
+				})
+			}
 		default:
 			errors = append(errors, &structs.TxnError{
 				OpIndex: i,
@@ -152,6 +183,36 @@ func (t *Txn) preCheck(authorizer resolver.Result, ops structs.TxnOps) structs.T
 	return errors
 }
 
+// preCheckIf verifies the conditions and both branches of a conditional
+// operation. Errors for nested operations name the list and the index of the
+// operation they are about.
+func (t *Txn) preCheckIf(authorizer resolver.Result, op *structs.TxnIfOp) []error {
+	var errs []error
+	for i, cond := range op.Conditions {
+		if !cond.IsCondition() {
+			errs = append(errs, fmt.Errorf("condition %d is not a check operation", i))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	branches := []struct {
+		name string
+		ops  structs.TxnOps
+	}{
+		{"condition", op.Conditions},
+		{"then op", op.Then},
+		{"else op", op.Else},
+	}
+	for _, branch := range branches {
+		for _, err := range t.preCheck(authorizer, branch.ops) {
+			errs = append(errs, fmt.Errorf("%s %d: %s", branch.name, err.OpIndex, err.What))
+		}
+	}
+	return errs
+}
+
 // vetNodeTxnOp applies the given ACL policy to a node transaction operation.
 func vetNodeTxnOp(op *structs.TxnNodeOp, authz resolver.Result) error {
 	var authzContext acl.AuthorizerContext
@@ -182,6 +243,25 @@ func vetCheckTxnOp(op *structs.TxnCheckOp, authz resolver.Result) error {
 	return nil
 }
 
+// vetServiceHealthTxnOp applies the given ACL policy to a service health check
+// transaction operation.
+func vetServiceHealthTxnOp(op *structs.TxnServiceOp, authz resolver.Result) error {
+	if op.Service.Service == "" {
+		return fmt.Errorf("Must provide service name")
+	}
+
+	var authzContext acl.AuthorizerContext
+	op.Service.FillAuthzContext(&authzContext)
+
+	if err := authz.ToAllowAuthorizer().ServiceReadAllowed(op.Service.Service, &authzContext); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().NodeReadAllowed(op.Node, &authzContext); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Apply is used to apply multiple operations in a single, atomic transaction.
 func (t *Txn) Apply(args *structs.TxnRequest, reply *structs.TxnResponse) error {
 	if done, err := t.srv.ForwardRPC("Txn.Apply", args, reply); done {
@@ -189,6 +269,14 @@ func (t *Txn) Apply(args *structs.TxnRequest, reply *structs.TxnResponse) error
 	}
 	defer metrics.MeasureSince([]string{"txn", "apply"}, time.Now())
 
+	// Followers on older versions would fail to apply the new operations.
+	if txnOpsRequireConditionalSupport(args.Ops) {
+		if ok, _ := ServersInDCMeetMinimumVersion(t.srv, t.srv.config.Datacenter, minTxnConditionalOpsVersion); !ok {
+			return fmt.Errorf("all servers must be >= %s to apply conditional, compare or service health check operations",
+				minTxnConditionalOpsVersion.String())
+		}
+	}
+
 	// Run the pre-checks before we send the transaction into Raft.
 	authz, err := t.srv.ResolveToken(args.Token)
 	if err != nil {
@@ -216,6 +304,28 @@ func (t *Txn) Apply(args *structs.TxnRequest, reply *structs.TxnResponse) error
 	return nil
 }
 
+// txnOpsRequireConditionalSupport returns true if any of the operations is
+// a conditional operation, a comparison or a service health check, which
+// older servers don't know about.
+func txnOpsRequireConditionalSupport(ops structs.TxnOps) bool {
+	for _, op := range ops {
+		switch {
+		case op.If != nil:
+			return true
+		case op.KV != nil:
+			if op.KV.Verb == api.KVCompareValue || op.KV.Verb == api.KVComparePrefixCount ||
+				op.KV.Comparison != "" || op.KV.Count != 0 {
+				return true
+			}
+		case op.Service != nil:
+			if op.Service.Verb == api.ServiceCheckHealth {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Read is used to perform a read-only transaction that doesn't modify the state
 // store. This is much more scalable since it doesn't go through Raft and
 // supports staleness, so this should be preferred if you're just performing
@@ -286,7 +396,7 @@ func serviceVerbValidate(op api.ServiceOp) (bool, error) {
 	// enumcover:api.ServiceOp
 	switch op {
 	// Skip the pre-apply checks if this is a GET.
-	case api.ServiceGet:
+	case api.ServiceGet, api.ServiceCheckHealth:
 		return false, nil
 	case api.ServiceSet, api.ServiceCAS, api.ServiceDelete, api.ServiceDeleteCAS:
 		return true, nil
@@ -310,6 +420,24 @@ func checkVerbValidate(op api.CheckOp) (bool, error) {
 	}
 }
 
+// kvComparisonValidate checks for a known comparison in compare operations.
+func kvComparisonValidate(op *structs.TxnKVOp) error {
+	if op.Verb != api.KVCompareValue && op.Verb != api.KVComparePrefixCount {
+		if op.Comparison != "" {
+			return fmt.Errorf("comparison is only supported by the %s and %s operations", api.KVCompareValue, api.KVComparePrefixCount)
+		}
+		return nil
+	}
+
+	// enumcover:api.KVComparison
+	switch op.Comparison {
+	case "", api.KVCompareEqual, api.KVCompareNotEqual, api.KVCompareLess, api.KVCompareLessOrEqual, api.KVCompareGreater, api.KVCompareGreaterOrEqual:
+		return nil
+	default:
+		return fmt.Errorf("unknown comparison: %s", op.Comparison)
+	}
+}
+
 // intentionVerbValidate checks for a known operation type.
 func intentionVerbValidate(op structs.IntentionOp) error {
 	// enumcover:structs.IntentionOp
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 	"github.com/hashicorp/consul/types"
 )
@@ -620,6 +621,206 @@ func TestTxn_Apply_ACLDeny(t *testing.T) {
 	}
 }
 
+func TestTxn_Apply_If_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	state := s1.fsm.State()
+	require.NoError(t, state.KVSSet(1, &structs.DirEntry{Key: "foo", Value: []byte("1")}))
+
+	token := createTokenFull(t, codec, testTxnRules)
+
+	// Every nested operation is checked against the token.
+	arg := structs.TxnRequest{
+		Datacenter: "dc1",
+		Ops: structs.TxnOps{
+			&structs.TxnOp{
+				If: &structs.TxnIfOp{
+					Conditions: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVCompareValue,
+								DirEnt: structs.DirEntry{Key: "nope"},
+							},
+						},
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVComparePrefixCount,
+								DirEnt: structs.DirEntry{Key: ""},
+							},
+						},
+						&structs.TxnOp{
+							Service: &structs.TxnServiceOp{
+								Verb:    api.ServiceCheckHealth,
+								Node:    "foo-node",
+								Service: structs.NodeService{Service: "nope"},
+							},
+						},
+					},
+					Then: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVSet,
+								DirEnt: structs.DirEntry{Key: "foo"},
+							},
+						},
+					},
+					Else: structs.TxnOps{
+						&structs.TxnOp{
+							KV: &structs.TxnKVOp{
+								Verb:   api.KVSet,
+								DirEnt: structs.DirEntry{Key: "test"},
+							},
+						},
+					},
+				},
+			},
+		},
+		WriteRequest: structs.WriteRequest{Token: token.SecretID},
+	}
+	var out structs.TxnResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Txn.Apply", &arg, &out))
+
+	var whats []string
+	for _, err := range out.Errors {
+		require.Equal(t, 0, err.OpIndex)
+		whats = append(whats, err.What)
+	}
+	require.Len(t, whats, 4)
+	require.Contains(t, whats[0], "condition 0: Permission denied")
+	require.Contains(t, whats[1], "condition 1: Permission denied")
+	require.Contains(t, whats[2], "condition 2: Permission denied")
+	require.Contains(t, whats[3], "then op 0: Permission denied")
+
+	// With permitted operations the conditions select a branch.
+	arg.Ops[0].If.Conditions = structs.TxnOps{
+		&structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb:   api.KVCompareValue,
+				DirEnt: structs.DirEntry{Key: "foo", Value: []byte("2")},
+			},
+		},
+	}
+	arg.Ops[0].If.Then = nil
+	out = structs.TxnResponse{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Txn.Apply", &arg, &out))
+	require.Empty(t, out.Errors)
+	require.Len(t, out.Results, 2)
+	require.False(t, out.Results[0].If.Succeeded)
+	require.Equal(t, "test", out.Results[1].KV.Key)
+}
+
+func TestTxn_Apply_MinVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	// A follower on an older version can't apply the new operations.
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Bootstrap = false
+		c.Build = "1.21.0"
+	})
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	joinLAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	cases := map[string]*structs.TxnOp{
+		"if": {
+			If: &structs.TxnIfOp{
+				Then: structs.TxnOps{
+					&structs.TxnOp{
+						KV: &structs.TxnKVOp{
+							Verb:   api.KVSet,
+							DirEnt: structs.DirEntry{Key: "test"},
+						},
+					},
+				},
+			},
+		},
+		"compare value": {
+			KV: &structs.TxnKVOp{
+				Verb:   api.KVCompareValue,
+				DirEnt: structs.DirEntry{Key: "test"},
+			},
+		},
+		"compare prefix count": {
+			KV: &structs.TxnKVOp{
+				Verb:   api.KVComparePrefixCount,
+				DirEnt: structs.DirEntry{Key: "test"},
+			},
+		},
+		"comparison": {
+			KV: &structs.TxnKVOp{
+				Verb:       api.KVGet,
+				DirEnt:     structs.DirEntry{Key: "test"},
+				Comparison: api.KVCompareEqual,
+			},
+		},
+		"service health": {
+			Service: &structs.TxnServiceOp{
+				Verb:    api.ServiceCheckHealth,
+				Node:    "foo",
+				Service: structs.NodeService{Service: "test"},
+			},
+		},
+	}
+	for name, op := range cases {
+		t.Run(name, func(t *testing.T) {
+			arg := structs.TxnRequest{
+				Datacenter: "dc1",
+				Ops:        structs.TxnOps{op},
+			}
+			retry.Run(t, func(r *retry.R) {
+				var out structs.TxnResponse
+				err := msgpackrpc.CallWithCodec(codec, "Txn.Apply", &arg, &out)
+				require.ErrorContains(r, err, "all servers must be >= 1.22.0")
+			})
+		})
+	}
+
+	// Other operations are still accepted.
+	arg := structs.TxnRequest{
+		Datacenter: "dc1",
+		Ops: structs.TxnOps{
+			&structs.TxnOp{
+				KV: &structs.TxnKVOp{
+					Verb:   api.KVSet,
+					DirEnt: structs.DirEntry{Key: "test", Value: []byte("hello")},
+				},
+			},
+		},
+	}
+	var out structs.TxnResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Txn.Apply", &arg, &out))
+	require.Empty(t, out.Errors)
+}
+
 func TestTxn_Apply_LockDelay(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -1061,6 +1262,90 @@ func TestTxn_Validation(t *testing.T) {
 			},
 			expectedError: "unknown operation type",
 		},
+		{
+			request: structs.TxnReadRequest{
+				Datacenter: "dc1",
+				Ops: structs.TxnOps{
+					&structs.TxnOp{
+						KV: &structs.TxnKVOp{
+							Verb:       api.KVCompareValue,
+							DirEnt:     structs.DirEntry{Key: "nope"},
+							Comparison: "about",
+						},
+					},
+				},
+			},
+			expectedError: "unknown comparison: about",
+		},
+		{
+			request: structs.TxnReadRequest{
+				Datacenter: "dc1",
+				Ops: structs.TxnOps{
+					&structs.TxnOp{
+						KV: &structs.TxnKVOp{
+							Verb:       api.KVGet,
+							DirEnt:     structs.DirEntry{Key: "nope"},
+							Comparison: api.KVCompareLess,
+						},
+					},
+				},
+			},
+			expectedError: "comparison is only supported by the compare-value and compare-prefix-count operations",
+		},
+		{
+			request: structs.TxnReadRequest{
+				Datacenter: "dc1",
+				Ops: structs.TxnOps{
+					&structs.TxnOp{
+						Service: &structs.TxnServiceOp{
+							Verb:    api.ServiceCheckHealth,
+							Node:    "nope",
+							Service: structs.NodeService{ID: "nope"},
+						},
+					},
+				},
+			},
+			expectedError: "Must provide service name",
+		},
+		{
+			request: structs.TxnReadRequest{
+				Datacenter: "dc1",
+				Ops: structs.TxnOps{
+					&structs.TxnOp{
+						If: &structs.TxnIfOp{
+							Conditions: structs.TxnOps{
+								&structs.TxnOp{
+									KV: &structs.TxnKVOp{
+										Verb:   api.KVGet,
+										DirEnt: structs.DirEntry{Key: "nope"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "op 0: condition 0 is not a check operation",
+		},
+		{
+			request: structs.TxnReadRequest{
+				Datacenter: "dc1",
+				Ops: structs.TxnOps{
+					&structs.TxnOp{
+						If: &structs.TxnIfOp{
+							Else: structs.TxnOps{
+								&structs.TxnOp{
+									Node: &structs.TxnNodeOp{
+										Verb: "tick",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "op 0: else op 0: unknown node operation",
+		},
 	}
 
 	for _, tc := range testCases {
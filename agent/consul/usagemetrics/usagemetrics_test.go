@@ -965,8 +965,8 @@ func testUsageReporter_emitKVUsage_CE(t *testing.T, getMetricsReporter func(test
 		require.NoError(t, s.KVSSet(5, &structs.DirEntry{Key: "b", Value: []byte{1}}))
 		require.NoError(t, s.KVSSet(6, &structs.DirEntry{Key: "c", Value: []byte{1}}))
 		require.NoError(t, s.KVSSet(7, &structs.DirEntry{Key: "d", Value: []byte{1}}))
-		require.NoError(t, s.KVSDelete(8, "d", &acl.EnterpriseMeta{}))
-		require.NoError(t, s.KVSDelete(9, "c", &acl.EnterpriseMeta{}))
+		require.NoError(t, s.KVSDelete(8, "d", &acl.EnterpriseMeta{}, nil))
+		require.NoError(t, s.KVSDelete(9, "c", &acl.EnterpriseMeta{}, nil))
 		require.NoError(t, s.KVSSet(10, &structs.DirEntry{Key: "e", Value: []byte{1}}))
 		require.NoError(t, s.KVSSet(11, &structs.DirEntry{Key: "f", Value: []byte{1}}))
 	}
@@ -5,12 +5,14 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	agentdns "github.com/hashicorp/consul/agent/dns"
 	"math"
 	"net"
+	"net/http"
 	"regexp"
 	"strings"
 	"sync/atomic"
@@ -53,6 +55,13 @@ const (
 	// trimUDP call) consul would fail to respond and the consumer timesout
 	// the request.
 	maxUDPDatagramSize = math.MaxUint16 - 68
+
+	// dnsNetworkTLS is the network name used to serve DNS-over-TLS (RFC 7858).
+	// It matches the network name understood by dns.Server.
+	dnsNetworkTLS = "tcp-tls"
+
+	// dnsNetworkHTTPS is the network name used to serve DNS-over-HTTPS (RFC 8484).
+	dnsNetworkHTTPS = "https"
 )
 
 type dnsSOAConfig struct {
@@ -96,6 +105,16 @@ type dnsServerConfig struct {
 	TTLStrict          map[string]time.Duration
 	DisableCompression bool
 
+	AnswerOrdering   structs.DNSAnswerOrdering
+	AnswerSubsetSize int
+	// OrderingRadix and OrderingStrict hold the per-service orderings, the
+	// same way TTLRadix and TTLStrict hold the per-service TTLs.
+	OrderingRadix  *radix.Tree
+	OrderingStrict map[string]structs.DNSAnswerOrdering
+	// DNSSEC signs answers from the Consul domains. It is nil when DNSSEC is
+	// disabled.
+	DNSSEC *dnssecSigner
+
 	enterpriseDNSConfig
 }
 
@@ -124,9 +143,18 @@ type nodeLookup struct {
 // service discovery endpoints using a DNS interface.
 type DNSServer struct {
 	*dns.Server
-	agent     *Agent
-	mux       *dns.ServeMux
-	domain    string
+	// httpServer is only set when serving DNS-over-HTTPS, in which case
+	// Server is nil.
+	httpServer *http.Server
+	agent      *Agent
+	mux        *dns.ServeMux
+	domain     string
+
+	// rateLimiter is shared by all the DNS servers of the agent. Queries
+	// forwarded by dataplanes over gRPC bypass it since they all share the
+	// dataplane's address.
+	rateLimiter *dnsRateLimiter
+
 	altDomain string
 	logger    hclog.Logger
 
@@ -151,6 +179,7 @@ func NewDNSServer(a *Agent) (*DNSServer, error) {
 		logger:                a.logger.Named(logging.DNS),
 		defaultEnterpriseMeta: *a.AgentEnterpriseMeta(),
 		mux:                   dns.NewServeMux(),
+		rateLimiter:           a.dnsRateLimiter,
 	}
 	cfg, err := getDNSServerConfig(a.config)
 	if err != nil {
@@ -193,6 +222,8 @@ func getDNSServerConfig(conf *config.RuntimeConfig) (*dnsServerConfig, error) {
 		DisableCompression: conf.DNSDisableCompression,
 		UseCache:           conf.DNSUseCache,
 		CacheMaxAge:        conf.DNSCacheMaxAge,
+		AnswerOrdering:     conf.DNSAnswerOrdering,
+		AnswerSubsetSize:   conf.DNSAnswerSubsetSize,
 		SOAConfig: dnsSOAConfig{
 			Expire:  conf.DNSSOA.Expire,
 			Minttl:  conf.DNSSOA.Minttl,
@@ -215,6 +246,18 @@ func getDNSServerConfig(conf *config.RuntimeConfig) (*dnsServerConfig, error) {
 			}
 		}
 	}
+	if len(conf.DNSServiceOrdering) > 0 {
+		cfg.OrderingRadix = radix.New()
+		cfg.OrderingStrict = make(map[string]structs.DNSAnswerOrdering)
+
+		for key, ordering := range conf.DNSServiceOrdering {
+			if strings.HasSuffix(key, "*") {
+				cfg.OrderingRadix.Insert(key[:len(key)-1], ordering)
+			} else {
+				cfg.OrderingStrict[key] = ordering
+			}
+		}
+	}
 	for _, r := range conf.DNSRecursors {
 		ra, err := recursorAddr(r)
 		if err != nil {
@@ -222,6 +265,13 @@ func getDNSServerConfig(conf *config.RuntimeConfig) (*dnsServerConfig, error) {
 		}
 		cfg.Recursors = append(cfg.Recursors, ra)
 	}
+	if conf.DNSSEC.Enabled {
+		signer, err := newDNSSECSigner(conf.DNSSEC, conf.EncryptKey)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid DNSSEC configuration: %v", err)
+		}
+		cfg.DNSSEC = signer
+	}
 
 	return cfg, nil
 }
@@ -244,20 +294,94 @@ func (cfg *dnsServerConfig) GetTTLForService(service string) (time.Duration, boo
 	return 0, false
 }
 
+// GetAnswerOrderingForService returns the policy used to order the answers of
+// a service lookup.
+func (cfg *dnsServerConfig) GetAnswerOrderingForService(service string) structs.DNSAnswerOrdering {
+	if ordering, ok := cfg.OrderingStrict[service]; ok {
+		return ordering
+	}
+	if cfg.OrderingRadix != nil {
+		if _, ordering, ok := cfg.OrderingRadix.LongestPrefix(service); ok {
+			return ordering.(structs.DNSAnswerOrdering)
+		}
+	}
+	return cfg.AnswerOrdering
+}
+
+// ListenAndServe serves DNS on the given network and address. Besides the
+// plain "udp" and "tcp" networks it accepts dnsNetworkTLS and dnsNetworkHTTPS
+// to serve DNS-over-TLS and DNS-over-HTTPS respectively. The encrypted
+// listeners take their certificates from the agent's TLS configurator, so
+// certificate changes are picked up on reload without restarting them.
 func (d *DNSServer) ListenAndServe(network, addr string, notif func()) error {
+	if network == dnsNetworkHTTPS {
+		return d.listenAndServeHTTPS(addr, notif)
+	}
+
 	d.Server = &dns.Server{
 		Addr:              addr,
 		Net:               network,
-		Handler:           d.mux,
+		Handler:           d.handler(),
 		NotifyStartedFunc: notif,
 	}
-	if network == "udp" {
+	switch network {
+	case "udp":
 		d.UDPSize = 65535
+	case dnsNetworkTLS:
+		d.Server.TLSConfig = d.agent.tlsConfigurator.IncomingDNSConfig([]string{"dot"})
 	}
 	return d.Server.ListenAndServe()
 }
 
+// handler returns the handler serving queries from the network, which applies
+// the agent's rate limits in front of the mux.
+func (d *DNSServer) handler() dns.Handler {
+	if d.rateLimiter == nil {
+		return d.mux
+	}
+	return &rateLimitedHandler{limiter: d.rateLimiter, next: d.mux}
+}
+
+func (d *DNSServer) listenAndServeHTTPS(addr string, notif func()) error {
+	tlsConfig := d.agent.tlsConfigurator.IncomingDNSConfig([]string{"h2", "http/1.1"})
+
+	mux := http.NewServeMux()
+	mux.Handle(dohPath, &dohHandler{handler: d.handler(), logger: d.logger})
+	d.httpServer = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: dohReadHeaderTimeout,
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if notif != nil {
+		notif()
+	}
+	err = d.httpServer.Serve(tls.NewListener(ln, tlsConfig))
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
 func (d *DNSServer) Shutdown() {
+	if d.httpServer != nil {
+		d.logger.Info("Stopping server",
+			"protocol", "DNS",
+			"address", d.httpServer.Addr,
+			"network", dnsNetworkHTTPS,
+		)
+		ctx, cancel := context.WithTimeout(context.Background(), dohShutdownTimeout)
+		defer cancel()
+		err := d.httpServer.Shutdown(ctx)
+		if err != nil {
+			d.logger.Error("Error stopping DNS server", "error", err)
+		}
+	}
 	if d.Server != nil {
 		d.logger.Info("Stopping server",
 			"protocol", "DNS",
@@ -273,6 +397,9 @@ func (d *DNSServer) Shutdown() {
 
 // GetAddr is a function to return the server address if is not nil.
 func (d *DNSServer) GetAddr() string {
+	if d.httpServer != nil {
+		return d.httpServer.Addr
+	}
 	if d.Server != nil {
 		return d.Server.Addr
 	}
@@ -575,24 +702,78 @@ func (d *DNSServer) handleQuery(resp dns.ResponseWriter, req *dns.Msg) {
 	case dns.TypeAXFR:
 		m.SetRcode(req, dns.RcodeNotImplemented)
 
-	default:
-		err = d.dispatch(resp.RemoteAddr(), req, m, cfg, maxRecursionLevelDefault)
-		rCode := rCodeFromError(err)
-		if rCode == dns.RcodeNameError || errors.Is(err, errNoData) {
-			d.addSOAToMessage(cfg, m, q.Name)
+	case dns.TypeDNSKEY:
+		if cfg.DNSSEC != nil && strings.EqualFold(q.Name, d.getResponseDomain(q.Name)) {
+			m.Answer = append(m.Answer, cfg.DNSSEC.dnskey(q.Name))
+			m.SetRcode(req, dns.RcodeSuccess)
+			break
 		}
-		m.SetRcode(req, rCode)
+		err = d.dispatchQuery(cfg, resp.RemoteAddr(), req, m)
+
+	default:
+		err = d.dispatchQuery(cfg, resp.RemoteAddr(), req, m)
 	}
 
 	setEDNS(req, m, !errors.Is(err, errECSNotGlobal))
 
 	d.trimDNSResponse(cfg, network, req, m)
 
+	if cfg.DNSSEC != nil && dnssecOK(req) {
+		d.signResponse(cfg, network, req, m)
+	}
+
 	if err := resp.WriteMsg(m); err != nil {
 		d.logger.Warn("failed to respond", "error", err)
 	}
 }
 
+// dispatchQuery answers a query for a name in the Consul domain and sets the
+// response code, adding the SOA record to negative answers.
+func (d *DNSServer) dispatchQuery(cfg *dnsRequestConfig, remoteAddr net.Addr, req, resp *dns.Msg) error {
+	q := req.Question[0]
+	err := d.dispatch(remoteAddr, req, resp, cfg, maxRecursionLevelDefault)
+	rCode := rCodeFromError(err)
+	if rCode == dns.RcodeNameError || errors.Is(err, errNoData) {
+		d.addSOAToMessage(cfg, resp, q.Name)
+	}
+	resp.SetRcode(req, rCode)
+	return err
+}
+
+// signResponse adds DNSSEC records to a response for a client that set the
+// DO bit. Negative answers get a denial of existence before all RRsets
+// belonging to the Consul domain are signed. Signing happens after the
+// response was trimmed, so a UDP response that no longer fits is sent empty
+// with the truncated bit set to make the client retry over TCP.
+func (d *DNSServer) signResponse(cfg *dnsRequestConfig, network string, req, resp *dns.Msg) {
+	zone := d.getResponseDomain(req.Question[0].Name)
+
+	if opt := resp.IsEdns0(); opt != nil {
+		opt.SetDo()
+	}
+	if resp.Rcode == dns.RcodeNameError || (resp.Rcode == dns.RcodeSuccess && len(resp.Answer) == 0) {
+		cfg.DNSSEC.denyExistence(req, resp)
+	}
+	if err := cfg.DNSSEC.sign(zone, resp); err != nil {
+		d.logger.Error("failed to sign DNS response", "error", err)
+		resp.SetRcode(req, dns.RcodeServerFailure)
+		resp.Answer, resp.Ns = nil, nil
+		return
+	}
+
+	if network != "tcp" && resp.Len() > maxUDPResponseSize(req) {
+		resp.Truncated = true
+		resp.Answer, resp.Ns = nil, nil
+		extra := resp.Extra[:0]
+		for _, rr := range resp.Extra {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				extra = append(extra, rr)
+			}
+		}
+		resp.Extra = extra
+	}
+}
+
 // Craft dns records for an SOA
 func (d *DNSServer) makeSOARecord(cfg *dnsRequestConfig, questionName string) *dns.SOA {
 	domain := d.domain
@@ -858,7 +1039,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, cfg *dnsRe
 			// tag[.tag].name.service.consul
 		}
 
-		err = d.handleServiceQuery(cfg, lookup, req, resp)
+		err = d.handleServiceQuery(cfg, lookup, remoteAddr, req, resp)
 		// Return if we are error free right away, otherwise loop again if we can
 		if err == nil {
 			return nil
@@ -888,7 +1069,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, cfg *dnsRe
 			EnterpriseMeta:    locality.EnterpriseMeta,
 		}
 		// name.connect.consul
-		return d.handleServiceQuery(cfg, lookup, req, resp)
+		return d.handleServiceQuery(cfg, lookup, remoteAddr, req, resp)
 
 	case "virtual":
 		if len(queryParts) < 1 {
@@ -955,7 +1136,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, cfg *dnsRe
 			EnterpriseMeta:    locality.EnterpriseMeta,
 		}
 		// name.ingress.consul
-		return d.handleServiceQuery(cfg, lookup, req, resp)
+		return d.handleServiceQuery(cfg, lookup, remoteAddr, req, resp)
 
 	case "node":
 		if len(queryParts) < 1 {
@@ -1353,13 +1534,9 @@ func trimTCPResponse(req, resp *dns.Msg) (trimmed bool) {
 	return truncated
 }
 
-// trimUDPResponse makes sure a UDP response is not longer than allowed by RFC
-// 1035. Enforce an arbitrary limit that can be further ratcheted down by
-// config, and then make sure the response doesn't exceed 512 bytes. Any extra
-// records will be trimmed along with answers.
-func trimUDPResponse(req, resp *dns.Msg, udpAnswerLimit int) (trimmed bool) {
-	numAnswers := len(resp.Answer)
-	hasExtra := len(resp.Extra) > 0
+// maxUDPResponseSize returns the largest UDP response the client accepts,
+// taking the EDNS buffer size into account.
+func maxUDPResponseSize(req *dns.Msg) int {
 	maxSize := defaultMaxUDPSize
 
 	// Update to the maximum edns size
@@ -1373,6 +1550,17 @@ func trimUDPResponse(req, resp *dns.Msg, udpAnswerLimit int) (trimmed bool) {
 	if maxSize > maxUDPDatagramSize {
 		maxSize = maxUDPDatagramSize
 	}
+	return maxSize
+}
+
+// trimUDPResponse makes sure a UDP response is not longer than allowed by RFC
+// 1035. Enforce an arbitrary limit that can be further ratcheted down by
+// config, and then make sure the response doesn't exceed 512 bytes. Any extra
+// records will be trimmed along with answers.
+func trimUDPResponse(req, resp *dns.Msg, udpAnswerLimit int) (trimmed bool) {
+	numAnswers := len(resp.Answer)
+	hasExtra := len(resp.Extra) > 0
+	maxSize := maxUDPResponseSize(req)
 
 	// We avoid some function calls and allocations by only handling the
 	// extra data when necessary.
@@ -1479,6 +1667,13 @@ func (d *DNSServer) lookupServiceNodes(cfg *dnsRequestConfig, lookup serviceLook
 		},
 		EnterpriseMeta: lookup.EnterpriseMeta,
 	}
+	if d.answerOrdering(cfg, lookup) == structs.DNSAnswerOrderingNearest {
+		// Have the servers sort the nodes by distance from this agent. The
+		// agent cache does not key entries by query source, so it cannot
+		// be used for sorted results.
+		args.Source = d.agentQuerySource()
+		args.QueryOptions.UseCache = false
+	}
 
 	out, _, err := d.agent.rpcClientHealth.ServiceNodes(context.TODO(), args)
 	if err != nil {
@@ -1488,8 +1683,19 @@ func (d *DNSServer) lookupServiceNodes(cfg *dnsRequestConfig, lookup serviceLook
 	return out, nil
 }
 
+// answerOrdering returns the policy used to order the answers of lookup.
+func (d *DNSServer) answerOrdering(cfg *dnsRequestConfig, lookup serviceLookup) structs.DNSAnswerOrdering {
+	ordering := cfg.GetAnswerOrderingForService(lookup.Service)
+	if ordering == structs.DNSAnswerOrderingNearest &&
+		(lookup.PeerName != "" || lookup.SamenessGroup != "" || lookup.Datacenter != d.agent.config.Datacenter) {
+		// Coordinates cannot be compared across datacenters or peers.
+		return structs.DNSAnswerOrderingRandom
+	}
+	return ordering
+}
+
 // handleServiceQuery is used to handle a service query
-func (d *DNSServer) handleServiceQuery(cfg *dnsRequestConfig, lookup serviceLookup, req, resp *dns.Msg) error {
+func (d *DNSServer) handleServiceQuery(cfg *dnsRequestConfig, lookup serviceLookup, remoteAddr net.Addr, req, resp *dns.Msg) error {
 	out, err := d.lookupServiceNodes(cfg, lookup)
 	if err != nil {
 		return fmt.Errorf("rpc request failed: %w", err)
@@ -1500,8 +1706,8 @@ func (d *DNSServer) handleServiceQuery(cfg *dnsRequestConfig, lookup serviceLook
 		return errNameNotFound
 	}
 
-	// Perform a random shuffle
-	out.Nodes.Shuffle()
+	// Order the nodes according to the policy of the service
+	out.Nodes = orderServiceNodes(d.answerOrdering(cfg, lookup), out.Nodes, dnsSourceIP(remoteAddr, req), cfg.AnswerSubsetSize)
 
 	// Determine the TTL
 	ttl, _ := cfg.GetTTLForService(lookup.Service)
@@ -1537,6 +1743,34 @@ func ednsSubnetForRequest(req *dns.Msg) *dns.EDNS0_SUBNET {
 	return nil
 }
 
+// dnsSourceIP returns the address of the client a query originates from,
+// preferring the EDNS client subnet set by forwarding resolvers.
+func dnsSourceIP(remoteAddr net.Addr, req *dns.Msg) string {
+	if subnet := ednsSubnetForRequest(req); subnet != nil {
+		return subnet.Address.String()
+	}
+	switch v := remoteAddr.(type) {
+	case *net.UDPAddr:
+		return v.IP.String()
+	case *net.TCPAddr:
+		return v.IP.String()
+	case *net.IPAddr:
+		return v.IP.String()
+	}
+	return ""
+}
+
+// agentQuerySource returns the query source of the local agent, used by the
+// servers to sort results by distance from it.
+func (d *DNSServer) agentQuerySource() structs.QuerySource {
+	return structs.QuerySource{
+		Datacenter:    d.agent.config.Datacenter,
+		Segment:       d.agent.config.SegmentName,
+		Node:          d.agent.config.NodeName,
+		NodePartition: d.agent.config.PartitionOrEmpty(),
+	}
+}
+
 // handlePreparedQuery is used to handle a prepared query.
 func (d *DNSServer) handlePreparedQuery(cfg *dnsRequestConfig, datacenter, query string, remoteAddr net.Addr, req, resp *dns.Msg, maxRecursionLevel int) error {
 	// Execute the prepared query.
@@ -1553,28 +1787,9 @@ func (d *DNSServer) handlePreparedQuery(cfg *dnsRequestConfig, datacenter, query
 		// is no provision for passing additional query parameters, so we
 		// send the local agent's data through to allow distance sorting
 		// relative to ourself on the server side.
-		Agent: structs.QuerySource{
-			Datacenter:    d.agent.config.Datacenter,
-			Segment:       d.agent.config.SegmentName,
-			Node:          d.agent.config.NodeName,
-			NodePartition: d.agent.config.PartitionOrEmpty(),
-		},
-	}
-
-	subnet := ednsSubnetForRequest(req)
-
-	if subnet != nil {
-		args.Source.Ip = subnet.Address.String()
-	} else {
-		switch v := remoteAddr.(type) {
-		case *net.UDPAddr:
-			args.Source.Ip = v.IP.String()
-		case *net.TCPAddr:
-			args.Source.Ip = v.IP.String()
-		case *net.IPAddr:
-			args.Source.Ip = v.IP.String()
-		}
+		Agent: d.agentQuerySource(),
 	}
+	args.Source.Ip = dnsSourceIP(remoteAddr, req)
 
 	out, err := d.lookupPreparedQuery(cfg, args)
 	if err != nil {
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/hashicorp/consul/agent/config"
+)
+
+const (
+	// dnssecKeyTTL is the TTL of the DNSKEY record served at the zone apex.
+	dnssecKeyTTL = 3600
+
+	// dnssecInceptionSkew backdates signature inception to tolerate clock
+	// skew between the agent and validating resolvers.
+	dnssecInceptionSkew = time.Hour
+
+	// dnssecKeyFlags marks the key as a zone key that is also used as the
+	// secure entry point (a combined signing key).
+	dnssecKeyFlags = dns.ZONE | dns.SEP
+
+	// typeNXNAME is the pseudo type used in compact denial of existence
+	// (RFC 9824) to signal that the queried name does not exist.
+	typeNXNAME = 128
+
+	// dnssecKeyDerivationInfo is mixed into the HKDF used to derive the
+	// signing key from the gossip encryption key.
+	dnssecKeyDerivationInfo = "consul-dns-dnssec-signing-key"
+)
+
+// dnssecNoDataTypes is the set of types advertised in the NSEC bitmap of a
+// NODATA response, minus the queried type. Advertising every type Consul may
+// answer with keeps resolvers that aggressively cache NSEC records (RFC 8198)
+// from synthesizing negative answers for types that do exist.
+var dnssecNoDataTypes = []uint16{
+	dns.TypeA,
+	dns.TypeNS,
+	dns.TypeCNAME,
+	dns.TypeSOA,
+	dns.TypePTR,
+	dns.TypeTXT,
+	dns.TypeAAAA,
+	dns.TypeSRV,
+	dns.TypeRRSIG,
+	dns.TypeNSEC,
+}
+
+// dnssecSigner signs DNS responses for the Consul domains online, at the time
+// the answer is built.
+type dnssecSigner struct {
+	key      *dns.DNSKEY
+	signer   crypto.Signer
+	validity time.Duration
+}
+
+// newDNSSECSigner loads the signing key described by conf. If no key files are
+// configured the key is derived from encryptKey.
+func newDNSSECSigner(conf config.RuntimeDNSSECConfig, encryptKey string) (*dnssecSigner, error) {
+	s := &dnssecSigner{validity: conf.SignatureValidity}
+
+	var err error
+	if conf.PublicKeyFile != "" {
+		s.key, s.signer, err = readDNSSECKey(conf.PublicKeyFile, conf.PrivateKeyFile)
+	} else {
+		s.key, s.signer, err = deriveDNSSECKey(encryptKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// readDNSSECKey reads a key pair in the format written by dnssec-keygen.
+func readDNSSECKey(publicKeyFile, privateKeyFile string) (*dns.DNSKEY, crypto.Signer, error) {
+	pubFile, err := os.Open(publicKeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open DNSSEC public key: %w", err)
+	}
+	defer pubFile.Close()
+
+	rr, err := dns.ReadRR(pubFile, publicKeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse DNSSEC public key: %w", err)
+	}
+	key, ok := rr.(*dns.DNSKEY)
+	if !ok {
+		return nil, nil, fmt.Errorf("DNSSEC public key file %q does not contain a DNSKEY record", publicKeyFile)
+	}
+
+	privFile, err := os.Open(privateKeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open DNSSEC private key: %w", err)
+	}
+	defer privFile.Close()
+
+	priv, err := key.ReadPrivateKey(privFile, privateKeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse DNSSEC private key: %w", err)
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("DNSSEC private key of type %T cannot be used for signing", priv)
+	}
+	return key, signer, nil
+}
+
+// deriveDNSSECKey deterministically derives an ECDSA P-256 signing key from
+// the gossip encryption key, so that every agent sharing the key serves the
+// same DNSKEY without distributing key files.
+func deriveDNSSECKey(encryptKey string) (*dns.DNSKEY, crypto.Signer, error) {
+	secret, err := base64.StdEncoding.DecodeString(encryptKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode gossip encryption key: %w", err)
+	}
+
+	// A random 32 byte string is a valid P-256 scalar with overwhelming
+	// probability, but keep reading until we find one just in case.
+	r := hkdf.New(sha256.New, secret, nil, []byte(dnssecKeyDerivationInfo))
+	var priv *ecdh.PrivateKey
+	for priv == nil {
+		seed := make([]byte, 32)
+		if _, err := io.ReadFull(r, seed); err != nil {
+			return nil, nil, fmt.Errorf("failed to derive DNSSEC key: %w", err)
+		}
+		priv, _ = ecdh.P256().NewPrivateKey(seed)
+	}
+
+	// The uncompressed point encoding is 0x04 || X || Y.
+	point := priv.PublicKey().Bytes()
+	signer := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(priv.Bytes()),
+	}
+	key := &dns.DNSKEY{
+		Flags:     dnssecKeyFlags,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+		PublicKey: base64.StdEncoding.EncodeToString(point[1:]),
+	}
+	return key, signer, nil
+}
+
+// dnskey returns the DNSKEY record to serve at the apex of zone.
+func (s *dnssecSigner) dnskey(zone string) *dns.DNSKEY {
+	key := *s.key
+	key.Hdr = dns.RR_Header{
+		Name:   zone,
+		Rrtype: dns.TypeDNSKEY,
+		Class:  dns.ClassINET,
+		Ttl:    dnssecKeyTTL,
+	}
+	return &key
+}
+
+// denyExistence adds a compact denial of existence (RFC 9824) for a negative
+// answer to the authority section of msg. Nonexistent names are answered
+// with NOERROR and an NSEC record carrying the NXNAME type, so that a single
+// signed record proves the negative response.
+func (s *dnssecSigner) denyExistence(req, msg *dns.Msg) {
+	q := req.Question[0]
+
+	var types []uint16
+	if msg.Rcode == dns.RcodeNameError {
+		msg.Rcode = dns.RcodeSuccess
+		types = []uint16{dns.TypeRRSIG, dns.TypeNSEC, typeNXNAME}
+	} else {
+		for _, t := range dnssecNoDataTypes {
+			if t != q.Qtype {
+				types = append(types, t)
+			}
+		}
+	}
+
+	// Negative answers are cached for the SOA minimum TTL (RFC 2308).
+	var ttl uint32
+	for _, rr := range msg.Ns {
+		if soa, ok := rr.(*dns.SOA); ok {
+			ttl = soa.Minttl
+		}
+	}
+
+	msg.Ns = append(msg.Ns, &dns.NSEC{
+		Hdr: dns.RR_Header{
+			Name:   q.Name,
+			Rrtype: dns.TypeNSEC,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		},
+		NextDomain: "\\000." + q.Name,
+		TypeBitMap: types,
+	})
+}
+
+// sign appends an RRSIG for every RRset in msg that belongs to zone.
+func (s *dnssecSigner) sign(zone string, msg *dns.Msg) error {
+	var err error
+	if msg.Answer, err = s.signSection(zone, msg.Answer); err != nil {
+		return err
+	}
+	if msg.Ns, err = s.signSection(zone, msg.Ns); err != nil {
+		return err
+	}
+	if msg.Extra, err = s.signSection(zone, msg.Extra); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *dnssecSigner) signSection(zone string, rrs []dns.RR) ([]dns.RR, error) {
+	type rrsetKey struct {
+		name   string
+		rrtype uint16
+		class  uint16
+	}
+
+	var order []rrsetKey
+	rrsets := make(map[rrsetKey][]dns.RR)
+	for _, rr := range rrs {
+		hdr := rr.Header()
+		switch {
+		case hdr.Rrtype == dns.TypeOPT || hdr.Rrtype == dns.TypeRRSIG:
+			continue
+		case !dns.IsSubDomain(zone, hdr.Name):
+			// Records outside of the zone, such as recursively resolved
+			// CNAME targets, are signed by their own zone.
+			continue
+		}
+		k := rrsetKey{name: strings.ToLower(hdr.Name), rrtype: hdr.Rrtype, class: hdr.Class}
+		if _, ok := rrsets[k]; !ok {
+			order = append(order, k)
+		}
+		rrsets[k] = append(rrsets[k], rr)
+	}
+
+	now := time.Now()
+	for _, k := range order {
+		rrset := rrsets[k]
+		sig := &dns.RRSIG{
+			Hdr: dns.RR_Header{
+				Name:   rrset[0].Header().Name,
+				Rrtype: dns.TypeRRSIG,
+				Class:  k.class,
+				Ttl:    rrset[0].Header().Ttl,
+			},
+			Algorithm:  s.key.Algorithm,
+			Inception:  uint32(now.Add(-dnssecInceptionSkew).Unix()),
+			Expiration: uint32(now.Add(s.validity).Unix()),
+			KeyTag:     s.key.KeyTag(),
+			SignerName: zone,
+		}
+		if err := sig.Sign(s.signer, rrset); err != nil {
+			return nil, fmt.Errorf("failed to sign %s %s: %w", k.name, dns.Type(k.rrtype), err)
+		}
+		rrs = append(rrs, sig)
+	}
+	return rrs, nil
+}
+
+// dnssecOK reports whether the client asked for DNSSEC records by setting the
+// DO bit.
+func dnssecOK(req *dns.Msg) bool {
+	edns := req.IsEdns0()
+	return edns != nil && edns.Do()
+}
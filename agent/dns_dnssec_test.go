@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_DNSSEC_DeriveKey(t *testing.T) {
+	key1, _, err := deriveDNSSECKey("yJqVBxPbKTVN/Y9rtCqOgqFjbBn2Qp3wLz5Dk9Bx2UI=")
+	require.NoError(t, err)
+	key2, _, err := deriveDNSSECKey("yJqVBxPbKTVN/Y9rtCqOgqFjbBn2Qp3wLz5Dk9Bx2UI=")
+	require.NoError(t, err)
+	other, _, err := deriveDNSSECKey("W9Sz3mW7i2Y7wI1dC+0uXJQeR0i2dKjJrdFwDsqvGuA=")
+	require.NoError(t, err)
+
+	require.Equal(t, key1.PublicKey, key2.PublicKey)
+	require.NotEqual(t, key1.PublicKey, other.PublicKey)
+	require.Equal(t, uint8(dns.ECDSAP256SHA256), key1.Algorithm)
+
+	_, _, err = deriveDNSSECKey("not base64")
+	require.Error(t, err)
+}
+
+func TestDNS_DNSSEC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	a := NewTestAgent(t, `
+		encrypt = "yJqVBxPbKTVN/Y9rtCqOgqFjbBn2Qp3wLz5Dk9Bx2UI="
+		dns_config {
+			dnssec {
+				enabled = true
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	query := func(t *testing.T, name string, qtype uint16, do bool) *dns.Msg {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		m.SetEdns0(4096, do)
+		c := &dns.Client{Net: "tcp"}
+		in, _, err := c.Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+		return in
+	}
+
+	requireVerified := func(t *testing.T, key *dns.DNSKEY, rrs []dns.RR) {
+		t.Helper()
+		var sigs []*dns.RRSIG
+		rrsets := map[uint16][]dns.RR{}
+		for _, rr := range rrs {
+			if sig, ok := rr.(*dns.RRSIG); ok {
+				sigs = append(sigs, sig)
+				continue
+			}
+			rrsets[rr.Header().Rrtype] = append(rrsets[rr.Header().Rrtype], rr)
+		}
+		require.Len(t, sigs, len(rrsets))
+		for _, sig := range sigs {
+			require.Equal(t, "consul.", sig.SignerName)
+			require.True(t, sig.ValidityPeriod(time.Now()))
+			require.NoError(t, sig.Verify(key, rrsets[sig.TypeCovered]))
+		}
+	}
+
+	in := query(t, "consul.", dns.TypeDNSKEY, true)
+	require.Len(t, in.Answer, 2)
+	key, ok := in.Answer[0].(*dns.DNSKEY)
+	require.True(t, ok, "first answer is not a DNSKEY record")
+	requireVerified(t, key, in.Answer)
+	require.True(t, in.IsEdns0().Do())
+
+	t.Run("signed answer", func(t *testing.T) {
+		in := query(t, a.Config.NodeName+".node.consul.", dns.TypeA, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Len(t, in.Answer, 2)
+		requireVerified(t, key, in.Answer)
+	})
+
+	t.Run("unsigned without DO bit", func(t *testing.T) {
+		in := query(t, a.Config.NodeName+".node.consul.", dns.TypeA, false)
+		require.Len(t, in.Answer, 1)
+		require.IsType(t, &dns.A{}, in.Answer[0])
+	})
+
+	t.Run("nonexistent name", func(t *testing.T) {
+		in := query(t, "nope.node.consul.", dns.TypeA, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Empty(t, in.Answer)
+		requireVerified(t, key, in.Ns)
+
+		var nsec *dns.NSEC
+		for _, rr := range in.Ns {
+			if n, ok := rr.(*dns.NSEC); ok {
+				nsec = n
+			}
+		}
+		require.NotNil(t, nsec)
+		require.Equal(t, "nope.node.consul.", nsec.Hdr.Name)
+		require.Contains(t, nsec.TypeBitMap, uint16(typeNXNAME))
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		in := query(t, a.Config.NodeName+".node.consul.", dns.TypeSRV, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Empty(t, in.Answer)
+		requireVerified(t, key, in.Ns)
+
+		var nsec *dns.NSEC
+		for _, rr := range in.Ns {
+			if n, ok := rr.(*dns.NSEC); ok {
+				nsec = n
+			}
+		}
+		require.NotNil(t, nsec)
+		require.NotContains(t, nsec.TypeBitMap, dns.TypeSRV)
+		require.NotContains(t, nsec.TypeBitMap, uint16(typeNXNAME))
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/miekg/dns"
+
+	agentdns "github.com/hashicorp/consul/agent/dns"
+)
+
+const (
+	// dohPath is the well-known path DNS-over-HTTPS clients send queries to.
+	dohPath = "/dns-query"
+
+	// dohMediaType is the media type of DNS wire format messages as defined
+	// by RFC 8484.
+	dohMediaType = "application/dns-message"
+
+	// dohMaxMessageSize is the largest DNS message accepted in a request body.
+	dohMaxMessageSize = dns.MaxMsgSize
+
+	dohReadHeaderTimeout = 10 * time.Second
+	dohShutdownTimeout   = 5 * time.Second
+)
+
+// dohHandler serves DNS-over-HTTPS (RFC 8484) requests by unpacking the wire
+// format message from the request and handing it to the same handler the UDP
+// and TCP servers use.
+type dohHandler struct {
+	handler dns.Handler
+	logger  hclog.Logger
+}
+
+func (h *dohHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var raw []byte
+	switch r.Method {
+	case http.MethodGet:
+		param := r.URL.Query().Get("dns")
+		if param == "" {
+			http.Error(w, "missing dns query parameter", http.StatusBadRequest)
+			return
+		}
+		b, err := base64.RawURLEncoding.DecodeString(param)
+		if err != nil {
+			http.Error(w, "invalid dns query parameter", http.StatusBadRequest)
+			return
+		}
+		raw = b
+
+	case http.MethodPost:
+		if r.Header.Get("Content-Type") != dohMediaType {
+			http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+			return
+		}
+		b, err := io.ReadAll(io.LimitReader(r.Body, dohMaxMessageSize+1))
+		if err != nil {
+			http.Error(w, "failed reading request body", http.StatusBadRequest)
+			return
+		}
+		if len(b) > dohMaxMessageSize {
+			http.Error(w, "dns message too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		raw = b
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := new(dns.Msg)
+	if err := req.Unpack(raw); err != nil {
+		http.Error(w, "malformed dns message", http.StatusBadRequest)
+		return
+	}
+
+	// The remote address is reported as TCP so that responses are trimmed
+	// like any other stream-based DNS transport.
+	respWriter := &agentdns.BufferResponseWriter{
+		LocalAddress:  dohAddr(r.Context().Value(http.LocalAddrContextKey)),
+		RemoteAddress: dohAddr(r.RemoteAddr),
+		Logger:        h.logger,
+	}
+	h.handler.ServeDNS(respWriter, req)
+
+	buf := respWriter.ResponseBuffer()
+	if buf == nil {
+		http.Error(w, "failed to serve dns request", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", dohMediaType)
+	resp := new(dns.Msg)
+	if err := resp.Unpack(buf); err == nil {
+		if ttl, ok := dohMinTTL(resp); ok {
+			w.Header().Set("Cache-Control", "max-age="+strconv.FormatUint(uint64(ttl), 10))
+		}
+	}
+	if _, err := w.Write(buf); err != nil {
+		h.logger.Warn("failed to respond", "error", err)
+	}
+}
+
+// dohAddr converts the address of an HTTP connection into a *net.TCPAddr.
+// It accepts either a net.Addr or a "host:port" string and returns an empty
+// address if the value can't be parsed.
+func dohAddr(v interface{}) net.Addr {
+	switch addr := v.(type) {
+	case *net.TCPAddr:
+		return addr
+	case net.Addr:
+		return dohAddr(addr.String())
+	case string:
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			break
+		}
+		p, _ := strconv.Atoi(port)
+		return &net.TCPAddr{IP: net.ParseIP(host), Port: p}
+	}
+	return &net.TCPAddr{}
+}
+
+// dohMinTTL returns the smallest TTL found in the answer section, which RFC
+// 8484 recommends using as the freshness lifetime of the HTTP response.
+func dohMinTTL(msg *dns.Msg) (uint32, bool) {
+	if len(msg.Answer) == 0 {
+		return 0, false
+	}
+	ttl := msg.Answer[0].Header().Ttl
+	for _, rr := range msg.Answer[1:] {
+		if rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+	}
+	return ttl, true
+}
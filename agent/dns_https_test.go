@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_EncryptedTransports(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	ports := freeport.GetN(t, 2)
+	a := NewTestAgent(t, fmt.Sprintf(`
+		ports {
+			dns_tls = %d
+			dns_https = %d
+		}
+		tls {
+			defaults {
+				ca_file = "../test/client_certs/rootca.crt"
+				cert_file = "../test/client_certs/server.crt"
+				key_file = "../test/client_certs/server.key"
+			}
+		}
+	`, ports[0], ports[1]))
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	addrs := map[string]string{}
+	for _, s := range a.dnsServers {
+		srv := s.(*DNSServer)
+		switch {
+		case srv.httpServer != nil:
+			addrs[dnsNetworkHTTPS] = srv.GetAddr()
+		case srv.Server != nil && srv.Server.Net == dnsNetworkTLS:
+			addrs[dnsNetworkTLS] = srv.GetAddr()
+		}
+	}
+	require.Contains(t, addrs, dnsNetworkTLS)
+	require.Contains(t, addrs, dnsNetworkHTTPS)
+
+	question := new(dns.Msg)
+	question.SetQuestion(a.Config.NodeName+".node.consul.", dns.TypeA)
+
+	requireNodeAnswer := func(t *testing.T, in *dns.Msg) {
+		t.Helper()
+		require.Len(t, in.Answer, 1)
+		aRec, ok := in.Answer[0].(*dns.A)
+		require.True(t, ok, "answer is not an A record")
+		require.Equal(t, "127.0.0.1", aRec.A.String())
+	}
+
+	t.Run("tls", func(t *testing.T) {
+		c := &dns.Client{
+			Net:       dnsNetworkTLS,
+			TLSConfig: &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"dot"}},
+		}
+		in, _, err := c.Exchange(question, addrs[dnsNetworkTLS])
+		require.NoError(t, err)
+		requireNodeAnswer(t, in)
+	})
+
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+	url := "https://" + addrs[dnsNetworkHTTPS] + dohPath
+	raw, err := question.Pack()
+	require.NoError(t, err)
+
+	readAnswer := func(t *testing.T, resp *http.Response) *dns.Msg {
+		t.Helper()
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, dohMediaType, resp.Header.Get("Content-Type"))
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		in := new(dns.Msg)
+		require.NoError(t, in.Unpack(body))
+		return in
+	}
+
+	t.Run("https get", func(t *testing.T) {
+		resp, err := client.Get(url + "?dns=" + base64.RawURLEncoding.EncodeToString(raw))
+		require.NoError(t, err)
+		requireNodeAnswer(t, readAnswer(t, resp))
+	})
+
+	t.Run("https post", func(t *testing.T) {
+		resp, err := client.Post(url, dohMediaType, bytes.NewReader(raw))
+		require.NoError(t, err)
+		requireNodeAnswer(t, readAnswer(t, resp))
+	})
+
+	t.Run("https rejects other content types", func(t *testing.T) {
+		resp, err := client.Post(url, "application/json", bytes.NewReader(raw))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("https rejects malformed messages", func(t *testing.T) {
+		resp, err := client.Get(url + "?dns=AAAA")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// orderServiceNodes orders the nodes of a service lookup according to
+// ordering. clientIP is the address of the client used by the consistent hash
+// ordering, which also limits the answer to subsetSize nodes.
+//
+// The nearest ordering relies on the servers having sorted the nodes by
+// distance from the agent, so it leaves them untouched.
+func orderServiceNodes(ordering structs.DNSAnswerOrdering, nodes structs.CheckServiceNodes, clientIP string, subsetSize int) structs.CheckServiceNodes {
+	switch ordering {
+	case structs.DNSAnswerOrderingNearest:
+		return nodes
+	case structs.DNSAnswerOrderingWeighted:
+		weightedShuffle(nodes)
+		return nodes
+	case structs.DNSAnswerOrderingConsistentHash:
+		return consistentHashSubset(nodes, clientIP, subsetSize)
+	default:
+		nodes.Shuffle()
+		return nodes
+	}
+}
+
+// weightedShuffle shuffles nodes so that the probability of a node coming
+// first is proportional to its weight for its current health status. It uses
+// the exponential keys of Efraimidis and Spirakis: sorting by -ln(u)/weight
+// is equivalent to repeatedly drawing nodes without replacement. Nodes with a
+// weight of 0 are moved to the end.
+func weightedShuffle(nodes structs.CheckServiceNodes) {
+	type keyedNode struct {
+		node structs.CheckServiceNode
+		key  float64
+	}
+	keyed := make([]keyedNode, len(nodes))
+	for i, node := range nodes {
+		keyed[i] = keyedNode{node: node, key: math.Inf(1)}
+		if weight := findWeight(node); weight > 0 {
+			keyed[i].key = -math.Log(1-rand.Float64()) / float64(weight)
+		}
+	}
+	sort.SliceStable(keyed, func(i, j int) bool {
+		return keyed[i].key < keyed[j].key
+	})
+	for i := range keyed {
+		nodes[i] = keyed[i].node
+	}
+}
+
+// consistentHashSubset returns the subsetSize nodes with the highest
+// rendezvous hash for clientIP, in random order. A client keeps getting the
+// same subset as long as these nodes are healthy, and only the clients of a
+// node that goes away are moved to other nodes.
+func consistentHashSubset(nodes structs.CheckServiceNodes, clientIP string, subsetSize int) structs.CheckServiceNodes {
+	type scoredNode struct {
+		node  structs.CheckServiceNode
+		score uint64
+	}
+	scored := make([]scoredNode, len(nodes))
+	for i, node := range nodes {
+		scored[i] = scoredNode{node: node, score: rendezvousScore(clientIP, node)}
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+	if subsetSize > 0 && len(scored) > subsetSize {
+		scored = scored[:subsetSize]
+	}
+
+	subset := make(structs.CheckServiceNodes, len(scored))
+	for i := range scored {
+		subset[i] = scored[i].node
+	}
+	subset.Shuffle()
+	return subset
+}
+
+// rendezvousScore returns the weight of node for clientIP in highest random
+// weight hashing.
+func rendezvousScore(clientIP string, node structs.CheckServiceNode) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(clientIP))
+	h.Write([]byte{0})
+	h.Write([]byte(node.Service.PeerName))
+	h.Write([]byte{0})
+	h.Write([]byte(node.Node.Node))
+	h.Write([]byte{0})
+	h.Write([]byte(node.Service.ID))
+
+	// FNV alone mixes the trailing bytes poorly, which would skew the
+	// ranking towards some nodes. Finish with the MurmurHash3 finalizer.
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
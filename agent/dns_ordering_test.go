@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func testOrderingNodes(n int) structs.CheckServiceNodes {
+	nodes := make(structs.CheckServiceNodes, n)
+	for i := range nodes {
+		nodes[i] = structs.CheckServiceNode{
+			Node: &structs.Node{Node: fmt.Sprintf("node%d", i)},
+			Service: &structs.NodeService{
+				ID:      "web",
+				Service: "web",
+				Weights: &structs.Weights{Passing: 1, Warning: 1},
+			},
+		}
+	}
+	return nodes
+}
+
+func TestDNS_OrderServiceNodes_Weighted(t *testing.T) {
+	first := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		nodes := testOrderingNodes(3)
+		nodes[0].Service.Weights.Passing = 8
+		nodes[2].Service.Weights.Passing = 0
+
+		nodes = orderServiceNodes(structs.DNSAnswerOrderingWeighted, nodes, "", 0)
+		require.Len(t, nodes, 3)
+		require.Equal(t, "node2", nodes[2].Node.Node, "node with a weight of 0 must come last")
+		first[nodes[0].Node.Node]++
+	}
+
+	// node0 comes first with a probability of 8/9.
+	require.Greater(t, first["node0"], 800)
+	require.Greater(t, first["node1"], 50)
+}
+
+func TestDNS_OrderServiceNodes_ConsistentHash(t *testing.T) {
+	subsetOf := func(nodes structs.CheckServiceNodes) []string {
+		var names []string
+		for _, n := range nodes {
+			names = append(names, n.Node.Node)
+		}
+		return names
+	}
+
+	subset := subsetOf(orderServiceNodes(structs.DNSAnswerOrderingConsistentHash, testOrderingNodes(10), "10.0.0.1", 3))
+	require.Len(t, subset, 3)
+
+	t.Run("stable for a client", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			nodes := testOrderingNodes(10)
+			nodes.Shuffle()
+			got := subsetOf(orderServiceNodes(structs.DNSAnswerOrderingConsistentHash, nodes, "10.0.0.1", 3))
+			require.ElementsMatch(t, subset, got)
+		}
+	})
+
+	t.Run("unaffected by removing other nodes", func(t *testing.T) {
+		var nodes structs.CheckServiceNodes
+		for _, n := range testOrderingNodes(10) {
+			if n.Node.Node == subset[0] || n.Node.Node == subset[1] || n.Node.Node == subset[2] || len(nodes) < 5 {
+				nodes = append(nodes, n)
+			}
+		}
+		got := subsetOf(orderServiceNodes(structs.DNSAnswerOrderingConsistentHash, nodes, "10.0.0.1", 3))
+		require.ElementsMatch(t, subset, got)
+	})
+
+	t.Run("spread across clients", func(t *testing.T) {
+		seen := map[string]struct{}{}
+		for i := 0; i < 100; i++ {
+			client := fmt.Sprintf("10.0.%d.%d", i/250, i%250)
+			for _, name := range subsetOf(orderServiceNodes(structs.DNSAnswerOrderingConsistentHash, testOrderingNodes(10), client, 3)) {
+				seen[name] = struct{}{}
+			}
+		}
+		require.Len(t, seen, 10)
+	})
+}
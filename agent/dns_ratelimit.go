@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/miekg/dns"
+	"golang.org/x/time/rate"
+
+	"github.com/hashicorp/consul/agent/config"
+)
+
+const (
+	// dnsRateLimitIdleTimeout is how long the limiter of an idle client is
+	// kept around.
+	dnsRateLimitIdleTimeout = 30 * time.Second
+
+	// dnsRateLimitCleanupInterval is how often idle client limiters are
+	// removed.
+	dnsRateLimitCleanupInterval = 10 * time.Second
+
+	// dnsRateLimitMaxLimiters is the number of token buckets kept, so that a
+	// flood of queries from spoofed addresses cannot grow them without bound.
+	// The least recently used bucket is removed to make room for a new one.
+	dnsRateLimitMaxLimiters = 65536
+
+	dnsQueryRateLimitPrefix    = "dns-query"
+	dnsResponseRateLimitPrefix = "dns-response"
+)
+
+var DNSRateLimitCounters = []prometheus.CounterDefinition{
+	{
+		Name: []string{"dns", "rate_limit", "dropped"},
+		Help: "Increments when a DNS query or response is dropped by rate limiting.",
+	},
+	{
+		Name: []string{"dns", "rate_limit", "slipped"},
+		Help: "Increments when a rate limited DNS response is sent truncated instead of being dropped.",
+	},
+}
+
+// rrlAction is the decision taken by response rate limiting for a response.
+type rrlAction int
+
+const (
+	rrlSend rrlAction = iota
+	rrlDrop
+	rrlSlip
+)
+
+// dnsClientLimiter is the token bucket of a single client, or of a single
+// client network and answer for response rate limiting.
+type dnsClientLimiter struct {
+	limiter    *rate.Limiter
+	lastAccess time.Time
+}
+
+// dnsRateLimiter enforces per-client query rate limits and response rate
+// limiting (RRL) for all the DNS servers of an agent.
+//
+// Queries are limited per source address: excess UDP queries are dropped
+// silently while TCP and DNS-over-HTTPS clients are answered with REFUSED.
+// Responses are limited per client network, name, type and response code, so
+// that the agent cannot be used to reflect identical answers at a spoofed
+// victim. Only UDP responses are subject to RRL since the source address of a
+// TCP client has been verified by the handshake.
+type dnsRateLimiter struct {
+	config atomic.Pointer[config.RuntimeDNSRateLimitConfig]
+
+	// limiters holds the token buckets by key, from the least to the most
+	// recently used. A bucket is created on the first query or response it
+	// applies to so that a new client is limited right away.
+	limitersLock sync.Mutex
+	limiters     *simplelru.LRU
+
+	// suppressed counts responses suppressed by RRL to decide which ones
+	// slip through truncated.
+	suppressed atomic.Uint64
+}
+
+func newDNSRateLimiter(conf config.RuntimeDNSRateLimitConfig) *dnsRateLimiter {
+	// The size is a positive constant, which is the only error case.
+	limiters, _ := simplelru.NewLRU(dnsRateLimitMaxLimiters, nil)
+	l := &dnsRateLimiter{limiters: limiters}
+	l.UpdateConfig(conf)
+	return l
+}
+
+// Run starts the background removal of idle client limiters until ctx is
+// done.
+func (l *dnsRateLimiter) Run(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(dnsRateLimitCleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				l.removeIdle(now.Add(-dnsRateLimitIdleTimeout))
+			}
+		}
+	}()
+}
+
+// removeIdle removes the limiters that have not been used since before.
+func (l *dnsRateLimiter) removeIdle(before time.Time) {
+	l.limitersLock.Lock()
+	defer l.limitersLock.Unlock()
+	for {
+		_, v, ok := l.limiters.GetOldest()
+		if !ok || !v.(*dnsClientLimiter).lastAccess.Before(before) {
+			return
+		}
+		l.limiters.RemoveOldest()
+	}
+}
+
+// UpdateConfig applies new rates. Existing client limiters pick up the new
+// rates on their next use.
+func (l *dnsRateLimiter) UpdateConfig(conf config.RuntimeDNSRateLimitConfig) {
+	l.config.Store(&conf)
+}
+
+// allow takes a token from the bucket of key, creating the bucket if this is
+// its first use.
+func (l *dnsRateLimiter) allow(key string, limit rate.Limit, burst int) bool {
+	now := time.Now()
+
+	l.limitersLock.Lock()
+	defer l.limitersLock.Unlock()
+
+	var c *dnsClientLimiter
+	if v, ok := l.limiters.Get(key); ok {
+		c = v.(*dnsClientLimiter)
+		if c.limiter.Limit() != limit {
+			c.limiter.SetLimitAt(now, limit)
+		}
+		if c.limiter.Burst() != burst {
+			c.limiter.SetBurstAt(now, burst)
+		}
+	} else {
+		c = &dnsClientLimiter{limiter: rate.NewLimiter(limit, burst)}
+		l.limiters.Add(key, c)
+	}
+	c.lastAccess = now
+	return c.limiter.AllowN(now, 1)
+}
+
+// allowQuery reports whether a query from addr is within the query rate.
+func (l *dnsRateLimiter) allowQuery(addr net.Addr) bool {
+	conf := l.config.Load()
+	if conf.QueryRate <= 0 {
+		return true
+	}
+	ip := dnsClientIP(addr)
+	if ip == nil {
+		return true
+	}
+	return l.allow(dnsRateLimitKey(dnsQueryRateLimitPrefix, ip), rate.Limit(conf.QueryRate), conf.QueryBurst)
+}
+
+// checkResponse decides whether msg may be sent to addr.
+func (l *dnsRateLimiter) checkResponse(addr net.Addr, msg *dns.Msg) rrlAction {
+	conf := l.config.Load()
+	if conf.ResponseRate <= 0 {
+		return rrlSend
+	}
+	udpAddr, ok := addr.(*net.UDPAddr)
+	if !ok {
+		return rrlSend
+	}
+
+	var netblock net.IP
+	if ip4 := udpAddr.IP.To4(); ip4 != nil {
+		netblock = ip4.Mask(net.CIDRMask(conf.IPv4PrefixLength, 32))
+	} else {
+		netblock = udpAddr.IP.Mask(net.CIDRMask(conf.IPv6PrefixLength, 128))
+	}
+
+	var name string
+	var qtype uint16
+	if len(msg.Question) > 0 {
+		name = strings.ToLower(msg.Question[0].Name)
+		qtype = msg.Question[0].Qtype
+	}
+	if zone, ok := rrlNXDomainZone(msg); ok {
+		// Answers for random nonexistent names would each get their own
+		// bucket, so they share the bucket of their zone instead.
+		name = zone
+	}
+
+	key := dnsRateLimitKey(dnsResponseRateLimitPrefix, netblock, []byte(name), []byte{byte(qtype >> 8), byte(qtype), byte(msg.Rcode)})
+	if l.allow(key, rate.Limit(conf.ResponseRate), conf.ResponseBurst) {
+		return rrlSend
+	}
+	if conf.Slip > 0 && l.suppressed.Add(1)%uint64(conf.Slip) == 0 {
+		return rrlSlip
+	}
+	return rrlDrop
+}
+
+// dnsRateLimitKey joins the parts of a token bucket key.
+func dnsRateLimitKey(prefix string, parts ...[]byte) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	for _, p := range parts {
+		b.WriteByte(0)
+		b.Write(p)
+	}
+	return b.String()
+}
+
+// rrlNXDomainZone returns the zone of a negative answer for a nonexistent
+// name, as found in the SOA record of the authority section. Compact denial
+// of existence answers are recognized by their NXNAME type.
+func rrlNXDomainZone(msg *dns.Msg) (string, bool) {
+	nxdomain := msg.Rcode == dns.RcodeNameError
+	zone := ""
+	for _, rr := range msg.Ns {
+		switch rr := rr.(type) {
+		case *dns.SOA:
+			zone = strings.ToLower(rr.Hdr.Name)
+		case *dns.NSEC:
+			for _, t := range rr.TypeBitMap {
+				if t == typeNXNAME {
+					nxdomain = true
+				}
+			}
+		}
+	}
+	return zone, nxdomain && zone != ""
+}
+
+// dnsClientIP returns the IP address of a DNS client, or nil if addr is not
+// an IP based address.
+func dnsClientIP(addr net.Addr) net.IP {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		ip = a.IP
+	case *net.TCPAddr:
+		ip = a.IP
+	default:
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
+
+// rateLimitedHandler applies a dnsRateLimiter to the queries and responses
+// of the wrapped handler.
+type rateLimitedHandler struct {
+	limiter *dnsRateLimiter
+	next    dns.Handler
+}
+
+func (h *rateLimitedHandler) ServeDNS(resp dns.ResponseWriter, req *dns.Msg) {
+	if !h.limiter.allowQuery(resp.RemoteAddr()) {
+		metrics.IncrCounterWithLabels([]string{"dns", "rate_limit", "dropped"}, 1,
+			[]metrics.Label{{Name: "type", Value: "query"}})
+
+		// Answering a flood of UDP queries, even with an error, would
+		// still amplify it towards a possibly spoofed source.
+		if _, ok := resp.RemoteAddr().(*net.UDPAddr); ok {
+			return
+		}
+		m := new(dns.Msg)
+		m.SetRcode(req, dns.RcodeRefused)
+		resp.WriteMsg(m)
+		return
+	}
+
+	h.next.ServeDNS(&rrlResponseWriter{ResponseWriter: resp, limiter: h.limiter}, req)
+}
+
+// rrlResponseWriter applies response rate limiting to the messages written
+// by a handler.
+type rrlResponseWriter struct {
+	dns.ResponseWriter
+	limiter *dnsRateLimiter
+}
+
+func (w *rrlResponseWriter) WriteMsg(msg *dns.Msg) error {
+	switch w.limiter.checkResponse(w.RemoteAddr(), msg) {
+	case rrlDrop:
+		metrics.IncrCounterWithLabels([]string{"dns", "rate_limit", "dropped"}, 1,
+			[]metrics.Label{{Name: "type", Value: "response"}})
+		return nil
+	case rrlSlip:
+		// A truncated answer without records cannot be used for
+		// amplification, and tells legitimate clients to retry over TCP.
+		metrics.IncrCounter([]string{"dns", "rate_limit", "slipped"}, 1)
+		tc := &dns.Msg{MsgHdr: msg.MsgHdr, Question: msg.Question}
+		tc.Truncated = true
+		if opt := msg.IsEdns0(); opt != nil {
+			tc.Extra = []dns.RR{opt}
+		}
+		return w.ResponseWriter.WriteMsg(tc)
+	}
+	return w.ResponseWriter.WriteMsg(msg)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/config"
+	agentdns "github.com/hashicorp/consul/agent/dns"
+)
+
+func newTestDNSRateLimiter(t *testing.T, conf config.RuntimeDNSRateLimitConfig) *dnsRateLimiter {
+	t.Helper()
+	l := newDNSRateLimiter(conf)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	l.Run(ctx)
+	return l
+}
+
+func TestDNSRateLimiter_Query(t *testing.T) {
+	l := newTestDNSRateLimiter(t, config.RuntimeDNSRateLimitConfig{QueryRate: 0.001, QueryBurst: 2})
+
+	answer := func(resp dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+		resp.WriteMsg(m)
+	}
+	h := &rateLimitedHandler{limiter: l, next: dns.HandlerFunc(answer)}
+
+	serve := func(addr net.Addr) *dns.Msg {
+		req := new(dns.Msg)
+		req.SetQuestion("foo.service.consul.", dns.TypeA)
+		w := &agentdns.BufferResponseWriter{RemoteAddress: addr, Logger: hclog.NewNullLogger()}
+		h.ServeDNS(w, req)
+		if w.ResponseBuffer() == nil {
+			return nil
+		}
+		m := new(dns.Msg)
+		require.NoError(t, m.Unpack(w.ResponseBuffer()))
+		return m
+	}
+
+	udpClient := &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5353}
+	tcpClient := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5353}
+
+	// A new client is limited from its first query on.
+	require.NotNil(t, serve(udpClient))
+	require.NotNil(t, serve(udpClient))
+	require.Nil(t, serve(udpClient))
+
+	// The bucket is per source address, regardless of the transport.
+	m := serve(tcpClient)
+	require.NotNil(t, m)
+	require.Equal(t, dns.RcodeRefused, m.Rcode)
+
+	m = serve(&net.UDPAddr{IP: net.ParseIP("10.0.0.2"), Port: 5353})
+	require.NotNil(t, m)
+	require.Equal(t, dns.RcodeSuccess, m.Rcode)
+
+	t.Run("idle limiters are removed", func(t *testing.T) {
+		l.removeIdle(time.Now().Add(time.Minute))
+		require.NotNil(t, serve(udpClient))
+	})
+
+	t.Run("disabled on reload", func(t *testing.T) {
+		l.UpdateConfig(config.RuntimeDNSRateLimitConfig{})
+		require.NotNil(t, serve(udpClient))
+	})
+}
+
+func TestDNSRateLimiter_MaxLimiters(t *testing.T) {
+	l := newDNSRateLimiter(config.RuntimeDNSRateLimitConfig{QueryRate: 0.001, QueryBurst: 1})
+
+	client := &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5353}
+	require.True(t, l.allowQuery(client))
+	require.False(t, l.allowQuery(client))
+
+	// A flood of new sources evicts the least recently used buckets instead
+	// of growing the limiters without bound.
+	for i := 0; i < dnsRateLimitMaxLimiters; i++ {
+		ip := net.IPv4(10, 1, byte(i>>8), byte(i))
+		l.allowQuery(&net.UDPAddr{IP: ip, Port: 5353})
+	}
+	require.Equal(t, dnsRateLimitMaxLimiters, l.limiters.Len())
+	require.True(t, l.allowQuery(client))
+}
+
+func TestDNSRateLimiter_Response(t *testing.T) {
+	l := newTestDNSRateLimiter(t, config.RuntimeDNSRateLimitConfig{
+		ResponseRate:     0.001,
+		ResponseBurst:    1,
+		Slip:             2,
+		IPv4PrefixLength: 24,
+		IPv6PrefixLength: 56,
+	})
+
+	reply := func(name string, rcode int) *dns.Msg {
+		req := new(dns.Msg)
+		req.SetQuestion(name, dns.TypeA)
+		m := new(dns.Msg)
+		m.SetRcode(req, rcode)
+		if rcode == dns.RcodeNameError {
+			m.Ns = []dns.RR{&dns.SOA{Hdr: dns.RR_Header{Name: "consul.", Rrtype: dns.TypeSOA, Class: dns.ClassINET}}}
+		}
+		return m
+	}
+	client := &net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5353}
+
+	require.Equal(t, rrlSend, l.checkResponse(client, reply("foo.service.consul.", dns.RcodeSuccess)))
+	require.NotEqual(t, rrlSend, l.checkResponse(client, reply("foo.service.consul.", dns.RcodeSuccess)))
+
+	t.Run("slip", func(t *testing.T) {
+		var actions []rrlAction
+		for i := 0; i < 4; i++ {
+			actions = append(actions, l.checkResponse(client, reply("foo.service.consul.", dns.RcodeSuccess)))
+		}
+		require.Contains(t, actions, rrlDrop)
+		require.Contains(t, actions, rrlSlip)
+		require.NotContains(t, actions, rrlSend)
+	})
+
+	t.Run("clients in the same network share a bucket", func(t *testing.T) {
+		neighbour := &net.UDPAddr{IP: net.ParseIP("10.0.0.200"), Port: 5353}
+		require.NotEqual(t, rrlSend, l.checkResponse(neighbour, reply("foo.service.consul.", dns.RcodeSuccess)))
+
+		other := &net.UDPAddr{IP: net.ParseIP("10.0.1.1"), Port: 5353}
+		require.Equal(t, rrlSend, l.checkResponse(other, reply("foo.service.consul.", dns.RcodeSuccess)))
+	})
+
+	t.Run("nonexistent names share the bucket of their zone", func(t *testing.T) {
+		require.Equal(t, rrlSend, l.checkResponse(client, reply("a.consul.", dns.RcodeNameError)))
+		require.NotEqual(t, rrlSend, l.checkResponse(client, reply("b.consul.", dns.RcodeNameError)))
+	})
+
+	t.Run("tcp is not limited", func(t *testing.T) {
+		tcpClient := &net.TCPAddr{IP: client.IP, Port: client.Port}
+		require.Equal(t, rrlSend, l.checkResponse(tcpClient, reply("foo.service.consul.", dns.RcodeSuccess)))
+	})
+
+	t.Run("slipped responses are truncated", func(t *testing.T) {
+		w := &agentdns.BufferResponseWriter{RemoteAddress: client, Logger: hclog.NewNullLogger()}
+		rw := &rrlResponseWriter{ResponseWriter: w, limiter: l}
+		msg := reply("foo.service.consul.", dns.RcodeSuccess)
+		msg.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: "foo.service.consul.", Rrtype: dns.TypeA, Class: dns.ClassINET}, A: net.ParseIP("10.1.1.1")}}
+
+		for i := 0; i < 2 && w.ResponseBuffer() == nil; i++ {
+			require.NoError(t, rw.WriteMsg(msg))
+		}
+		require.NotNil(t, w.ResponseBuffer())
+		m := new(dns.Msg)
+		require.NoError(t, m.Unpack(w.ResponseBuffer()))
+		require.True(t, m.Truncated)
+		require.Empty(t, m.Answer)
+	})
+}
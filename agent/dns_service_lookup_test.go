@@ -2738,6 +2738,78 @@ func TestDNS_ServiceLookup_Randomize(t *testing.T) {
 	}
 }
 
+func TestDNS_ServiceLookup_AnswerOrdering(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	a := NewTestAgent(t, `
+		dns_config {
+			service_answer_ordering {
+				"web" = "consistent_hash"
+			}
+			answer_subset_size = 2
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	for i := 0; i < 10; i++ {
+		args := &structs.RegisterRequest{
+			Datacenter: "dc1",
+			Node:       fmt.Sprintf("foo%d", i),
+			Address:    fmt.Sprintf("127.0.0.%d", i+1),
+			Service: &structs.NodeService{
+				Service: "web",
+				Port:    8000,
+			},
+		}
+
+		var out struct{}
+		require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+	}
+
+	query := func(t *testing.T, subnet string) []string {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion("web.service.consul.", dns.TypeA)
+		o := new(dns.OPT)
+		o.Hdr.Name = "."
+		o.Hdr.Rrtype = dns.TypeOPT
+		o.Option = append(o.Option, &dns.EDNS0_SUBNET{
+			Code:          dns.EDNS0SUBNET,
+			Family:        1,
+			SourceNetmask: 32,
+			Address:       net.ParseIP(subnet),
+		})
+		m.Extra = append(m.Extra, o)
+
+		c := &dns.Client{Net: "tcp"}
+		in, _, err := c.Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+
+		var addrs []string
+		for _, rec := range in.Answer {
+			addrs = append(addrs, rec.(*dns.A).A.String())
+		}
+		sort.Strings(addrs)
+		return addrs
+	}
+
+	// Every client keeps getting the same subset of the instances.
+	subsets := map[string]struct{}{}
+	for i := 0; i < 10; i++ {
+		client := fmt.Sprintf("10.0.0.%d", i+1)
+		subset := query(t, client)
+		require.Len(t, subset, 2)
+		for j := 0; j < 5; j++ {
+			require.Equal(t, subset, query(t, client))
+		}
+		subsets[strings.Join(subset, "|")] = struct{}{}
+	}
+	require.Greater(t, len(subsets), 1, "all the clients got the same subset")
+}
+
 func TestDNS_ServiceLookup_Truncate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package exec
+
+import (
+	"strings"
+)
+
+// Sandbox restricts the environment that a command runs in. Sandboxing is
+// only supported on Linux. The agent refuses to start with a sandbox on other
+// platforms, where Start returns an error.
+type Sandbox struct {
+	// CgroupParent is a cgroup v2 directory in which a cgroup is created for
+	// every command to apply CPUMax and MemoryMax.
+	CgroupParent string
+
+	// CPUMax is the number of CPUs the command may use. Zero means no limit.
+	CPUMax float64
+
+	// MemoryMax is the number of bytes of memory the command may use. Zero
+	// means no limit.
+	MemoryMax int64
+
+	// User and Group run the command as another user and group, given by
+	// name or numeric ID. The command runs with the primary group of User
+	// when Group is empty.
+	User  string
+	Group string
+
+	// NoNewPrivileges prevents the command and its children from gaining
+	// privileges, for example through setuid binaries.
+	NoNewPrivileges bool
+
+	// EnvAllowlist lists the environment variables passed to the command. A
+	// trailing "*" matches all variables with that prefix. The whole
+	// environment is passed when it is empty.
+	EnvAllowlist []string
+}
+
+// Enabled returns true if the sandbox restricts commands in any way.
+func (s *Sandbox) Enabled() bool {
+	return s.CPUMax > 0 || s.MemoryMax > 0 || s.User != "" || s.Group != "" ||
+		s.NoNewPrivileges || len(s.EnvAllowlist) > 0
+}
+
+// filterEnv returns the variables of environ that are in the allowlist.
+func (s *Sandbox) filterEnv(environ []string) []string {
+	env := make([]string, 0, len(environ))
+	for _, kv := range environ {
+		name, _, _ := strings.Cut(kv, "=")
+		for _, allowed := range s.EnvAllowlist {
+			if prefix, ok := strings.CutSuffix(allowed, "*"); ok && strings.HasPrefix(name, prefix) || name == allowed {
+				env = append(env, kv)
+				break
+			}
+		}
+	}
+	return env
+}
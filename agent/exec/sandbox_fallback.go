@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package exec
+
+import (
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// SandboxRun is a command started in a Sandbox.
+type SandboxRun struct{}
+
+// Start returns an error because sandboxing is only supported on Linux.
+func (s *Sandbox) Start(cmd *exec.Cmd) (*SandboxRun, error) {
+	return nil, errors.New("sandboxing commands is only supported on Linux")
+}
+
+func (r *SandboxRun) LimitExceeded() string { return "" }
+
+func (r *SandboxRun) Throttled() time.Duration { return 0 }
+
+func (r *SandboxRun) Close() error { return nil }
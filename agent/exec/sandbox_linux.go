@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package exec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	osuser "os/user"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// cpuPeriod is the cgroup CPU accounting period in microseconds that CPUMax
+// is applied to.
+const cpuPeriod = 100000
+
+// SandboxRun is a command started in a Sandbox.
+type SandboxRun struct {
+	sandbox *Sandbox
+	cgroup  string
+}
+
+// Start starts cmd in the sandbox. Close must be called on the returned run
+// once the command exited.
+func (s *Sandbox) Start(cmd *exec.Cmd) (*SandboxRun, error) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	if len(s.EnvAllowlist) > 0 {
+		env := cmd.Env
+		if env == nil {
+			env = os.Environ()
+		}
+		cmd.Env = s.filterEnv(env)
+	}
+	if s.User != "" || s.Group != "" {
+		cred, err := s.credential()
+		if err != nil {
+			return nil, err
+		}
+		cmd.SysProcAttr.Credential = cred
+	}
+
+	run := &SandboxRun{sandbox: s}
+	if s.CPUMax > 0 || s.MemoryMax > 0 {
+		cgroup, err := s.createCgroup()
+		if err != nil {
+			return nil, err
+		}
+		run.cgroup = cgroup
+
+		dir, err := os.Open(cgroup)
+		if err != nil {
+			run.Close()
+			return nil, fmt.Errorf("failed to open cgroup %q: %w", cgroup, err)
+		}
+		defer dir.Close()
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = int(dir.Fd())
+	}
+
+	if err := s.start(cmd); err != nil {
+		run.Close()
+		return nil, err
+	}
+	return run, nil
+}
+
+// start starts cmd with no_new_privs set if the sandbox requires it.
+func (s *Sandbox) start(cmd *exec.Cmd) error {
+	if !s.NoNewPrivileges {
+		return cmd.Start()
+	}
+
+	// The no_new_privs attribute is inherited by the processes that a thread
+	// forks and can never be cleared again. Set it on a locked thread that is
+	// thrown away once the command started, which the runtime does when a
+	// goroutine exits without unlocking its thread.
+	errCh := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			errCh <- fmt.Errorf("failed to set no_new_privs: %w", err)
+			return
+		}
+		errCh <- cmd.Start()
+	}()
+	return <-errCh
+}
+
+// credential returns the credential of the user and group of the sandbox.
+func (s *Sandbox) credential() (*syscall.Credential, error) {
+	uid, gid := os.Getuid(), os.Getgid()
+	if s.User != "" {
+		u, err := lookupUser(s.User)
+		switch {
+		case err == nil:
+			uid, _ = strconv.Atoi(u.Uid)
+			gid, _ = strconv.Atoi(u.Gid)
+		case s.Group != "":
+			// Users that only exist as an ID are fine as long as the group
+			// doesn't have to be looked up from them.
+			if uid, err = strconv.Atoi(s.User); err != nil {
+				return nil, fmt.Errorf("failed to look up user %s: %v", s.User, err)
+			}
+		default:
+			return nil, fmt.Errorf("failed to look up user %s: %v", s.User, err)
+		}
+	}
+	if s.Group != "" {
+		var err error
+		if gid, err = strconv.Atoi(s.Group); err != nil {
+			g, err := osuser.LookupGroup(s.Group)
+			if err != nil {
+				return nil, fmt.Errorf("failed to look up group %s: %v", s.Group, err)
+			}
+			gid, _ = strconv.Atoi(g.Gid)
+		}
+	}
+
+	// An empty list of groups drops the supplementary groups of the agent.
+	return &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid), Groups: []uint32{}}, nil
+}
+
+func lookupUser(user string) (*osuser.User, error) {
+	if _, err := strconv.Atoi(user); err == nil {
+		return osuser.LookupId(user)
+	}
+	return osuser.Lookup(user)
+}
+
+// createCgroup creates a cgroup in CgroupParent with the limits of the
+// sandbox and returns its path.
+func (s *Sandbox) createCgroup() (string, error) {
+	if s.CgroupParent == "" {
+		return "", errors.New("a cgroup parent is required for CPU and memory limits")
+	}
+	if _, err := os.Stat(filepath.Join(s.CgroupParent, "cgroup.controllers")); err != nil {
+		return "", fmt.Errorf("cgroup parent %q is not a cgroup v2 directory: %w", s.CgroupParent, err)
+	}
+
+	var controllers []string
+	if s.CPUMax > 0 {
+		controllers = append(controllers, "+cpu")
+	}
+	if s.MemoryMax > 0 {
+		controllers = append(controllers, "+memory")
+	}
+	if err := os.WriteFile(filepath.Join(s.CgroupParent, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0); err != nil {
+		return "", fmt.Errorf("failed to enable the %s controllers in cgroup parent %q: %w", strings.Join(controllers, " "), s.CgroupParent, err)
+	}
+
+	cgroup, err := os.MkdirTemp(s.CgroupParent, "check-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create cgroup: %w", err)
+	}
+	limits := map[string]string{}
+	if s.CPUMax > 0 {
+		limits["cpu.max"] = fmt.Sprintf("%d %d", max(int64(s.CPUMax*cpuPeriod), 1000), cpuPeriod)
+	}
+	if s.MemoryMax > 0 {
+		limits["memory.max"] = strconv.FormatInt(s.MemoryMax, 10)
+		// Swapping would let the command exceed the memory limit.
+		limits["memory.swap.max"] = "0"
+	}
+	for file, value := range limits {
+		err := os.WriteFile(filepath.Join(cgroup, file), []byte(value), 0)
+		if err != nil && !(file == "memory.swap.max" && errors.Is(err, os.ErrNotExist)) {
+			os.Remove(cgroup)
+			return "", fmt.Errorf("failed to set %s of cgroup %q: %w", file, cgroup, err)
+		}
+	}
+	return cgroup, nil
+}
+
+// LimitExceeded returns a description of the sandbox limit that made the
+// kernel kill the command, or an empty string if none did.
+func (r *SandboxRun) LimitExceeded() string {
+	if r == nil || r.cgroup == "" || r.sandbox.MemoryMax <= 0 {
+		return ""
+	}
+	if kills, _ := readCgroupStat(filepath.Join(r.cgroup, "memory.events"), "oom_kill"); kills > 0 {
+		return fmt.Sprintf("memory limit of %d bytes", r.sandbox.MemoryMax)
+	}
+	return ""
+}
+
+// Throttled returns how long the CPU limit of the sandbox held back the
+// command.
+func (r *SandboxRun) Throttled() time.Duration {
+	if r == nil || r.cgroup == "" || r.sandbox.CPUMax <= 0 {
+		return 0
+	}
+	usec, _ := readCgroupStat(filepath.Join(r.cgroup, "cpu.stat"), "throttled_usec")
+	return time.Duration(usec) * time.Microsecond
+}
+
+// Close kills the processes that are left in the cgroup of the run and
+// removes it.
+func (r *SandboxRun) Close() error {
+	if r == nil || r.cgroup == "" {
+		return nil
+	}
+
+	// cgroup.kill only exists since Linux 5.14. The command itself was
+	// already killed through its process group on older kernels.
+	os.WriteFile(filepath.Join(r.cgroup, "cgroup.kill"), []byte("1"), 0)
+
+	// Removing the cgroup fails until the killed processes are gone.
+	var err error
+	for i := 0; i < 50; i++ {
+		if err = os.Remove(r.cgroup); err == nil || !errors.Is(err, syscall.EBUSY) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove cgroup %q: %w", r.cgroup, err)
+	}
+	r.cgroup = ""
+	return nil
+}
+
+// readCgroupStat returns the value of key in a flat keyed cgroup file.
+func readCgroupStat(path, key string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if k, v, ok := strings.Cut(scanner.Text(), " "); ok && k == key {
+			return strconv.ParseInt(v, 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("%s not found in %s", key, path)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
@@ -32,12 +33,24 @@ func (s *HTTPHandlers) KVSEndpoint(resp http.ResponseWriter, req *http.Request)
 		keyList = true
 	}
 
+	// Check for a read of the history of the key
+	history := false
+	if _, ok := params["revisions"]; ok {
+		history = true
+	}
+	if _, ok := params["at"]; ok {
+		history = true
+	}
+
 	// Switch on the method
 	switch req.Method {
 	case "GET":
 		if keyList {
 			return s.KVSGetKeys(resp, req, &args)
 		}
+		if history {
+			return s.KVSGetRevisions(resp, req, &args)
+		}
 		return s.KVSGet(resp, req, &args)
 	case "PUT":
 		return s.KVSPut(resp, req, &args)
@@ -104,6 +117,69 @@ func (s *HTTPHandlers) KVSGet(resp http.ResponseWriter, req *http.Request, args
 	return out.Entries, nil
 }
 
+// KVSGetRevisions handles a GET request for the recorded revisions of a key,
+// or for the value the key had at a given index
+func (s *HTTPHandlers) KVSGetRevisions(resp http.ResponseWriter, req *http.Request, args *structs.KeyRequest) (interface{}, error) {
+	params := req.URL.Query()
+	if _, ok := params["recurse"]; ok {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Conflicting flags: revisions and at cannot be used with recurse"}
+	}
+	if args.Key == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing key name"}
+	}
+	if err := s.parseEntMetaNoWildcard(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	var at uint64
+	_, pointInTime := params["at"]
+	if pointInTime {
+		var err error
+		at, err = strconv.ParseUint(params.Get("at"), 10, 64)
+		if err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid at index: %v", err)}
+		}
+	}
+
+	// Make the RPC
+	var out structs.IndexedKVSRevisions
+	if err := s.agent.RPC(req.Context(), "KVS.History", args, &out); err != nil {
+		return nil, err
+	}
+	setMeta(resp, &out.QueryMeta)
+
+	if !pointInTime {
+		if len(out.Revisions) == 0 {
+			resp.WriteHeader(http.StatusNotFound)
+			return nil, nil
+		}
+		return out.Revisions, nil
+	}
+
+	// The revisions are sorted newest first, so the first one written at or
+	// before the index is the value the key had at that index.
+	for _, rev := range out.Revisions {
+		if rev.ModifyIndex > at {
+			continue
+		}
+		if rev.Deleted {
+			break
+		}
+		return structs.DirEntries{&rev.DirEntry}, nil
+	}
+
+	// The key only didn't exist before its oldest revision if that revision
+	// created it. Otherwise the older revisions are no longer retained.
+	if n := len(out.Revisions); n > 0 {
+		oldest := out.Revisions[n-1]
+		if oldest.ModifyIndex > at && (oldest.Deleted || oldest.CreateIndex != oldest.ModifyIndex) {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "revision no longer retained"}
+		}
+	}
+	resp.WriteHeader(http.StatusNotFound)
+	return nil, nil
+}
+
 // KVSGetKeys handles a GET request for keys
 func (s *HTTPHandlers) KVSGetKeys(resp http.ResponseWriter, req *http.Request, args *structs.KeyRequest) (interface{}, error) {
 	if err := s.parseEntMeta(req, &args.EnterpriseMeta); err != nil {
@@ -184,6 +260,15 @@ func (s *HTTPHandlers) KVSPut(resp http.ResponseWriter, req *http.Request, args
 		applyReq.DirEnt.Flags = flagVal
 	}
 
+	// Check for a TTL
+	if _, ok := params["ttl"]; ok {
+		ttl := params.Get("ttl")
+		if d, err := time.ParseDuration(ttl); err != nil || d <= 0 {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid TTL: %q", ttl)}
+		}
+		applyReq.DirEnt.TTL = ttl
+	}
+
 	// Check for cas value
 	if _, ok := params["cas"]; ok {
 		casVal, err := strconv.ParseUint(params.Get("cas"), 10, 64)
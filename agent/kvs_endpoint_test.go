@@ -9,8 +9,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 
 	"github.com/hashicorp/consul/agent/structs"
@@ -507,6 +512,47 @@ func TestKVSEndpoint_PUT_ConflictingFlags(t *testing.T) {
 	}
 }
 
+func TestKVSEndpoint_PUT_TTL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	buf := bytes.NewBuffer([]byte("test"))
+	req, _ := http.NewRequest("PUT", "/v1/kv/test?ttl=1h", buf)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.KVSEndpoint(resp, req)
+	require.NoError(t, err)
+	require.True(t, obj.(bool))
+
+	req, _ = http.NewRequest("GET", "/v1/kv/test", nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.KVSEndpoint(resp, req)
+	require.NoError(t, err)
+	d := obj.(structs.DirEntries)
+	require.Len(t, d, 1)
+	require.Equal(t, "1h", d[0].TTL)
+	require.NotNil(t, d[0].Expires)
+	require.WithinDuration(t, time.Now().Add(time.Hour), *d[0].Expires, time.Minute)
+
+	for _, ttl := range []string{"nope", "0s", "-5m"} {
+		t.Run(ttl, func(t *testing.T) {
+			req, _ := http.NewRequest("PUT", "/v1/kv/test?ttl="+ttl, bytes.NewBuffer([]byte("test")))
+			resp := httptest.NewRecorder()
+			_, err := a.srv.KVSEndpoint(resp, req)
+			require.Error(t, err)
+			httpErr, ok := err.(HTTPError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+			require.Contains(t, httpErr.Reason, "Invalid TTL")
+		})
+	}
+}
+
 func TestKVSEndpoint_GET(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -575,3 +621,119 @@ func TestKVSEndpoint_DELETE_ConflictingFlags(t *testing.T) {
 		t.Fatalf("expected conflicting args error")
 	}
 }
+
+func TestKVSEndpoint_GET_Revisions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `kv_history { retain_count = 10 }`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	put := func(t require.TestingT, value string) {
+		req, _ := http.NewRequest("PUT", "/v1/kv/test", bytes.NewBufferString(value))
+		obj, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		require.True(t, obj.(bool))
+	}
+	get := func(t require.TestingT, query string) (*httptest.ResponseRecorder, interface{}) {
+		req, _ := http.NewRequest("GET", "/v1/kv/test?"+query, nil)
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.KVSEndpoint(resp, req)
+		require.NoError(t, err)
+		return resp, obj
+	}
+
+	// Recording starts once the leader has shared the retain count.
+	retry.Run(t, func(r *retry.R) {
+		put(r, "one")
+		resp, _ := get(r, "revisions")
+		require.Equal(r, http.StatusOK, resp.Code)
+	})
+	put(t, "two")
+	req, _ := http.NewRequest("DELETE", "/v1/kv/test", nil)
+	_, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+	require.NoError(t, err)
+
+	resp, obj := get(t, "revisions")
+	assertIndex(t, resp)
+	revs := obj.(structs.KVSRevisions)
+	require.GreaterOrEqual(t, len(revs), 3)
+	require.True(t, revs[0].Deleted)
+	require.Equal(t, []byte("two"), revs[1].Value)
+
+	t.Run("at", func(t *testing.T) {
+		_, obj := get(t, fmt.Sprintf("at=%d", revs[1].ModifyIndex))
+		d := obj.(structs.DirEntries)
+		require.Len(t, d, 1)
+		require.Equal(t, []byte("two"), d[0].Value)
+
+		// Between two revisions the older one applies.
+		_, obj = get(t, fmt.Sprintf("at=%d", revs[0].ModifyIndex-1))
+		require.Equal(t, []byte("two"), obj.(structs.DirEntries)[0].Value)
+	})
+
+	t.Run("at deleted", func(t *testing.T) {
+		resp, obj := get(t, fmt.Sprintf("at=%d", revs[0].ModifyIndex))
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Nil(t, obj)
+	})
+
+	t.Run("at before creation", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/v1/kv/created", bytes.NewBufferString("one"))
+		_, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+
+		req, _ = http.NewRequest("GET", "/v1/kv/created?revisions", nil)
+		obj, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		created := obj.(structs.KVSRevisions)[0].ModifyIndex
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/v1/kv/created?at=%d", created-1), nil)
+		resp := httptest.NewRecorder()
+		_, err = a.srv.KVSEndpoint(resp, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("at before history", func(t *testing.T) {
+		// The oldest revisions are dropped beyond the retain count.
+		var index uint64
+		for i := 0; i < 11; i++ {
+			req, _ := http.NewRequest("PUT", "/v1/kv/pruned", bytes.NewBufferString(strconv.Itoa(i)))
+			_, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+			require.NoError(t, err)
+			if i == 0 {
+				req, _ = http.NewRequest("GET", "/v1/kv/pruned", nil)
+				obj, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				index = obj.(structs.DirEntries)[0].ModifyIndex
+			}
+		}
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1/kv/pruned?at=%d", index), nil)
+		_, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+		require.Error(t, err)
+		httpErr, ok := err.(HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+		require.Equal(t, "revision no longer retained", httpErr.Reason)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for query, reason := range map[string]string{
+			"at=nope":           "Invalid at index",
+			"revisions&recurse": "Conflicting flags",
+		} {
+			req, _ := http.NewRequest("GET", "/v1/kv/test?"+query, nil)
+			_, err := a.srv.KVSEndpoint(httptest.NewRecorder(), req)
+			require.Error(t, err)
+			httpErr, ok := err.(HTTPError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+			require.Contains(t, httpErr.Reason, reason)
+		}
+	})
+}
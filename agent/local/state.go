@@ -136,6 +136,11 @@ type CheckState struct {
 	// IsLocallyDefined indicates whether the check was defined locally in config
 	// as opposed to being registered through the Agent API.
 	IsLocallyDefined bool
+
+	// Flapping is the flap dampening state of the health check, nil unless
+	// the check has a FlapHalfLife. Only whether the check is flapping is
+	// synced to the servers, with the MetaCheckFlapping key of its Meta.
+	Flapping *structs.CheckFlapState
 }
 
 // Clone returns a shallow copy of the object.
@@ -589,7 +594,8 @@ func (l *State) addCheckLocked(check *structs.HealthCheck, token string, isLocal
 
 // AddAliasCheck creates an alias check. When any check for the srcServiceID is
 // changed, checkID will reflect that using the same semantics as
-// checks.CheckAlias.
+// checks.CheckAlias. A srcServiceID with the wildcard ID is notified of the
+// changes to the checks of any service, which is used by checks.CheckComposite.
 //
 // This is a local optimization so that the Alias check doesn't need to use
 // blocking queries against the remote server for check updates for local
@@ -805,6 +811,69 @@ func (l *State) ChecksForService(serviceID structs.ServiceID, includeNodeChecks
 	return m
 }
 
+// UpdateCheckFlapping records the flap dampening state of a health check.
+// A sync is only triggered when the check starts or stops flapping, as the
+// rest of the state is only kept by the agent.
+func (l *State) UpdateCheckFlapping(id structs.CheckID, state *structs.CheckFlapState) {
+	l.Lock()
+	defer l.Unlock()
+
+	c := l.checks[id]
+	if c == nil || c.Deleted {
+		return
+	}
+	c.Flapping = state
+
+	value := ""
+	if state != nil && state.Flapping {
+		value = "true"
+	}
+	l.setCheckMetaLocked(id, c, structs.MetaCheckFlapping, value)
+}
+
+// UpdateCheckMeta sets a key of the metadata of a health check, or removes
+// the key if the value is empty. A sync is triggered if the metadata changed.
+func (l *State) UpdateCheckMeta(id structs.CheckID, key, value string) {
+	l.Lock()
+	defer l.Unlock()
+
+	c := l.checks[id]
+	if c == nil || c.Deleted {
+		return
+	}
+	l.setCheckMetaLocked(id, c, key, value)
+}
+
+// setCheckMetaLocked sets a key of the metadata of a health check, or
+// removes it if the value is empty. Empty metadata is stored as nil.
+//
+// NOTE: This is not thread safe and must only be called while holding the
+// lock.
+func (l *State) setCheckMetaLocked(id structs.CheckID, c *CheckState, key, value string) {
+	if c.Check.Meta[key] == value {
+		return
+	}
+
+	// Only mutate a copy of the check state.
+	c = c.Clone()
+	meta := make(map[string]string, len(c.Check.Meta)+1)
+	for k, v := range c.Check.Meta {
+		meta[k] = v
+	}
+	if value == "" {
+		delete(meta, key)
+	} else {
+		meta[key] = value
+	}
+	if len(meta) == 0 {
+		meta = nil
+	}
+	c.Check.Meta = meta
+	c.InSync = false
+	l.checks[id] = c
+	l.TriggerSyncChanges()
+}
+
 // CheckState returns a shallow copy of the current health check state record.
 //
 // The defer timer still points to the original value and must not be modified.
@@ -1609,6 +1678,11 @@ func (l *State) syncNodeInfo() error {
 
 // notifyIfAliased will notify waiters of changes to an aliased service
 func (l *State) notifyIfAliased(serviceID structs.ServiceID) {
+	l.notifyAliases(serviceID)
+	l.notifyAliases(structs.NewServiceID(structs.WildcardSpecifier, serviceID.WithWildcardNamespace()))
+}
+
+func (l *State) notifyAliases(serviceID structs.ServiceID) {
 	if aliases, ok := l.checkAliases[serviceID]; ok && len(aliases) > 0 {
 		for _, notifyCh := range aliases {
 			// Do not block. All notify channels should be buffered to at
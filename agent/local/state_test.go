@@ -1757,6 +1757,47 @@ func TestAgent_UpdateCheck_DiscardOutput(t *testing.T) {
 	}
 }
 
+func TestAgent_UpdateCheckMeta(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	cid := structs.NewCheckID("web", nil)
+	check := &structs.HealthCheck{
+		Node:    a.Config.NodeName,
+		CheckID: "web",
+		Name:    "web",
+		Status:  api.HealthPassing,
+	}
+	require.NoError(t, a.State.AddCheck(check, "", false))
+	require.NoError(t, a.State.SyncFull())
+	require.True(t, a.State.CheckState(cid).InSync)
+
+	// Setting a key puts the check out of sync.
+	a.State.UpdateCheckMeta(cid, "key", "value")
+	require.Equal(t, map[string]string{"key": "value"}, a.State.Check(cid).Meta)
+	require.False(t, a.State.CheckState(cid).InSync)
+
+	// Setting the same value again leaves the check in sync.
+	require.NoError(t, a.State.SyncFull())
+	a.State.UpdateCheckMeta(cid, "key", "value")
+	require.True(t, a.State.CheckState(cid).InSync)
+
+	// An empty value removes the key, and empty metadata is nil.
+	a.State.UpdateCheckMeta(cid, "key", "")
+	require.Nil(t, a.State.Check(cid).Meta)
+	require.False(t, a.State.CheckState(cid).InSync)
+
+	// Unknown checks are ignored.
+	a.State.UpdateCheckMeta(structs.NewCheckID("unknown", nil), "key", "value")
+	require.Nil(t, a.State.Check(structs.NewCheckID("unknown", nil)))
+}
+
 func TestAgentAntiEntropy_Check_DeferSync(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -2316,6 +2357,42 @@ func TestAgent_AliasCheck_ServiceNotification(t *testing.T) {
 	}
 }
 
+func TestAgent_AliasCheck_Wildcard(t *testing.T) {
+	t.Parallel()
+
+	cfg := loadRuntimeConfig(t, `bind_addr = "127.0.0.1" data_dir = "dummy" node_name = "dummy"`)
+	l := local.NewState(agent.LocalConfig(cfg), nil, new(token.Store))
+	l.TriggerSyncChanges = func() {}
+
+	notifyCh := make(chan struct{}, 1)
+	require.NoError(t, l.AddAliasCheck(structs.NewCheckID(types.CheckID("a1"), nil), structs.NewServiceID(structs.WildcardSpecifier, nil), notifyCh))
+
+	// Adding any service, service check or node check notifies
+	require.NoError(t, l.AddServiceWithChecks(&structs.NodeService{Service: "s1"}, nil, "", false))
+	require.NoError(t, l.AddCheck(&structs.HealthCheck{CheckID: types.CheckID("c1"), ServiceID: "s1"}, "", false))
+	require.NoError(t, l.AddCheck(&structs.HealthCheck{CheckID: types.CheckID("c2")}, "", false))
+	select {
+	case <-notifyCh:
+	default:
+		t.Fatal("notify not received")
+	}
+
+	l.UpdateCheck(structs.NewCheckID(types.CheckID("c2"), nil), api.HealthPassing, "")
+	select {
+	case <-notifyCh:
+	default:
+		t.Fatal("notify not received")
+	}
+
+	l.RemoveAliasCheck(structs.NewCheckID(types.CheckID("a1"), nil), structs.NewServiceID(structs.WildcardSpecifier, nil))
+	l.UpdateCheck(structs.NewCheckID(types.CheckID("c1"), nil), api.HealthPassing, "")
+	select {
+	case <-notifyCh:
+		t.Fatal("notify received")
+	default:
+	}
+}
+
 func TestAgent_sendCoordinate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -20,6 +20,7 @@ type Notify struct {
 	state      map[structs.CheckID]string
 	updates    map[structs.CheckID]int
 	output     map[structs.CheckID]string
+	meta       map[structs.CheckID]map[string]string
 	serviceIDs map[structs.ServiceID]bool
 }
 
@@ -28,6 +29,7 @@ func NewNotify() *Notify {
 		state:      make(map[structs.CheckID]string),
 		updates:    make(map[structs.CheckID]int),
 		output:     make(map[structs.CheckID]string),
+		meta:       make(map[structs.CheckID]map[string]string),
 		serviceIDs: make(map[structs.ServiceID]bool),
 	}
 }
@@ -48,6 +50,7 @@ func NewNotifyChan() (*Notify, chan int) {
 		state:   make(map[structs.CheckID]string),
 		updates: make(map[structs.CheckID]int),
 		output:  make(map[structs.CheckID]string),
+		meta:    make(map[structs.CheckID]map[string]string),
 	}
 	return n, n.updated
 }
@@ -75,6 +78,19 @@ func (m *Notify) UpdateCheck(id structs.CheckID, status, output string) {
 	}
 }
 
+func (m *Notify) UpdateCheckMeta(id structs.CheckID, key, value string) {
+	m.Lock()
+	defer m.Unlock()
+	if m.meta[id] == nil {
+		m.meta[id] = make(map[string]string)
+	}
+	if value == "" {
+		delete(m.meta[id], key)
+	} else {
+		m.meta[id][key] = value
+	}
+}
+
 // State returns the state of the specified health-check.
 func (m *Notify) State(id structs.CheckID) string {
 	m.RLock()
@@ -95,3 +111,10 @@ func (m *Notify) Output(id structs.CheckID) string {
 	defer m.RUnlock()
 	return m.output[id]
 }
+
+// Meta returns a metadata value of the specified health-check.
+func (m *Notify) Meta(id structs.CheckID, key string) string {
+	m.RLock()
+	defer m.RUnlock()
+	return m.meta[id][key]
+}
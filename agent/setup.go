@@ -367,6 +367,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 	if isServer {
 		gauges = append(gauges,
 			consul.AutopilotGauges,
+			consul.AutoSnapshotGauges,
 			consul.LeaderCertExpirationGauges,
 			consul.LeaderPeeringMetrics,
 			xdscapacity.StatsGauges,
@@ -431,6 +432,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 
 	var counters = [][]prometheus.CounterDefinition{
 		CatalogCounters,
+		DNSRateLimitCounters,
 		cache.Counters,
 		consul.ACLCounters,
 		consul.CatalogCounters,
@@ -443,6 +445,10 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 		rate.Counters,
 	}
 
+	if isServer {
+		counters = append(counters, consul.AutoSnapshotCounters)
+	}
+
 	// For some unknown reason, we seem to add the raft counters above without
 	// checking if this is a server like we do above for some of the summaries
 	// above. We should probably fix that but I want to not change behavior right
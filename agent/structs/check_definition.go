@@ -35,6 +35,7 @@ type CheckDefinition struct {
 	Method                         string
 	Body                           string
 	DisableRedirects               bool
+	ResponseAssertions             []HTTPResponseAssertion
 	TCP                            string
 	TCPUseTLS                      bool
 	UDP                            string
@@ -44,15 +45,29 @@ type CheckDefinition struct {
 	GRPC                           string
 	GRPCUseTLS                     bool
 	OSService                      string
+	CertExpiry                     string
+	DNS                            string
+	DNSServer                      string
+	FileFreshness                  string
+	Outlier                        string
+	WarningThreshold               time.Duration
+	CriticalThreshold              time.Duration
 	TLSServerName                  string
 	TLSSkipVerify                  bool
 	AliasNode                      string
 	AliasService                   string
+	CompositeMode                  string
+	CompositeQuorum                int
+	CompositeMembers               []CompositeCheckMember
 	Timeout                        time.Duration
 	TTL                            time.Duration
 	SuccessBeforePassing           int
 	FailuresBeforeWarning          int
 	FailuresBeforeCritical         int
+	FlapHalfLife                   time.Duration
+	FlapMaxSuppress                time.Duration
+	FlapSuppressThreshold          int
+	FlapReuseThreshold             int
 	DeregisterCriticalServiceAfter time.Duration
 	OutputMaxSize                  int
 
@@ -67,21 +82,38 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 		Timeout                        interface{}
 		TTL                            interface{}
 		DeregisterCriticalServiceAfter interface{}
+		WarningThreshold               interface{}
+		CriticalThreshold              interface{}
+		FlapHalfLife                   interface{}
+		FlapMaxSuppress                interface{}
 
 		// Translate fields
 
 		// "args" -> ScriptArgs
-		Args                                []string    `json:"args"`
-		ScriptArgsSnake                     []string    `json:"script_args"`
-		DeregisterCriticalServiceAfterSnake interface{} `json:"deregister_critical_service_after"`
-		DockerContainerIDSnake              string      `json:"docker_container_id"`
-		TLSServerNameSnake                  string      `json:"tls_server_name"`
-		TLSSkipVerifySnake                  bool        `json:"tls_skip_verify"`
-		TCPUseTLSSnake                      bool        `json:"tcp_use_tls"`
-		GRPCUseTLSSnake                     bool        `json:"grpc_use_tls"`
-		ServiceIDSnake                      string      `json:"service_id"`
-		H2PingUseTLSSnake                   bool        `json:"h2ping_use_tls"`
-		DisableRedirectsSnake               bool        `json:"disable_redirects"`
+		Args                                []string                `json:"args"`
+		ScriptArgsSnake                     []string                `json:"script_args"`
+		DeregisterCriticalServiceAfterSnake interface{}             `json:"deregister_critical_service_after"`
+		DockerContainerIDSnake              string                  `json:"docker_container_id"`
+		TLSServerNameSnake                  string                  `json:"tls_server_name"`
+		TLSSkipVerifySnake                  bool                    `json:"tls_skip_verify"`
+		TCPUseTLSSnake                      bool                    `json:"tcp_use_tls"`
+		GRPCUseTLSSnake                     bool                    `json:"grpc_use_tls"`
+		ServiceIDSnake                      string                  `json:"service_id"`
+		H2PingUseTLSSnake                   bool                    `json:"h2ping_use_tls"`
+		DisableRedirectsSnake               bool                    `json:"disable_redirects"`
+		ResponseAssertionsSnake             []HTTPResponseAssertion `json:"response_assertions"`
+		CompositeModeSnake                  string                  `json:"composite_mode"`
+		CompositeQuorumSnake                int                     `json:"composite_quorum"`
+		CompositeMembersSnake               []CompositeCheckMember  `json:"composite_members"`
+		CertExpirySnake                     string                  `json:"cert_expiry"`
+		DNSServerSnake                      string                  `json:"dns_server"`
+		FileFreshnessSnake                  string                  `json:"file_freshness"`
+		WarningThresholdSnake               interface{}             `json:"warning_threshold"`
+		CriticalThresholdSnake              interface{}             `json:"critical_threshold"`
+		FlapHalfLifeSnake                   interface{}             `json:"flap_half_life"`
+		FlapMaxSuppressSnake                interface{}             `json:"flap_max_suppress"`
+		FlapSuppressThresholdSnake          int                     `json:"flap_suppress_threshold"`
+		FlapReuseThresholdSnake             int                     `json:"flap_reuse_threshold"`
 
 		*Alias
 	}{
@@ -133,6 +165,45 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 	if aux.DisableRedirectsSnake {
 		t.DisableRedirects = aux.DisableRedirectsSnake
 	}
+	if len(t.ResponseAssertions) == 0 {
+		t.ResponseAssertions = aux.ResponseAssertionsSnake
+	}
+	if t.CompositeMode == "" {
+		t.CompositeMode = aux.CompositeModeSnake
+	}
+	if t.CompositeQuorum == 0 {
+		t.CompositeQuorum = aux.CompositeQuorumSnake
+	}
+	if len(t.CompositeMembers) == 0 {
+		t.CompositeMembers = aux.CompositeMembersSnake
+	}
+	if t.CertExpiry == "" {
+		t.CertExpiry = aux.CertExpirySnake
+	}
+	if t.DNSServer == "" {
+		t.DNSServer = aux.DNSServerSnake
+	}
+	if t.FileFreshness == "" {
+		t.FileFreshness = aux.FileFreshnessSnake
+	}
+	if aux.WarningThreshold == nil {
+		aux.WarningThreshold = aux.WarningThresholdSnake
+	}
+	if aux.CriticalThreshold == nil {
+		aux.CriticalThreshold = aux.CriticalThresholdSnake
+	}
+	if aux.FlapHalfLife == nil {
+		aux.FlapHalfLife = aux.FlapHalfLifeSnake
+	}
+	if aux.FlapMaxSuppress == nil {
+		aux.FlapMaxSuppress = aux.FlapMaxSuppressSnake
+	}
+	if t.FlapSuppressThreshold == 0 {
+		t.FlapSuppressThreshold = aux.FlapSuppressThresholdSnake
+	}
+	if t.FlapReuseThreshold == 0 {
+		t.FlapReuseThreshold = aux.FlapReuseThresholdSnake
+	}
 
 	if (aux.H2PING != "" && !aux.H2PingUseTLSSnake) || (aux.H2PING == "" && aux.H2PingUseTLSSnake) {
 		t.H2PingUseTLS = aux.H2PingUseTLSSnake
@@ -179,6 +250,46 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 			t.DeregisterCriticalServiceAfter = time.Duration(v)
 		}
 	}
+	if aux.WarningThreshold != nil {
+		switch v := aux.WarningThreshold.(type) {
+		case string:
+			if t.WarningThreshold, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.WarningThreshold = time.Duration(v)
+		}
+	}
+	if aux.CriticalThreshold != nil {
+		switch v := aux.CriticalThreshold.(type) {
+		case string:
+			if t.CriticalThreshold, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.CriticalThreshold = time.Duration(v)
+		}
+	}
+	if aux.FlapHalfLife != nil {
+		switch v := aux.FlapHalfLife.(type) {
+		case string:
+			if t.FlapHalfLife, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.FlapHalfLife = time.Duration(v)
+		}
+	}
+	if aux.FlapMaxSuppress != nil {
+		switch v := aux.FlapMaxSuppress.(type) {
+		case string:
+			if t.FlapMaxSuppress, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.FlapMaxSuppress = time.Duration(v)
+		}
+	}
 
 	return nil
 }
@@ -214,6 +325,9 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		ScriptArgs:                     c.ScriptArgs,
 		AliasNode:                      c.AliasNode,
 		AliasService:                   c.AliasService,
+		CompositeMode:                  c.CompositeMode,
+		CompositeQuorum:                c.CompositeQuorum,
+		CompositeMembers:               c.CompositeMembers,
 		HTTP:                           c.HTTP,
 		H2PING:                         c.H2PING,
 		H2PingUseTLS:                   c.H2PingUseTLS,
@@ -223,6 +337,7 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		Method:                         c.Method,
 		Body:                           c.Body,
 		DisableRedirects:               c.DisableRedirects,
+		ResponseAssertions:             c.ResponseAssertions,
 		OutputMaxSize:                  c.OutputMaxSize,
 		TCP:                            c.TCP,
 		TCPUseTLS:                      c.TCPUseTLS,
@@ -231,6 +346,13 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		DockerContainerID:              c.DockerContainerID,
 		Shell:                          c.Shell,
 		OSService:                      c.OSService,
+		CertExpiry:                     c.CertExpiry,
+		DNS:                            c.DNS,
+		DNSServer:                      c.DNSServer,
+		FileFreshness:                  c.FileFreshness,
+		Outlier:                        c.Outlier,
+		WarningThreshold:               c.WarningThreshold,
+		CriticalThreshold:              c.CriticalThreshold,
 		TLSServerName:                  c.TLSServerName,
 		TLSSkipVerify:                  c.TLSSkipVerify,
 		Timeout:                        c.Timeout,
@@ -238,6 +360,10 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		SuccessBeforePassing:           c.SuccessBeforePassing,
 		FailuresBeforeWarning:          c.FailuresBeforeWarning,
 		FailuresBeforeCritical:         c.FailuresBeforeCritical,
+		FlapHalfLife:                   c.FlapHalfLife,
+		FlapMaxSuppress:                c.FlapMaxSuppress,
+		FlapSuppressThreshold:          c.FlapSuppressThreshold,
+		FlapReuseThreshold:             c.FlapReuseThreshold,
 		DeregisterCriticalServiceAfter: c.DeregisterCriticalServiceAfter,
 	}
 }
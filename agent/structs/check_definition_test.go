@@ -53,6 +53,11 @@ func TestCheckDefinition_CheckType(t *testing.T) {
 	// Fuzz a definition to fill all its fields with data.
 	var def CheckDefinition
 	fuzz.New().Fuzz(&def)
+
+	// The members of a composite check have a CheckID field which would
+	// shadow the one of the check type, so they are compared on their own.
+	require.Equal(t, def.CompositeMembers, def.CheckType().CompositeMembers)
+	def.CompositeMembers = nil
 	orig := mapFields(t, def)
 
 	// Remap the ID field which changes name, and redact fields we don't
@@ -5,20 +5,25 @@ package structs
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/lib/jsonpath"
 	"github.com/hashicorp/consul/types"
 )
 
 type CheckTypes []*CheckType
 
 // CheckType is used to create either the CheckMonitor or the CheckTTL.
-// The following types are supported: Script, HTTP, TCP, Docker, TTL, GRPC, Alias, H2PING. Script,
-// HTTP, Docker, TCP, GRPC, and H2PING all require Interval. Only one of the types may
+// The following types are supported: Script, HTTP, TCP, Docker, TTL, GRPC, Alias, Composite,
+// H2PING, CertExpiry, DNS, FileFreshness, Outlier. Script, HTTP, Docker, TCP, GRPC, H2PING, CertExpiry, DNS,
+// FileFreshness and Outlier all require Interval. Only one of the types may
 // to be provided: TTL or Script/Interval or HTTP/Interval or TCP/Interval or
-// Docker/Interval or GRPC/Interval or AliasService or H2PING/Interval.
+// Docker/Interval or GRPC/Interval or AliasService or CompositeMembers or H2PING/Interval or
+// CertExpiry/Interval or DNS/Interval or FileFreshness/Interval or Outlier/Interval.
 // Since types like CheckHTTP and CheckGRPC derive from CheckType, there are
 // helper conversion methods that do the reverse conversion. ie. checkHTTP.CheckType()
 type CheckType struct {
@@ -41,17 +46,28 @@ type CheckType struct {
 	Method                 string
 	Body                   string
 	DisableRedirects       bool
+	ResponseAssertions     []HTTPResponseAssertion
 	TCP                    string
 	TCPUseTLS              bool
 	UDP                    string
 	Interval               time.Duration
 	AliasNode              string
 	AliasService           string
+	CompositeMode          string
+	CompositeQuorum        int
+	CompositeMembers       []CompositeCheckMember
 	DockerContainerID      string
 	Shell                  string
 	GRPC                   string
 	GRPCUseTLS             bool
 	OSService              string
+	CertExpiry             string
+	DNS                    string
+	DNSServer              string
+	FileFreshness          string
+	Outlier                string
+	WarningThreshold       time.Duration
+	CriticalThreshold      time.Duration
 	TLSServerName          string
 	TLSSkipVerify          bool
 	Timeout                time.Duration
@@ -59,6 +75,10 @@ type CheckType struct {
 	SuccessBeforePassing   int
 	FailuresBeforeWarning  int
 	FailuresBeforeCritical int
+	FlapHalfLife           time.Duration
+	FlapMaxSuppress        time.Duration
+	FlapSuppressThreshold  int
+	FlapReuseThreshold     int
 
 	// Definition fields used when exposing checks through a proxy
 	ProxyHTTP string
@@ -78,19 +98,36 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 		Timeout                        interface{}
 		TTL                            interface{}
 		DeregisterCriticalServiceAfter interface{}
+		WarningThreshold               interface{}
+		CriticalThreshold              interface{}
+		FlapHalfLife                   interface{}
+		FlapMaxSuppress                interface{}
 
 		// Translate fields
 
 		// "args" -> ScriptArgs
-		Args                                []string    `json:"args"`
-		ScriptArgsSnake                     []string    `json:"script_args"`
-		DeregisterCriticalServiceAfterSnake interface{} `json:"deregister_critical_service_after"`
-		DockerContainerIDSnake              string      `json:"docker_container_id"`
-		TLSServerNameSnake                  string      `json:"tls_server_name"`
-		TLSSkipVerifySnake                  bool        `json:"tls_skip_verify"`
-		TCPUseTLSSnake                      bool        `json:"tcp_use_tls"`
-		GRPCUseTLSSnake                     bool        `json:"grpc_use_tls"`
-		H2PingUseTLSSnake                   bool        `json:"h2ping_use_tls"`
+		Args                                []string                `json:"args"`
+		ScriptArgsSnake                     []string                `json:"script_args"`
+		DeregisterCriticalServiceAfterSnake interface{}             `json:"deregister_critical_service_after"`
+		DockerContainerIDSnake              string                  `json:"docker_container_id"`
+		TLSServerNameSnake                  string                  `json:"tls_server_name"`
+		TLSSkipVerifySnake                  bool                    `json:"tls_skip_verify"`
+		TCPUseTLSSnake                      bool                    `json:"tcp_use_tls"`
+		GRPCUseTLSSnake                     bool                    `json:"grpc_use_tls"`
+		H2PingUseTLSSnake                   bool                    `json:"h2ping_use_tls"`
+		ResponseAssertionsSnake             []HTTPResponseAssertion `json:"response_assertions"`
+		CompositeModeSnake                  string                  `json:"composite_mode"`
+		CompositeQuorumSnake                int                     `json:"composite_quorum"`
+		CompositeMembersSnake               []CompositeCheckMember  `json:"composite_members"`
+		CertExpirySnake                     string                  `json:"cert_expiry"`
+		DNSServerSnake                      string                  `json:"dns_server"`
+		FileFreshnessSnake                  string                  `json:"file_freshness"`
+		WarningThresholdSnake               interface{}             `json:"warning_threshold"`
+		CriticalThresholdSnake              interface{}             `json:"critical_threshold"`
+		FlapHalfLifeSnake                   interface{}             `json:"flap_half_life"`
+		FlapMaxSuppressSnake                interface{}             `json:"flap_max_suppress"`
+		FlapSuppressThresholdSnake          int                     `json:"flap_suppress_threshold"`
+		FlapReuseThresholdSnake             int                     `json:"flap_reuse_threshold"`
 
 		// These are going to be ignored but since we are disallowing unknown fields
 		// during parsing we have to be explicit about parsing but not using these.
@@ -121,6 +158,18 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 	if len(t.ScriptArgs) == 0 {
 		t.ScriptArgs = aux.Args
 	}
+	if len(t.ResponseAssertions) == 0 {
+		t.ResponseAssertions = aux.ResponseAssertionsSnake
+	}
+	if t.CompositeMode == "" {
+		t.CompositeMode = aux.CompositeModeSnake
+	}
+	if t.CompositeQuorum == 0 {
+		t.CompositeQuorum = aux.CompositeQuorumSnake
+	}
+	if len(t.CompositeMembers) == 0 {
+		t.CompositeMembers = aux.CompositeMembersSnake
+	}
 	if len(t.ScriptArgs) == 0 {
 		t.ScriptArgs = aux.ScriptArgsSnake
 	}
@@ -139,6 +188,33 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 	if aux.GRPCUseTLSSnake {
 		t.GRPCUseTLS = aux.GRPCUseTLSSnake
 	}
+	if t.CertExpiry == "" {
+		t.CertExpiry = aux.CertExpirySnake
+	}
+	if t.DNSServer == "" {
+		t.DNSServer = aux.DNSServerSnake
+	}
+	if t.FileFreshness == "" {
+		t.FileFreshness = aux.FileFreshnessSnake
+	}
+	if aux.WarningThreshold == nil {
+		aux.WarningThreshold = aux.WarningThresholdSnake
+	}
+	if aux.CriticalThreshold == nil {
+		aux.CriticalThreshold = aux.CriticalThresholdSnake
+	}
+	if aux.FlapHalfLife == nil {
+		aux.FlapHalfLife = aux.FlapHalfLifeSnake
+	}
+	if aux.FlapMaxSuppress == nil {
+		aux.FlapMaxSuppress = aux.FlapMaxSuppressSnake
+	}
+	if t.FlapSuppressThreshold == 0 {
+		t.FlapSuppressThreshold = aux.FlapSuppressThresholdSnake
+	}
+	if t.FlapReuseThreshold == 0 {
+		t.FlapReuseThreshold = aux.FlapReuseThresholdSnake
+	}
 	if aux.Interval != nil {
 		switch v := aux.Interval.(type) {
 		case string:
@@ -179,6 +255,46 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 			t.DeregisterCriticalServiceAfter = time.Duration(v)
 		}
 	}
+	if aux.WarningThreshold != nil {
+		switch v := aux.WarningThreshold.(type) {
+		case string:
+			if t.WarningThreshold, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.WarningThreshold = time.Duration(v)
+		}
+	}
+	if aux.CriticalThreshold != nil {
+		switch v := aux.CriticalThreshold.(type) {
+		case string:
+			if t.CriticalThreshold, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.CriticalThreshold = time.Duration(v)
+		}
+	}
+	if aux.FlapHalfLife != nil {
+		switch v := aux.FlapHalfLife.(type) {
+		case string:
+			if t.FlapHalfLife, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.FlapHalfLife = time.Duration(v)
+		}
+	}
+	if aux.FlapMaxSuppress != nil {
+		switch v := aux.FlapMaxSuppress.(type) {
+		case string:
+			if t.FlapMaxSuppress, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			t.FlapMaxSuppress = time.Duration(v)
+		}
+	}
 	if (aux.H2PING != "" && !aux.H2PingUseTLSSnake) || (aux.H2PING == "" && aux.H2PingUseTLSSnake) {
 		t.H2PingUseTLS = aux.H2PingUseTLSSnake
 	}
@@ -189,11 +305,18 @@ func (t *CheckType) UnmarshalJSON(data []byte) (err error) {
 
 // Validate returns an error message if the check is invalid
 func (c *CheckType) Validate() error {
-	intervalCheck := c.IsScript() || c.HTTP != "" || c.TCP != "" || c.UDP != "" || c.GRPC != "" || c.H2PING != "" || c.OSService != ""
+	thresholdCheck := c.CertExpiry != "" || c.DNS != "" || c.FileFreshness != ""
+	intervalCheck := c.IsScript() || c.HTTP != "" || c.TCP != "" || c.UDP != "" || c.GRPC != "" || c.H2PING != "" || c.OSService != "" || c.Outlier != "" || thresholdCheck
 
 	if c.Interval > 0 && c.TTL > 0 {
 		return fmt.Errorf("Interval and TTL cannot both be specified")
 	}
+	if thresholdCheck && c.Interval <= 0 {
+		return fmt.Errorf("Interval must be > 0 for CertExpiry, DNS or FileFreshness checks")
+	}
+	if c.Outlier != "" && c.Interval <= 0 {
+		return fmt.Errorf("Interval must be > 0 for Outlier checks")
+	}
 	if intervalCheck && c.Interval <= 0 {
 		return fmt.Errorf("Interval must be > 0 for Script, HTTP, H2PING, TCP, UDP or OSService checks")
 	}
@@ -203,7 +326,23 @@ func (c *CheckType) Validate() error {
 	if c.IsAlias() && c.TTL > 0 {
 		return fmt.Errorf("TTL must be not be set for Alias checks")
 	}
-	if !intervalCheck && !c.IsAlias() && c.TTL <= 0 {
+	if c.IsComposite() {
+		if intervalCheck {
+			return fmt.Errorf("Interval cannot be set for Composite checks")
+		}
+		if c.TTL > 0 {
+			return fmt.Errorf("TTL must be not be set for Composite checks")
+		}
+		if c.IsAlias() {
+			return fmt.Errorf("AliasNode and AliasService cannot be set for Composite checks")
+		}
+		if err := c.validateComposite(); err != nil {
+			return err
+		}
+	} else if c.CompositeMode != "" || c.CompositeQuorum != 0 {
+		return fmt.Errorf("CompositeMode and CompositeQuorum can only be set for Composite checks")
+	}
+	if !intervalCheck && !c.IsAlias() && !c.IsComposite() && c.TTL <= 0 {
 		return fmt.Errorf("TTL must be > 0 for TTL checks")
 	}
 	if c.OutputMaxSize < 0 {
@@ -212,10 +351,283 @@ func (c *CheckType) Validate() error {
 	if c.FailuresBeforeWarning > c.FailuresBeforeCritical {
 		return fmt.Errorf("FailuresBeforeWarning can't be higher than FailuresBeforeCritical")
 	}
+	if c.WarningThreshold < 0 || c.CriticalThreshold < 0 {
+		return fmt.Errorf("WarningThreshold and CriticalThreshold must not be negative")
+	}
+	if !thresholdCheck && (c.WarningThreshold > 0 || c.CriticalThreshold > 0) {
+		return fmt.Errorf("WarningThreshold and CriticalThreshold can only be set for CertExpiry, DNS or FileFreshness checks")
+	}
+	if c.WarningThreshold > 0 && c.CriticalThreshold > 0 {
+		// A certificate gets closer to its expiry over time, while the DNS
+		// latency and the file age must stay below their thresholds.
+		if c.CertExpiry != "" && c.WarningThreshold < c.CriticalThreshold {
+			return fmt.Errorf("WarningThreshold can't be lower than CriticalThreshold for CertExpiry checks")
+		}
+		if c.CertExpiry == "" && c.WarningThreshold > c.CriticalThreshold {
+			return fmt.Errorf("WarningThreshold can't be higher than CriticalThreshold")
+		}
+	}
+	if c.DNSServer != "" && c.DNS == "" {
+		return fmt.Errorf("DNSServer can only be set for DNS checks")
+	}
+	if err := c.validateFlapDampening(intervalCheck); err != nil {
+		return err
+	}
+	if len(c.ResponseAssertions) > 0 && c.HTTP == "" {
+		return fmt.Errorf("ResponseAssertions can only be set for HTTP checks")
+	}
+	for i, assertion := range c.ResponseAssertions {
+		if err := assertion.Validate(); err != nil {
+			return fmt.Errorf("ResponseAssertions[%d]: %v", i, err)
+		}
+	}
 
 	return nil
 }
 
+func (c *CheckType) validateFlapDampening(intervalCheck bool) error {
+	if c.FlapHalfLife < 0 || c.FlapMaxSuppress < 0 || c.FlapSuppressThreshold < 0 || c.FlapReuseThreshold < 0 {
+		return fmt.Errorf("FlapHalfLife, FlapMaxSuppress, FlapSuppressThreshold and FlapReuseThreshold must not be negative")
+	}
+	if c.FlapHalfLife == 0 {
+		if c.FlapMaxSuppress > 0 || c.FlapSuppressThreshold > 0 || c.FlapReuseThreshold > 0 {
+			return fmt.Errorf("FlapMaxSuppress, FlapSuppressThreshold and FlapReuseThreshold can only be set with FlapHalfLife")
+		}
+		return nil
+	}
+	if !intervalCheck {
+		return fmt.Errorf("FlapHalfLife can only be set for checks with an Interval")
+	}
+
+	suppress, reuse, maxSuppress := c.FlapDampening()
+	if reuse >= suppress {
+		return fmt.Errorf("FlapReuseThreshold must be lower than FlapSuppressThreshold")
+	}
+	// The penalty is capped so that it decays below the reuse threshold
+	// within maxSuppress, which must leave room to reach the suppress one.
+	if float64(reuse)*math.Exp2(float64(maxSuppress)/float64(c.FlapHalfLife)) < float64(suppress) {
+		return fmt.Errorf("FlapMaxSuppress is too short for the penalty to reach FlapSuppressThreshold")
+	}
+	return nil
+}
+
+// Defaults for the flap dampening of checks with a FlapHalfLife.
+const (
+	DefaultFlapSuppressThreshold = 3
+	DefaultFlapReuseThreshold    = 1
+	DefaultFlapMaxSuppressFactor = 4 // FlapMaxSuppress in multiples of FlapHalfLife
+)
+
+// FlapDampening returns the suppress and reuse thresholds and the maximum
+// suppression time of the check, with their defaults applied.
+func (c *CheckType) FlapDampening() (suppress, reuse int, maxSuppress time.Duration) {
+	suppress, reuse, maxSuppress = c.FlapSuppressThreshold, c.FlapReuseThreshold, c.FlapMaxSuppress
+	if suppress == 0 {
+		suppress = DefaultFlapSuppressThreshold
+	}
+	if reuse == 0 {
+		reuse = DefaultFlapReuseThreshold
+	}
+	if maxSuppress == 0 {
+		maxSuppress = DefaultFlapMaxSuppressFactor * c.FlapHalfLife
+	}
+	return suppress, reuse, maxSuppress
+}
+
+// CheckFlapState is the flap dampening state of a check with a FlapHalfLife.
+// It is kept by the agent running the check and is not synced to the
+// servers.
+type CheckFlapState struct {
+	// Flapping is true while the check is held in the warning state.
+	Flapping bool
+
+	// Penalty is the current penalty of the check. Every status transition
+	// adds 1 to it and it halves every FlapHalfLife.
+	Penalty float64
+
+	// Transitions is the number of status transitions of the check within
+	// the last FlapMaxSuppress.
+	Transitions int
+
+	// Status is the last status reported by the check itself, which is
+	// hidden while the check is flapping.
+	Status string
+}
+
+func (c *CheckType) validateComposite() error {
+	total := 0
+	for i, m := range c.CompositeMembers {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("CompositeMembers[%d]: %v", i, err)
+		}
+		if m.Node == "" && m.CheckID != "" && m.CheckID == c.CheckID {
+			return fmt.Errorf("CompositeMembers[%d]: a Composite check cannot include itself", i)
+		}
+		total += m.weight()
+	}
+
+	switch c.CompositeMode {
+	case "", CompositeModeAll, CompositeModeAny:
+		if c.CompositeQuorum != 0 {
+			return fmt.Errorf("CompositeQuorum can only be set when CompositeMode is %q", CompositeModeQuorum)
+		}
+	case CompositeModeQuorum:
+		if c.CompositeQuorum < 1 || c.CompositeQuorum > total {
+			return fmt.Errorf("CompositeQuorum must be between 1 and the total weight of the members, %d", total)
+		}
+	default:
+		return fmt.Errorf("CompositeMode must be one of %q, %q or %q", CompositeModeAll, CompositeModeAny, CompositeModeQuorum)
+	}
+	return nil
+}
+
+const (
+	// CompositeModeAll makes a composite check passing when all of its
+	// members are passing. It is the default.
+	CompositeModeAll = "all"
+
+	// CompositeModeAny makes a composite check passing when any of its
+	// members is passing.
+	CompositeModeAny = "any"
+
+	// CompositeModeQuorum makes a composite check passing when the weight of
+	// its passing members reaches the quorum.
+	CompositeModeQuorum = "quorum"
+)
+
+// CompositeCheckMember selects the checks that a member of a composite check
+// aggregates: a single check by CheckID, the instances of a service by
+// ServiceID or ServiceName, or the node-level checks when none of them is
+// set. Node is the node of the checks, the local agent when empty. Weight is
+// what the member counts for in a quorum, 1 when zero.
+type CompositeCheckMember struct {
+	Node        string        `json:",omitempty"`
+	CheckID     types.CheckID `json:",omitempty"`
+	ServiceID   string        `json:",omitempty"`
+	ServiceName string        `json:",omitempty"`
+	Weight      int           `json:",omitempty"`
+}
+
+func (m *CompositeCheckMember) UnmarshalJSON(data []byte) error {
+	type Alias CompositeCheckMember
+	aux := &struct {
+		CheckIDSnake     types.CheckID `json:"check_id"`
+		ServiceIDSnake   string        `json:"service_id"`
+		ServiceNameSnake string        `json:"service_name"`
+
+		*Alias
+	}{
+		Alias: (*Alias)(m),
+	}
+	if err := lib.UnmarshalJSON(data, aux); err != nil {
+		return err
+	}
+	if m.CheckID == "" {
+		m.CheckID = aux.CheckIDSnake
+	}
+	if m.ServiceID == "" {
+		m.ServiceID = aux.ServiceIDSnake
+	}
+	if m.ServiceName == "" {
+		m.ServiceName = aux.ServiceNameSnake
+	}
+	return nil
+}
+
+// Validate returns an error message if the member is invalid
+func (m *CompositeCheckMember) Validate() error {
+	var selectors int
+	for _, s := range []string{string(m.CheckID), m.ServiceID, m.ServiceName} {
+		if s != "" {
+			selectors++
+		}
+	}
+	if selectors > 1 {
+		return fmt.Errorf("only one of CheckID, ServiceID or ServiceName can be set")
+	}
+	if selectors == 0 && m.Node == "" {
+		return fmt.Errorf("one of Node, CheckID, ServiceID or ServiceName must be set")
+	}
+	if m.Weight < 0 {
+		return fmt.Errorf("Weight must not be negative")
+	}
+	return nil
+}
+
+func (m *CompositeCheckMember) weight() int {
+	if m.Weight == 0 {
+		return 1
+	}
+	return m.Weight
+}
+
+// HTTPResponseAssertion sets the status of an HTTP check when the response
+// matches. Exactly one of BodyRegex, JSONPath or Header is set. Match is a
+// regular expression for the values selected by JSONPath or Header, which
+// match if any value is found when it is empty.
+type HTTPResponseAssertion struct {
+	BodyRegex string `json:",omitempty"`
+	JSONPath  string `json:",omitempty"`
+	Header    string `json:",omitempty"`
+	Match     string `json:",omitempty"`
+	Status    string
+}
+
+func (a *HTTPResponseAssertion) UnmarshalJSON(data []byte) error {
+	type Alias HTTPResponseAssertion
+	aux := &struct {
+		BodyRegexSnake string `json:"body_regex"`
+		JSONPathSnake  string `json:"json_path"`
+
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := lib.UnmarshalJSON(data, aux); err != nil {
+		return err
+	}
+	if a.BodyRegex == "" {
+		a.BodyRegex = aux.BodyRegexSnake
+	}
+	if a.JSONPath == "" {
+		a.JSONPath = aux.JSONPathSnake
+	}
+	return nil
+}
+
+// Validate returns an error message if the assertion is invalid
+func (a *HTTPResponseAssertion) Validate() error {
+	var sources int
+	for _, s := range []string{a.BodyRegex, a.JSONPath, a.Header} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of BodyRegex, JSONPath or Header must be set")
+	}
+	if a.BodyRegex != "" {
+		if a.Match != "" {
+			return fmt.Errorf("Match cannot be set with BodyRegex")
+		}
+		if _, err := regexp.Compile(a.BodyRegex); err != nil {
+			return fmt.Errorf("invalid BodyRegex: %v", err)
+		}
+	}
+	if a.JSONPath != "" {
+		if _, err := jsonpath.Parse(a.JSONPath); err != nil {
+			return fmt.Errorf("invalid JSONPath: %v", err)
+		}
+	}
+	if _, err := regexp.Compile(a.Match); err != nil {
+		return fmt.Errorf("invalid Match: %v", err)
+	}
+	if !ValidStatus(a.Status) {
+		return fmt.Errorf("Status must be one of passing, warning or critical")
+	}
+	return nil
+}
+
 // Empty checks if the CheckType has no fields defined. Empty checks parsed from json configs are filtered out
 func (c *CheckType) Empty() bool {
 	return reflect.DeepEqual(c, &CheckType{})
@@ -226,6 +638,11 @@ func (c *CheckType) IsAlias() bool {
 	return c.AliasNode != "" || c.AliasService != ""
 }
 
+// IsComposite checks if this is a composite check.
+func (c *CheckType) IsComposite() bool {
+	return len(c.CompositeMembers) > 0
+}
+
 // IsScript checks if this is a check that execs some kind of script.
 func (c *CheckType) IsScript() bool {
 	return len(c.ScriptArgs) > 0
@@ -275,6 +692,26 @@ func (c *CheckType) IsOSService() bool {
 	return c.OSService != "" && c.Interval > 0
 }
 
+// IsCertExpiry checks if this is a CertExpiry type
+func (c *CheckType) IsCertExpiry() bool {
+	return c.CertExpiry != "" && c.Interval > 0
+}
+
+// IsDNS checks if this is a DNS type
+func (c *CheckType) IsDNS() bool {
+	return c.DNS != "" && c.Interval > 0
+}
+
+// IsFileFreshness checks if this is a FileFreshness type
+func (c *CheckType) IsFileFreshness() bool {
+	return c.FileFreshness != "" && c.Interval > 0
+}
+
+// IsOutlier checks if this is an Outlier type
+func (c *CheckType) IsOutlier() bool {
+	return c.Outlier != "" && c.Interval > 0
+}
+
 func (c *CheckType) Type() string {
 	switch {
 	case c.IsGRPC():
@@ -289,6 +726,8 @@ func (c *CheckType) Type() string {
 		return "udp"
 	case c.IsAlias():
 		return "alias"
+	case c.IsComposite():
+		return "composite"
 	case c.IsDocker():
 		return "docker"
 	case c.IsScript():
@@ -297,6 +736,14 @@ func (c *CheckType) Type() string {
 		return "h2ping"
 	case c.IsOSService():
 		return "os_service"
+	case c.IsCertExpiry():
+		return "cert_expiry"
+	case c.IsDNS():
+		return "dns"
+	case c.IsFileFreshness():
+		return "file_freshness"
+	case c.IsOutlier():
+		return "outlier"
 	default:
 		return ""
 	}
@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestCheckType_Validate_ResponseAssertions(t *testing.T) {
+	cases := map[string]struct {
+		check    CheckType
+		expected string
+	}{
+		"valid": {
+			check: CheckType{
+				HTTP:     "http://localhost:8080/health",
+				Interval: time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{
+					{BodyRegex: "degraded", Status: api.HealthWarning},
+					{JSONPath: "$.checks[*].ok", Match: "false", Status: api.HealthCritical},
+					{Header: "X-Maintenance", Status: api.HealthWarning},
+				},
+			},
+		},
+		"not an HTTP check": {
+			check: CheckType{
+				TCP:                "localhost:8080",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{BodyRegex: "ok", Status: api.HealthPassing}},
+			},
+			expected: "ResponseAssertions can only be set for HTTP checks",
+		},
+		"no source": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{Match: "ok", Status: api.HealthPassing}},
+			},
+			expected: "ResponseAssertions[0]: exactly one of BodyRegex, JSONPath or Header must be set",
+		},
+		"two sources": {
+			check: CheckType{
+				HTTP:     "http://localhost:8080/health",
+				Interval: time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{
+					{BodyRegex: "ok", Status: api.HealthPassing},
+					{BodyRegex: "ok", Header: "X-Status", Status: api.HealthPassing},
+				},
+			},
+			expected: "ResponseAssertions[1]: exactly one of BodyRegex, JSONPath or Header must be set",
+		},
+		"match with body regex": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{BodyRegex: "ok", Match: "ok", Status: api.HealthPassing}},
+			},
+			expected: "Match cannot be set with BodyRegex",
+		},
+		"invalid regex": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{BodyRegex: "(", Status: api.HealthPassing}},
+			},
+			expected: "invalid BodyRegex",
+		},
+		"invalid JSONPath": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{JSONPath: "status", Status: api.HealthPassing}},
+			},
+			expected: "invalid JSONPath: path must start with '$'",
+		},
+		"invalid match": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{Header: "X-Status", Match: "[", Status: api.HealthPassing}},
+			},
+			expected: "invalid Match",
+		},
+		"invalid status": {
+			check: CheckType{
+				HTTP:               "http://localhost:8080/health",
+				Interval:           time.Second,
+				ResponseAssertions: []HTTPResponseAssertion{{Header: "X-Status", Status: "degraded"}},
+			},
+			expected: "Status must be one of passing, warning or critical",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.check.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCheckType_Validate_Thresholds(t *testing.T) {
+	day := 24 * time.Hour
+	cases := map[string]struct {
+		check    CheckType
+		expected string
+	}{
+		"cert expiry": {
+			check: CheckType{CertExpiry: "localhost:443", Interval: time.Minute, WarningThreshold: 30 * day, CriticalThreshold: 7 * day},
+		},
+		"dns": {
+			check: CheckType{DNS: "example.com", DNSServer: "127.0.0.1:8600", Interval: time.Minute, WarningThreshold: time.Second},
+		},
+		"file freshness": {
+			check: CheckType{FileFreshness: "/tmp/last-run", Interval: time.Minute, WarningThreshold: time.Hour, CriticalThreshold: 2 * time.Hour},
+		},
+		"file freshness without thresholds": {
+			check: CheckType{FileFreshness: "/tmp/last-run", Interval: time.Minute},
+		},
+		"no interval": {
+			check:    CheckType{DNS: "example.com"},
+			expected: "Interval must be > 0",
+		},
+		"negative": {
+			check:    CheckType{DNS: "example.com", Interval: time.Minute, CriticalThreshold: -time.Second},
+			expected: "WarningThreshold and CriticalThreshold must not be negative",
+		},
+		"not a threshold check": {
+			check:    CheckType{TCP: "localhost:80", Interval: time.Minute, WarningThreshold: time.Second},
+			expected: "WarningThreshold and CriticalThreshold can only be set for CertExpiry, DNS or FileFreshness checks",
+		},
+		"cert expiry warning below critical": {
+			check:    CheckType{CertExpiry: "localhost:443", Interval: time.Minute, WarningThreshold: 7 * day, CriticalThreshold: 30 * day},
+			expected: "WarningThreshold can't be lower than CriticalThreshold for CertExpiry checks",
+		},
+		"file freshness warning above critical": {
+			check:    CheckType{FileFreshness: "/tmp/last-run", Interval: time.Minute, WarningThreshold: 2 * time.Hour, CriticalThreshold: time.Hour},
+			expected: "WarningThreshold can't be higher than CriticalThreshold",
+		},
+		"dns server without dns": {
+			check:    CheckType{FileFreshness: "/tmp/last-run", DNSServer: "127.0.0.1", Interval: time.Minute},
+			expected: "DNSServer can only be set for DNS checks",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.check.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCheckType_Type_Thresholds(t *testing.T) {
+	require.Equal(t, "cert_expiry", (&CheckType{CertExpiry: "localhost:443", Interval: time.Minute}).Type())
+	require.Equal(t, "dns", (&CheckType{DNS: "example.com", Interval: time.Minute}).Type())
+	require.Equal(t, "file_freshness", (&CheckType{FileFreshness: "/tmp/last-run", Interval: time.Minute}).Type())
+}
+
+func TestCheckType_Outlier(t *testing.T) {
+	chk := &CheckType{Outlier: "127.0.0.1:19000", Interval: 10 * time.Second}
+	require.NoError(t, chk.Validate())
+	require.Equal(t, "outlier", chk.Type())
+
+	chk = &CheckType{Outlier: "127.0.0.1:19000"}
+	require.EqualError(t, chk.Validate(), "Interval must be > 0 for Outlier checks")
+}
+
+func TestCheckDefinition_UnmarshalJSON_Thresholds(t *testing.T) {
+	for name, data := range map[string]string{
+		"camel case": `{
+			"CertExpiry": "localhost:443",
+			"WarningThreshold": "720h",
+			"CriticalThreshold": "168h"
+		}`,
+		"snake case": `{
+			"cert_expiry": "localhost:443",
+			"warning_threshold": "720h",
+			"critical_threshold": "168h"
+		}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var def CheckDefinition
+			require.NoError(t, json.Unmarshal([]byte(data), &def))
+			require.Equal(t, "localhost:443", def.CertExpiry)
+			require.Equal(t, 720*time.Hour, def.WarningThreshold)
+			require.Equal(t, 168*time.Hour, def.CriticalThreshold)
+
+			var chkType CheckType
+			require.NoError(t, json.Unmarshal([]byte(data), &chkType))
+			require.Equal(t, def.CheckType().CertExpiry, chkType.CertExpiry)
+			require.Equal(t, def.CheckType().WarningThreshold, chkType.WarningThreshold)
+			require.Equal(t, def.CheckType().CriticalThreshold, chkType.CriticalThreshold)
+		})
+	}
+
+	var def CheckDefinition
+	require.NoError(t, json.Unmarshal([]byte(`{"dns": "example.com", "dns_server": "127.0.0.1"}`), &def))
+	require.Equal(t, "example.com", def.DNS)
+	require.Equal(t, "127.0.0.1", def.DNSServer)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"file_freshness": "/tmp/last-run"}`), &def))
+	require.Equal(t, "/tmp/last-run", def.FileFreshness)
+}
+
+func TestCheckType_UnmarshalJSON_ResponseAssertions(t *testing.T) {
+	expected := []HTTPResponseAssertion{
+		{JSONPath: "$.status", Match: "degraded", Status: api.HealthWarning},
+		{BodyRegex: "maintenance", Status: api.HealthCritical},
+	}
+
+	for name, data := range map[string]string{
+		"camel case": `{
+			"ResponseAssertions": [
+				{"JSONPath": "$.status", "Match": "degraded", "Status": "warning"},
+				{"BodyRegex": "maintenance", "Status": "critical"}
+			]
+		}`,
+		"snake case": `{
+			"response_assertions": [
+				{"json_path": "$.status", "match": "degraded", "status": "warning"},
+				{"body_regex": "maintenance", "status": "critical"}
+			]
+		}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var chkType CheckType
+			require.NoError(t, json.Unmarshal([]byte(data), &chkType))
+			require.Equal(t, expected, chkType.ResponseAssertions)
+
+			var def CheckDefinition
+			require.NoError(t, json.Unmarshal([]byte(data), &def))
+			require.Equal(t, expected, def.ResponseAssertions)
+		})
+	}
+}
+
+func TestCheckType_Validate_Composite(t *testing.T) {
+	cases := map[string]struct {
+		check    CheckType
+		expected string
+	}{
+		"all": {
+			check: CheckType{CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}, {Node: "db-1", ServiceName: "db"}}},
+		},
+		"any": {
+			check: CheckType{CompositeMode: CompositeModeAny, CompositeMembers: []CompositeCheckMember{{CheckID: "mem"}, {Node: "db-1"}}},
+		},
+		"quorum": {
+			check: CheckType{
+				CompositeMode:    CompositeModeQuorum,
+				CompositeQuorum:  3,
+				CompositeMembers: []CompositeCheckMember{{ServiceID: "web-1", Weight: 2}, {ServiceID: "web-2"}},
+			},
+		},
+		"interval": {
+			check:    CheckType{Interval: time.Second, TCP: "localhost:80", CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: "Interval cannot be set for Composite checks",
+		},
+		"ttl": {
+			check:    CheckType{TTL: time.Second, CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: "TTL must be not be set for Composite checks",
+		},
+		"alias": {
+			check:    CheckType{AliasService: "web", CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: "AliasNode and AliasService cannot be set for Composite checks",
+		},
+		"unknown mode": {
+			check:    CheckType{CompositeMode: "most", CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: `CompositeMode must be one of "all", "any" or "quorum"`,
+		},
+		"quorum without quorum mode": {
+			check:    CheckType{CompositeQuorum: 1, CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: `CompositeQuorum can only be set when CompositeMode is "quorum"`,
+		},
+		"quorum above total weight": {
+			check: CheckType{
+				CompositeMode:    CompositeModeQuorum,
+				CompositeQuorum:  4,
+				CompositeMembers: []CompositeCheckMember{{ServiceID: "web-1", Weight: 2}, {ServiceID: "web-2"}},
+			},
+			expected: "CompositeQuorum must be between 1 and the total weight of the members, 3",
+		},
+		"missing quorum": {
+			check:    CheckType{CompositeMode: CompositeModeQuorum, CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}}},
+			expected: "CompositeQuorum must be between 1",
+		},
+		"mode without members": {
+			check:    CheckType{TTL: time.Second, CompositeMode: CompositeModeAny},
+			expected: "CompositeMode and CompositeQuorum can only be set for Composite checks",
+		},
+		"member with several selectors": {
+			check:    CheckType{CompositeMembers: []CompositeCheckMember{{ServiceID: "web"}, {ServiceID: "db", CheckID: "mem"}}},
+			expected: "CompositeMembers[1]: only one of CheckID, ServiceID or ServiceName can be set",
+		},
+		"empty member": {
+			check:    CheckType{CompositeMembers: []CompositeCheckMember{{}}},
+			expected: "CompositeMembers[0]: one of Node, CheckID, ServiceID or ServiceName must be set",
+		},
+		"negative weight": {
+			check:    CheckType{CompositeMembers: []CompositeCheckMember{{ServiceID: "web", Weight: -1}}},
+			expected: "CompositeMembers[0]: Weight must not be negative",
+		},
+		"itself": {
+			check:    CheckType{CheckID: "group", CompositeMembers: []CompositeCheckMember{{CheckID: "group"}}},
+			expected: "CompositeMembers[0]: a Composite check cannot include itself",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.check.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+				require.Equal(t, "composite", tc.check.Type())
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCheckDefinition_UnmarshalJSON_Composite(t *testing.T) {
+	expected := []CompositeCheckMember{
+		{ServiceID: "web"},
+		{Node: "db-1", ServiceName: "db", Weight: 2},
+		{CheckID: "mem"},
+	}
+	for name, data := range map[string]string{
+		"camel case": `{
+			"CompositeMode": "quorum",
+			"CompositeQuorum": 2,
+			"CompositeMembers": [
+				{"ServiceID": "web"},
+				{"Node": "db-1", "ServiceName": "db", "Weight": 2},
+				{"CheckID": "mem"}
+			]
+		}`,
+		"snake case": `{
+			"composite_mode": "quorum",
+			"composite_quorum": 2,
+			"composite_members": [
+				{"service_id": "web"},
+				{"node": "db-1", "service_name": "db", "weight": 2},
+				{"check_id": "mem"}
+			]
+		}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var def CheckDefinition
+			require.NoError(t, json.Unmarshal([]byte(data), &def))
+			require.Equal(t, CompositeModeQuorum, def.CompositeMode)
+			require.Equal(t, 2, def.CompositeQuorum)
+			require.Equal(t, expected, def.CompositeMembers)
+
+			var chkType CheckType
+			require.NoError(t, json.Unmarshal([]byte(data), &chkType))
+			require.Equal(t, def.CheckType().CompositeMode, chkType.CompositeMode)
+			require.Equal(t, def.CheckType().CompositeQuorum, chkType.CompositeQuorum)
+			require.Equal(t, def.CheckType().CompositeMembers, chkType.CompositeMembers)
+		})
+	}
+}
+
+func TestCheckType_Validate_FlapDampening(t *testing.T) {
+	cases := map[string]struct {
+		check    CheckType
+		expected string
+	}{
+		"defaults": {
+			check: CheckType{HTTP: "http://localhost", Interval: time.Second, FlapHalfLife: time.Minute},
+		},
+		"custom": {
+			check: CheckType{
+				TCP:                   "localhost:80",
+				Interval:              time.Second,
+				FlapHalfLife:          time.Minute,
+				FlapMaxSuppress:       10 * time.Minute,
+				FlapSuppressThreshold: 5,
+				FlapReuseThreshold:    2,
+			},
+		},
+		"negative": {
+			check:    CheckType{HTTP: "http://localhost", Interval: time.Second, FlapHalfLife: -time.Minute},
+			expected: "FlapHalfLife, FlapMaxSuppress, FlapSuppressThreshold and FlapReuseThreshold must not be negative",
+		},
+		"without half-life": {
+			check:    CheckType{HTTP: "http://localhost", Interval: time.Second, FlapSuppressThreshold: 5},
+			expected: "FlapMaxSuppress, FlapSuppressThreshold and FlapReuseThreshold can only be set with FlapHalfLife",
+		},
+		"ttl": {
+			check:    CheckType{TTL: time.Second, FlapHalfLife: time.Minute},
+			expected: "FlapHalfLife can only be set for checks with an Interval",
+		},
+		"reuse above suppress": {
+			check:    CheckType{HTTP: "http://localhost", Interval: time.Second, FlapHalfLife: time.Minute, FlapReuseThreshold: 3},
+			expected: "FlapReuseThreshold must be lower than FlapSuppressThreshold",
+		},
+		"max suppress too short": {
+			check:    CheckType{HTTP: "http://localhost", Interval: time.Second, FlapHalfLife: time.Minute, FlapMaxSuppress: time.Minute},
+			expected: "FlapMaxSuppress is too short for the penalty to reach FlapSuppressThreshold",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.check.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCheckType_FlapDampening(t *testing.T) {
+	suppress, reuse, maxSuppress := (&CheckType{FlapHalfLife: time.Minute}).FlapDampening()
+	require.Equal(t, DefaultFlapSuppressThreshold, suppress)
+	require.Equal(t, DefaultFlapReuseThreshold, reuse)
+	require.Equal(t, 4*time.Minute, maxSuppress)
+
+	chk := &CheckType{FlapHalfLife: time.Minute, FlapMaxSuppress: time.Hour, FlapSuppressThreshold: 5, FlapReuseThreshold: 2}
+	suppress, reuse, maxSuppress = chk.FlapDampening()
+	require.Equal(t, 5, suppress)
+	require.Equal(t, 2, reuse)
+	require.Equal(t, time.Hour, maxSuppress)
+}
+
+func TestCheckDefinition_UnmarshalJSON_FlapDampening(t *testing.T) {
+	for name, data := range map[string]string{
+		"camel case": `{
+			"FlapHalfLife": "5m",
+			"FlapMaxSuppress": "30m",
+			"FlapSuppressThreshold": 4,
+			"FlapReuseThreshold": 2
+		}`,
+		"snake case": `{
+			"flap_half_life": "5m",
+			"flap_max_suppress": "30m",
+			"flap_suppress_threshold": 4,
+			"flap_reuse_threshold": 2
+		}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var def CheckDefinition
+			require.NoError(t, json.Unmarshal([]byte(data), &def))
+			require.Equal(t, 5*time.Minute, def.FlapHalfLife)
+			require.Equal(t, 30*time.Minute, def.FlapMaxSuppress)
+			require.Equal(t, 4, def.FlapSuppressThreshold)
+			require.Equal(t, 2, def.FlapReuseThreshold)
+
+			var chkType CheckType
+			require.NoError(t, json.Unmarshal([]byte(data), &chkType))
+			require.Equal(t, def.CheckType().FlapHalfLife, chkType.FlapHalfLife)
+			require.Equal(t, def.CheckType().FlapMaxSuppress, chkType.FlapMaxSuppress)
+			require.Equal(t, def.CheckType().FlapSuppressThreshold, chkType.FlapSuppressThreshold)
+			require.Equal(t, def.CheckType().FlapReuseThreshold, chkType.FlapReuseThreshold)
+		})
+	}
+}
@@ -178,6 +178,7 @@ type ServiceConfigEntry struct {
 	BalanceInboundConnections string                 `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits            `json:",omitempty" alias:"rate_limits"`
 	EnvoyExtensions           EnvoyExtensions        `json:",omitempty" alias:"envoy_extensions"`
+	OutlierHealthCheck        *OutlierHealthCheck    `json:",omitempty" alias:"outlier_health_check"`
 
 	Meta               map[string]string `json:",omitempty"`
 	Hash               uint64            `json:",omitempty" hash:"ignore"`
@@ -197,6 +198,10 @@ func (e *ServiceConfigEntry) Clone() *ServiceConfigEntry {
 	e2 := *e
 	e2.Expose = e.Expose.Clone()
 	e2.UpstreamConfig = e.UpstreamConfig.Clone()
+	if e.OutlierHealthCheck != nil {
+		ohc := *e.OutlierHealthCheck
+		e2.OutlierHealthCheck = &ohc
+	}
 	return &e2
 }
 
@@ -322,6 +327,10 @@ func (e *ServiceConfigEntry) Validate() error {
 		validationErr = multierror.Append(validationErr, err)
 	}
 
+	if e.OutlierHealthCheck != nil && e.OutlierHealthCheck.MinProxies < 0 {
+		validationErr = multierror.Append(validationErr, fmt.Errorf("OutlierHealthCheck.MinProxies must not be negative"))
+	}
+
 	if err := envoyextensions.ValidateExtensions(e.EnvoyExtensions.ToAPI()); err != nil {
 		validationErr = multierror.Append(validationErr, err)
 	}
@@ -423,6 +432,16 @@ func IsIP(address string) bool {
 	return ip != nil
 }
 
+// OutlierHealthCheck opts the instances of a service into the outlier health
+// checks of their sidecar proxies. Such a check is warning while at least
+// MinProxies other proxies report that their outlier detection ejected the
+// instance.
+type OutlierHealthCheck struct {
+	// MinProxies is the number of proxies that must report the instance
+	// ejected, 1 when zero.
+	MinProxies int `json:",omitempty" alias:"min_proxies"`
+}
+
 // RateLimits is rate limiting configuration that is applied to
 // inbound traffic for a service.
 // Rate limiting is a Consul enterprise feature.
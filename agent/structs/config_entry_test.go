@@ -286,6 +286,30 @@ func TestDecodeConfigEntry_ServiceDefaults(t *testing.T) {
 				MaxInboundConnections: 14,
 			},
 		},
+		{
+			name: "service-defaults-with-OutlierHealthCheck",
+			snake: `
+				kind = "service-defaults"
+				name = "api"
+				outlier_health_check {
+					min_proxies = 2
+				}
+			`,
+			camel: `
+				Kind = "service-defaults"
+				Name = "api"
+				OutlierHealthCheck {
+					MinProxies = 2
+				}
+			`,
+			expect: &ServiceConfigEntry{
+				Kind: "service-defaults",
+				Name: "api",
+				OutlierHealthCheck: &OutlierHealthCheck{
+					MinProxies: 2,
+				},
+			},
+		},
 	} {
 		tc := tc
 
@@ -3121,6 +3145,16 @@ func TestServiceConfigEntry(t *testing.T) {
 			},
 			validateErr: "Invalid Port number",
 		},
+		"validate: negative outlier health check min proxies": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "api",
+				OutlierHealthCheck: &OutlierHealthCheck{
+					MinProxies: -1,
+				},
+			},
+			validateErr: "OutlierHealthCheck.MinProxies must not be negative",
+		},
 		"validate: invalid hostname 1": {
 			entry: &ServiceConfigEntry{
 				Kind:     ServiceDefaults,
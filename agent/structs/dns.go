@@ -25,3 +25,24 @@ func (s RecursorStrategy) Indexes(max int) []int {
 
 	}
 }
+
+// DNSAnswerOrdering is the policy used to order the answers of a DNS service
+// lookup.
+type DNSAnswerOrdering string
+
+const (
+	// DNSAnswerOrderingRandom shuffles the answers.
+	DNSAnswerOrderingRandom DNSAnswerOrdering = "random"
+
+	// DNSAnswerOrderingWeighted shuffles the answers, favoring instances
+	// with a higher weight for their current health status.
+	DNSAnswerOrderingWeighted DNSAnswerOrdering = "weighted"
+
+	// DNSAnswerOrderingNearest sorts the answers by estimated round trip
+	// time from the agent, using the network coordinates of the nodes.
+	DNSAnswerOrderingNearest DNSAnswerOrdering = "nearest"
+
+	// DNSAnswerOrderingConsistentHash answers every client with a stable
+	// subset of the instances chosen by hashing the client address.
+	DNSAnswerOrderingConsistentHash DNSAnswerOrdering = "consistent_hash"
+)
@@ -573,6 +573,12 @@ func (o *HealthCheck) DeepCopy() *HealthCheck {
 		cp.ServiceTags = make([]string, len(o.ServiceTags))
 		copy(cp.ServiceTags, o.ServiceTags)
 	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
 	if o.Definition.Header != nil {
 		cp.Definition.Header = make(map[string][]string, len(o.Definition.Header))
 		for k3, v3 := range o.Definition.Header {
@@ -965,6 +971,10 @@ func (o *ServiceConfigEntry) DeepCopy() *ServiceConfigEntry {
 			}
 		}
 	}
+	if o.OutlierHealthCheck != nil {
+		cp.OutlierHealthCheck = new(OutlierHealthCheck)
+		*cp.OutlierHealthCheck = *o.OutlierHealthCheck
+	}
 	if o.Meta != nil {
 		cp.Meta = make(map[string]string, len(o.Meta))
 		for k2, v2 := range o.Meta {
@@ -91,6 +91,7 @@ const (
 	RaftLogVerifierCheckpoint                   = 41 // Only used for log verifier, no-op on FSM.
 	ResourceOperationType                       = 42
 	UpdateVirtualIPRequestType                  = 43
+	KVSRevisionRequestType                      = 44
 )
 
 const (
@@ -160,6 +161,7 @@ var requestTypeStrings = map[MessageType]string{
 	RaftLogVerifierCheckpoint:       "RaftLogVerifierCheckpoint",
 	ResourceOperationType:           "Resource",
 	UpdateVirtualIPRequestType:      "UpdateManualVirtualIPRequestType",
+	KVSRevisionRequestType:          "KVSRevision",
 }
 
 const (
@@ -225,6 +227,15 @@ const (
 
 	// MetaConsulVersion is the node metadata key used to store the node's consul version
 	MetaConsulVersion = "consul-version"
+
+	// MetaCheckFlapping is the health check metadata key that the agent sets
+	// to "true" while it holds a flapping check in the warning state.
+	MetaCheckFlapping = "consul-flapping"
+
+	// MetaCheckOutlierEjected is the health check metadata key that an outlier
+	// check lists the upstream hosts ejected by its proxy in, as host:port
+	// separated by commas.
+	MetaCheckOutlierEjected = "consul-outlier-ejected"
 )
 
 var allowedConsulMetaKeysForMeshGateway = map[string]struct{}{MetaWANFederationKey: {}}
@@ -1835,6 +1846,9 @@ type HealthCheck struct {
 	// It is empty if the check was registered locally.
 	PeerName string `json:",omitempty"`
 
+	// Meta is set by the agent running the check, such as MetaCheckFlapping.
+	Meta map[string]string `json:",omitempty"`
+
 	Definition HealthCheckDefinition `bexpr:"-"`
 
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash" bexpr:"-"`
@@ -2017,6 +2031,7 @@ func (c *HealthCheck) IsSame(other *HealthCheck) bool {
 		!reflect.DeepEqual(c.ServiceTags, other.ServiceTags) ||
 		!reflect.DeepEqual(c.Definition, other.Definition) ||
 		c.PeerName != other.PeerName ||
+		!reflect.DeepEqual(c.Meta, other.Meta) ||
 		!c.EnterpriseMeta.IsSame(&other.EnterpriseMeta) {
 		return false
 	}
@@ -2667,6 +2682,18 @@ type DirEntry struct {
 	Value     []byte
 	Session   string `json:",omitempty"`
 
+	// TTL is the time to live of the entry, such as "30s". When it is set,
+	// the leader sets Expires as the entry is written and deletes the entry
+	// once it has expired.
+	TTL     string     `json:",omitempty"`
+	Expires *time.Time `json:",omitempty"`
+
+	// RevisionTime is the wall-time of the leader when it accepted the
+	// write or deletion of the entry. It is part of the Raft log so that all
+	// servers record the same time in the KV history, and it is not stored
+	// with the entry.
+	RevisionTime *time.Time `json:"-" codec:",omitempty" bexpr:"-"`
+
 	acl.EnterpriseMeta `bexpr:"-"`
 	RaftIndex
 }
@@ -2674,11 +2701,14 @@ type DirEntry struct {
 // Returns a clone of the given directory entry.
 func (d *DirEntry) Clone() *DirEntry {
 	return &DirEntry{
-		LockIndex: d.LockIndex,
-		Key:       d.Key,
-		Flags:     d.Flags,
-		Value:     d.Value,
-		Session:   d.Session,
+		LockIndex:    d.LockIndex,
+		Key:          d.Key,
+		Flags:        d.Flags,
+		Value:        d.Value,
+		Session:      d.Session,
+		TTL:          d.TTL,
+		Expires:      d.Expires,
+		RevisionTime: d.RevisionTime,
 		RaftIndex: RaftIndex{
 			CreateIndex: d.CreateIndex,
 			ModifyIndex: d.ModifyIndex,
@@ -2692,7 +2722,18 @@ func (d *DirEntry) Equal(o *DirEntry) bool {
 		d.Key == o.Key &&
 		d.Flags == o.Flags &&
 		bytes.Equal(d.Value, o.Value) &&
-		d.Session == o.Session
+		d.Session == o.Session &&
+		d.TTL == o.TTL &&
+		d.ExpirationTime().Equal(o.ExpirationTime())
+}
+
+// ExpirationTime returns the time after which the entry expires, or the zero
+// time if it doesn't expire.
+func (d *DirEntry) ExpirationTime() time.Time {
+	if d.Expires == nil {
+		return time.Time{}
+	}
+	return *d.Expires
 }
 
 // IDValue implements the state.singleValueID interface for indexing.
@@ -2749,11 +2790,60 @@ type IndexedKeyList struct {
 	QueryMeta
 }
 
+// KVSRevision is a value a KV entry had at some point. Revisions are recorded
+// as entries are written and deleted while KV history is enabled.
+type KVSRevision struct {
+	// DirEntry is the entry as it was written, with its ModifyIndex set to
+	// the index of the write. Only the key is set for deletions.
+	DirEntry
+
+	// Deleted is set if the entry was deleted at this revision.
+	Deleted bool `json:",omitempty"`
+
+	// Time is when the leader accepted the change, or the time of the
+	// previous revision for changes made by the servers themselves.
+	Time time.Time
+}
+
+type KVSRevisions []*KVSRevision
+
+type IndexedKVSRevisions struct {
+	Revisions KVSRevisions
+	QueryMeta
+}
+
+type KVSRevisionOp string
+
+const (
+	KVSRevisionReap KVSRevisionOp = "reap"
+)
+
+// KVSRevisionRequest is used by the leader to delete the KV revisions that
+// are older than the configured retention age.
+type KVSRevisionRequest struct {
+	Datacenter string
+	Op         KVSRevisionOp
+
+	// Revisions are the revisions to delete. Only their keys, enterprise
+	// metadata and modify indexes are used.
+	Revisions KVSRevisions
+	WriteRequest
+}
+
+func (r *KVSRevisionRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
 type SessionBehavior string
 
 const (
 	SessionKeysRelease SessionBehavior = "release"
 	SessionKeysDelete                  = "delete"
+
+	// SessionKeysTransfer hands the locks of an invalidated session to the
+	// first of its successors that still exists, keeping the contents of the
+	// keys. The locks are released if none of the successors exists.
+	SessionKeysTransfer SessionBehavior = "transfer"
 )
 
 const (
@@ -2775,6 +2865,11 @@ type Session struct {
 	NodeChecks    []string
 	ServiceChecks []ServiceCheck
 
+	// Successors is the ordered list of sessions that the locks held by this
+	// session are transferred to when it is invalidated. It is only used by
+	// the transfer behavior.
+	Successors []string `json:",omitempty"`
+
 	// Deprecated v1.7.0.
 	Checks []types.CheckID `json:",omitempty"`
 
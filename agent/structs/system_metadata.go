@@ -33,6 +33,7 @@ const (
 	SystemMetadataIntentionFormatLegacyValue   = "legacy"
 	SystemMetadataVirtualIPsEnabled            = "virtual-ips"
 	SystemMetadataTermGatewayVirtualIPsEnabled = "virtual-ips-term-gateway"
+	SystemMetadataKVHistoryRetainCount         = "kv-history-retain-count"
 )
 
 type SystemMetadataEntry struct {
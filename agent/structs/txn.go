@@ -16,6 +16,11 @@ import (
 type TxnKVOp struct {
 	Verb   api.KVOp
 	DirEnt DirEntry
+
+	// Comparison and Count are only used by the compare-value and
+	// compare-prefix-count verbs.
+	Comparison api.KVComparison `json:",omitempty"`
+	Count      uint64           `json:",omitempty"`
 }
 
 // TxnKVResult is used to define the result of a single operation on the KVS
@@ -63,6 +68,23 @@ type TxnSessionOp struct {
 	Session Session
 }
 
+// TxnIfOp is used to define a conditional operation inside a transaction.
+// The Then operations are run if all of the Conditions hold, otherwise the
+// Else operations are run.
+type TxnIfOp struct {
+	Conditions TxnOps
+	Then       TxnOps
+	Else       TxnOps
+}
+
+// TxnIfResult is used to define the result of a conditional operation inside
+// a transaction.
+type TxnIfResult struct {
+	// Succeeded is true if all of the conditions held and the Then
+	// operations were run.
+	Succeeded bool
+}
+
 // TxnIntentionOp is used to define a single operation on an Intention inside a
 // transaction.
 //
@@ -77,6 +99,7 @@ type TxnOp struct {
 	Service *TxnServiceOp
 	Check   *TxnCheckOp
 	Session *TxnSessionOp
+	If      *TxnIfOp
 
 	// Intention was an internal-only (not exposed in API or RPC)
 	// implementation detail of legacy intention replication. This is
@@ -93,6 +116,21 @@ type TxnOp struct {
 	Intention *TxnIntentionOp
 }
 
+// IsCondition returns true if the operation can be used as a condition of a
+// TxnIfOp. Conditions only check the state store and don't return results.
+func (op *TxnOp) IsCondition() bool {
+	switch {
+	case op.KV != nil:
+		switch op.KV.Verb {
+		case api.KVCheckSession, api.KVCheckIndex, api.KVCheckNotExists, api.KVCompareValue, api.KVComparePrefixCount:
+			return true
+		}
+	case op.Service != nil:
+		return op.Service.Verb == api.ServiceCheckHealth
+	}
+	return false
+}
+
 // TxnOps is a list of operations within a transaction.
 type TxnOps []*TxnOp
 
@@ -142,6 +180,7 @@ type TxnResult struct {
 	Node    TxnNodeResult    `json:",omitempty"`
 	Service TxnServiceResult `json:",omitempty"`
 	Check   TxnCheckResult   `json:",omitempty"`
+	If      *TxnIfResult     `json:",omitempty"`
 }
 
 // TxnResults is a list of TxnResult entries.
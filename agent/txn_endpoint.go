@@ -118,14 +118,33 @@ func (s *HTTPHandlers) convertOps(resp http.ResponseWriter, req *http.Request) (
 	}
 
 	// Enforce a reasonable upper limit on the number of operations in a
-	// transaction in order to curb abuse.
-	if size := len(ops); size > maxTxnOps {
+	// transaction in order to curb abuse. The operations nested in
+	// conditional operations count towards the limit.
+	if size := countOps(ops); size > maxTxnOps {
 		return nil, 0, HTTPError{
 			StatusCode: http.StatusRequestEntityTooLarge,
 			Reason:     fmt.Sprintf("Transaction contains too many operations (%d > %d)", size, maxTxnOps),
 		}
 	}
 
+	return s.convertOpList(ops, kvMaxValueSize)
+}
+
+// countOps returns the number of operations in ops, including the ones nested
+// in conditional operations.
+func countOps(ops api.TxnOps) int {
+	count := len(ops)
+	for _, op := range ops {
+		if op.If != nil {
+			count += countOps(op.If.Conditions) + countOps(op.If.Then) + countOps(op.If.Else)
+		}
+	}
+	return count
+}
+
+// convertOpList converts a list of operations in API format to the internal
+// RPC format, along with a count of the write ops in it.
+func (s *HTTPHandlers) convertOpList(ops api.TxnOps, kvMaxValueSize int64) (structs.TxnOps, int, error) {
 	// Convert the KV API format into the RPC format. Note that fixupKVOps
 	// above will have already converted the base64 encoded strings into
 	// byte arrays so we can assign right over.
@@ -155,6 +174,7 @@ func (s *HTTPHandlers) convertOps(resp http.ResponseWriter, req *http.Request) (
 						Value:   in.KV.Value,
 						Flags:   in.KV.Flags,
 						Session: in.KV.Session,
+						TTL:     in.KV.TTL,
 						EnterpriseMeta: acl.NewEnterpriseMetaWithPartition(
 							in.KV.Partition,
 							in.KV.Namespace,
@@ -163,6 +183,8 @@ func (s *HTTPHandlers) convertOps(resp http.ResponseWriter, req *http.Request) (
 							ModifyIndex: in.KV.Index,
 						},
 					},
+					Comparison: in.KV.Comparison,
+					Count:      in.KV.Count,
 				},
 			}
 			opsRPC = append(opsRPC, out)
@@ -199,7 +221,7 @@ func (s *HTTPHandlers) convertOps(resp http.ResponseWriter, req *http.Request) (
 			opsRPC = append(opsRPC, out)
 
 		case in.Service != nil:
-			if in.Service.Verb != api.ServiceGet {
+			if in.Service.Verb != api.ServiceGet && in.Service.Verb != api.ServiceCheckHealth {
 				writes++
 			}
 
@@ -343,6 +365,30 @@ func (s *HTTPHandlers) convertOps(resp http.ResponseWriter, req *http.Request) (
 				},
 			}
 			opsRPC = append(opsRPC, out)
+
+		case in.If != nil:
+			conditions, condWrites, err := s.convertOpList(in.If.Conditions, kvMaxValueSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			thenOps, thenWrites, err := s.convertOpList(in.If.Then, kvMaxValueSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			elseOps, elseWrites, err := s.convertOpList(in.If.Else, kvMaxValueSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			writes += condWrites + thenWrites + elseWrites
+
+			out := &structs.TxnOp{
+				If: &structs.TxnIfOp{
+					Conditions: conditions,
+					Then:       thenOps,
+					Else:       elseOps,
+				},
+			}
+			opsRPC = append(opsRPC, out)
 		}
 	}
 
@@ -232,6 +232,132 @@ func TestTxnEndpoint_Bad_Size_Ops(t *testing.T) {
 	}
 }
 
+func TestTxnEndpoint_Bad_Size_Nested_Ops(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	// The operations nested in a conditional operation count towards the
+	// limit.
+	buf := bytes.NewBuffer([]byte(fmt.Sprintf(`
+ [
+     {
+         "If": {
+             "Then": [%s { "KV": { "Verb": "set", "Key": "key" } }]
+         }
+     }
+ ]
+ `, strings.Repeat(`{ "KV": { "Verb": "get", "Key": "key" } },`, maxTxnOps-1))))
+	req, _ := http.NewRequest("PUT", "/v1/txn", buf)
+	resp := httptest.NewRecorder()
+	_, err := a.srv.Txn(resp, req)
+
+	httpErr, ok := err.(HTTPError)
+	require.True(t, ok, "expected HTTP error but got %v", err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, httpErr.StatusCode)
+	require.Contains(t, httpErr.Reason, fmt.Sprintf("(%d > %d)", maxTxnOps+1, maxTxnOps))
+}
+
+func TestTxnEndpoint_If(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	txn := func(body string) (int, interface{}) {
+		t.Helper()
+		req, _ := http.NewRequest("PUT", "/v1/txn", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.Txn(resp, req)
+		require.NoError(t, err)
+		return resp.Code, obj
+	}
+
+	// Create a key only if there are no keys under the prefix yet.
+	create := `
+ [
+     {
+         "If": {
+             "Conditions": [
+                 {
+                     "KV": {
+                         "Verb": "compare-prefix-count",
+                         "Key": "jobs/",
+                         "Comparison": "less",
+                         "Count": 1
+                     }
+                 }
+             ],
+             "Then": [
+                 { "KV": { "Verb": "set", "Key": "jobs/1", "Value": "aGVsbG8=" } }
+             ],
+             "Else": [
+                 { "KV": { "Verb": "get-tree", "Key": "jobs/" } }
+             ]
+         }
+     }
+ ]
+ `
+	code, obj := txn(create)
+	require.Equal(t, http.StatusOK, code)
+	txnResp, ok := obj.(structs.TxnResponse)
+	require.True(t, ok, "bad type: %T", obj)
+	require.Len(t, txnResp.Results, 2)
+	require.True(t, txnResp.Results[0].If.Succeeded)
+	require.Equal(t, "jobs/1", txnResp.Results[1].KV.Key)
+
+	code, obj = txn(create)
+	require.Equal(t, http.StatusOK, code)
+	txnResp = obj.(structs.TxnResponse)
+	require.Len(t, txnResp.Results, 2)
+	require.False(t, txnResp.Results[0].If.Succeeded)
+	require.Equal(t, []byte("hello"), txnResp.Results[1].KV.Value)
+
+	// A conditional operation with only reads is fast-pathed to the
+	// read-only endpoint.
+	code, obj = txn(`
+ [
+     {
+         "If": {
+             "Conditions": [
+                 { "KV": { "Verb": "compare-value", "Key": "jobs/1", "Value": "aGVsbG8=" } }
+             ],
+             "Then": [
+                 { "KV": { "Verb": "get", "Key": "jobs/1" } }
+             ]
+         }
+     }
+ ]
+ `)
+	require.Equal(t, http.StatusOK, code)
+	readResp, ok := obj.(structs.TxnReadResponse)
+	require.True(t, ok, "bad type: %T", obj)
+	require.Len(t, readResp.Results, 2)
+	require.True(t, readResp.Results[0].If.Succeeded)
+
+	// Errors in the branch that ran are a conflict.
+	code, _ = txn(`
+ [
+     {
+         "If": {
+             "Then": [
+                 { "KV": { "Verb": "check-not-exists", "Key": "jobs/1" } }
+             ]
+         }
+     }
+ ]
+ `)
+	require.Equal(t, http.StatusConflict, code)
+}
+
 func TestTxnEndpoint_KV_Actions(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -9,14 +9,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	osexec "os/exec"
+	"reflect"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/armon/circbuf"
 	"github.com/hashicorp/consul/agent/exec"
 	"github.com/hashicorp/consul/api/watch"
+	"github.com/hashicorp/consul/lib/file"
+	"github.com/hashicorp/consul/lib/retry"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-hclog"
 	"golang.org/x/net/context"
@@ -188,6 +195,194 @@ func makeHTTPWatchHandler(logger hclog.Logger, config *watch.HttpHandlerConfig)
 	return fn
 }
 
+// watchSinkWaiter returns the backoff between the attempts of the file and
+// socket handlers to write a result.
+func watchSinkWaiter() *retry.Waiter {
+	return &retry.Waiter{
+		MinFailures: 1,
+		MinWait:     250 * time.Millisecond,
+		MaxWait:     30 * time.Second,
+		Factor:      500 * time.Millisecond,
+		Jitter:      retry.NewJitter(20),
+	}
+}
+
+// retryWatchSink calls fn until it succeeds, retrying up to maxRetries times
+// with a backoff. It stops retrying once ctx is canceled, and returns the last
+// error of fn.
+func retryWatchSink(ctx context.Context, maxRetries int, fn func() error) error {
+	waiter := watchSinkWaiter()
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= maxRetries {
+			return err
+		}
+		if waitErr := waiter.Wait(ctx); waitErr != nil {
+			return err
+		}
+	}
+}
+
+// fileWatchHandler atomically writes the result of a watch to a file, so that
+// readers of the file never see a partial result.
+type fileWatchHandler struct {
+	logger hclog.Logger
+	config *watch.FileHandlerConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func makeFileWatchHandler(logger hclog.Logger, config *watch.FileHandlerConfig) *fileWatchHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fileWatchHandler{
+		logger: logger,
+		config: config,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (h *fileWatchHandler) Handle(idx uint64, data interface{}) {
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	if err := enc.Encode(data); err != nil {
+		h.logger.Error("Failed to encode data for file watch",
+			"watch", h.config.Path,
+			"error", err,
+		)
+		return
+	}
+
+	// Missing directories can be searched by whoever can read the file.
+	dirMode := h.config.Mode | 0700 | (h.config.Mode&0044)>>2
+	err := retryWatchSink(h.ctx, h.config.MaxRetries, func() error {
+		return file.WriteAtomicWithPerms(h.config.Path, out.Bytes(), dirMode, h.config.Mode)
+	})
+	if err != nil {
+		h.logger.Error("Failed to write file watch handler result",
+			"watch", h.config.Path,
+			"index", idx,
+			"error", err,
+		)
+		return
+	}
+	h.logger.Trace("file watch handler wrote result",
+		"watch", h.config.Path,
+		"index", idx,
+	)
+}
+
+// Close stops the retries of a result being written.
+func (h *fileWatchHandler) Close() error {
+	h.cancel()
+	return nil
+}
+
+// socketWatchEvent is a line written by the socket watch handler.
+type socketWatchEvent struct {
+	Index uint64
+	Data  interface{}
+}
+
+// socketWatchHandler streams the results of a watch as lines of JSON to a
+// Unix domain socket. The connection is kept open between results, and is
+// opened again when a write fails.
+type socketWatchHandler struct {
+	logger hclog.Logger
+	config *watch.SocketHandlerConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// lock guards conn, which is nil until the first result is written or
+	// after a write failed.
+	lock sync.Mutex
+	conn net.Conn
+}
+
+func makeSocketWatchHandler(logger hclog.Logger, config *watch.SocketHandlerConfig) *socketWatchHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &socketWatchHandler{
+		logger: logger,
+		config: config,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (h *socketWatchHandler) Handle(idx uint64, data interface{}) {
+	line, err := json.Marshal(&socketWatchEvent{Index: idx, Data: data})
+	if err != nil {
+		h.logger.Error("Failed to encode data for socket watch",
+			"watch", h.config.Path,
+			"error", err,
+		)
+		return
+	}
+	line = append(line, '\n')
+
+	err = retryWatchSink(h.ctx, h.config.MaxRetries, func() error {
+		return h.write(line)
+	})
+	if err != nil {
+		h.logger.Error("Failed to write socket watch handler result",
+			"watch", h.config.Path,
+			"index", idx,
+			"error", err,
+		)
+		return
+	}
+	h.logger.Trace("socket watch handler wrote result",
+		"watch", h.config.Path,
+		"index", idx,
+	)
+}
+
+// write writes a line to the socket, connecting to it first if needed.
+func (h *socketWatchHandler) write(line []byte) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.conn == nil {
+		dialer := net.Dialer{Timeout: h.config.Timeout}
+		conn, err := dialer.DialContext(h.ctx, "unix", h.config.Path)
+		if err != nil {
+			return err
+		}
+		h.conn = conn
+	}
+
+	if err := h.conn.SetWriteDeadline(time.Now().Add(h.config.Timeout)); err != nil {
+		h.closeConn()
+		return err
+	}
+	if _, err := h.conn.Write(line); err != nil {
+		// A partial line may have been written, so the next line goes to a
+		// new connection.
+		h.closeConn()
+		return err
+	}
+	return nil
+}
+
+func (h *socketWatchHandler) closeConn() {
+	if h.conn != nil {
+		h.conn.Close()
+		h.conn = nil
+	}
+}
+
+// Close stops the retries of a result being written, and closes the
+// connection to the socket.
+func (h *socketWatchHandler) Close() error {
+	h.cancel()
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.closeConn()
+	return nil
+}
+
 // TODO: return a fully constructed watch.Plan with a Plan.Handler, so that Exempt
 // can be ignored by the caller.
 func makeWatchPlan(logger hclog.Logger, params map[string]interface{}) (*watch.Plan, error) {
@@ -213,15 +408,55 @@ func makeWatchPlan(logger hclog.Logger, params map[string]interface{}) (*watch.P
 		}
 	}
 
-	if hasHandler && hasArgs || hasHandler && wp.HandlerType == "http" || hasArgs && wp.HandlerType == "http" {
+	isScript := wp.HandlerType == "" || wp.HandlerType == "script"
+	if hasHandler && hasArgs || (hasHandler || hasArgs) && !isScript {
 		return nil, fmt.Errorf("Only one watch handler allowed")
 	}
-	if !hasHandler && !hasArgs && wp.HandlerType != "http" {
+	if !hasHandler && !hasArgs && isScript {
 		return nil, fmt.Errorf("Must define a watch handler")
 	}
+
+	if wp.Filter != "" {
+		wp.ResultFilter, err = MakeWatchResultFilter(wp.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse 'filter': %v", err)
+		}
+	}
 	return wp, nil
 }
 
+// MakeWatchResultFilter returns the function applying a filter expression to
+// the result of a watch, for the watch types that do not filter through the
+// API. The elements of a collection are filtered, and a single result that
+// does not match is replaced by nil.
+func MakeWatchResultFilter(expr string) (watch.ResultFilterFunc, error) {
+	filter, err := bexpr.CreateFilter(expr, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	evaluator, err := bexpr.CreateEvaluator(expr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(result interface{}) (interface{}, error) {
+		v := reflect.ValueOf(result)
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return filter.Execute(result)
+		case reflect.Ptr:
+			if v.IsNil() {
+				return result, nil
+			}
+		}
+		match, err := evaluator.Evaluate(result)
+		if err != nil || !match {
+			return nil, err
+		}
+		return result, nil
+	}, nil
+}
+
 func parseWatchArgs(args interface{}) ([]string, error) {
 	switch args := args.(type) {
 	case string:
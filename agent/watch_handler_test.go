@@ -4,17 +4,24 @@
 package agent
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/api/watch"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
 )
 
 func TestMakeWatchHandler(t *testing.T) {
@@ -69,6 +76,106 @@ func TestMakeHTTPWatchHandler(t *testing.T) {
 	handler(100, []string{"foo", "bar", "baz"})
 }
 
+func TestMakeFileWatchHandler(t *testing.T) {
+	path := filepath.Join(testutil.TempDir(t, "watch"), "out", "result.json")
+	config := watch.FileHandlerConfig{
+		Path:       path,
+		Mode:       0640,
+		MaxRetries: watch.DefaultMaxRetries,
+	}
+	handler := makeFileWatchHandler(testutil.Logger(t), &config)
+	defer handler.Close()
+
+	handler.Handle(100, []string{"foo", "bar", "baz"})
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "[\"foo\",\"bar\",\"baz\"]\n", string(raw))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+
+	// The file is replaced by the next result.
+	handler.Handle(101, []string{"qux"})
+	raw, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "[\"qux\"]\n", string(raw))
+}
+
+func TestMakeSocketWatchHandler(t *testing.T) {
+	path := filepath.Join(testutil.TempDir(t, "watch"), "watch.sock")
+	config := watch.SocketHandlerConfig{
+		Path:       path,
+		Timeout:    time.Second,
+		MaxRetries: 10,
+	}
+	handler := makeSocketWatchHandler(testutil.Logger(t), &config)
+	defer handler.Close()
+
+	// The handler retries until the listener is started.
+	lines := make(chan string, 2)
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		l, err := net.Listen("unix", path)
+		if err != nil {
+			close(lines)
+			return
+		}
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			close(lines)
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	handler.Handle(100, []string{"foo"})
+	handler.Handle(101, []string{"bar"})
+
+	// Both results are streamed over the same connection.
+	for _, expected := range []socketWatchEvent{
+		{Index: 100, Data: []interface{}{"foo"}},
+		{Index: 101, Data: []interface{}{"bar"}},
+	} {
+		select {
+		case line, ok := <-lines:
+			require.True(t, ok, "listener failed")
+			var event socketWatchEvent
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			require.Equal(t, expected, event)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the result")
+		}
+	}
+}
+
+func TestRetryWatchSink(t *testing.T) {
+	var attempts int
+	err := retryWatchSink(context.Background(), 2, func() error {
+		attempts++
+		return fmt.Errorf("failed")
+	})
+	require.EqualError(t, err, "failed")
+	require.Equal(t, 3, attempts)
+
+	// A canceled context stops the retries.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts = 0
+	err = retryWatchSink(ctx, 10, func() error {
+		attempts++
+		return fmt.Errorf("failed")
+	})
+	require.EqualError(t, err, "failed")
+	require.Equal(t, 1, attempts)
+}
+
 type raw map[string]interface{}
 
 func TestMakeWatchPlan(t *testing.T) {
@@ -162,6 +269,58 @@ func TestMakeWatchPlan(t *testing.T) {
 			},
 			expectedErr: "Only one watch handler allowed",
 		},
+		{
+			name: "filtered and debounced",
+			params: raw{
+				"type":           "keyprefix",
+				"prefix":         "foo/",
+				"handler_type":   "script",
+				"args":           "./script.sh",
+				"filter":         "Flags == 1",
+				"debounce":       "5s",
+				"min_interval":   "1m",
+				"only_on_change": true,
+			},
+			expected: func(t *testing.T, plan *watch.Plan) {
+				require.Equal(t, "Flags == 1", plan.Filter)
+				require.NotNil(t, plan.ResultFilter)
+				require.Equal(t, 5*time.Second, plan.Debounce)
+				require.Equal(t, time.Minute, plan.MinInterval)
+				require.True(t, plan.OnlyOnChange)
+			},
+		},
+		{
+			name: "handler_type file",
+			params: raw{
+				"type":         "key",
+				"key":          "foo",
+				"handler_type": "file",
+				"file_handler_config": raw{
+					"path": "/tmp/result.json",
+				},
+			},
+			expected: func(t *testing.T, plan *watch.Plan) {
+				require.Equal(t, plan.HandlerType, "file")
+				require.Equal(t, plan.Exempt["file_handler_config"], &watch.FileHandlerConfig{
+					Path:       "/tmp/result.json",
+					Mode:       0600,
+					MaxRetries: watch.DefaultMaxRetries,
+				})
+			},
+		},
+		{
+			name: "handler_type socket, with args",
+			params: raw{
+				"type":         "key",
+				"key":          "foo",
+				"handler_type": "socket",
+				"args":         []interface{}{"./script.sh"},
+				"socket_handler_config": raw{
+					"path": "/tmp/watch.sock",
+				},
+			},
+			expectedErr: "Only one watch handler allowed",
+		},
 		{
 			name: "no handler_type",
 			params: raw{
@@ -170,6 +329,16 @@ func TestMakeWatchPlan(t *testing.T) {
 			},
 			expectedErr: "Must define a watch handler",
 		},
+		{
+			name: "invalid filter",
+			params: raw{
+				"type":   "key",
+				"key":    "foo",
+				"args":   []interface{}{"./script.sh"},
+				"filter": "Flags ==",
+			},
+			expectedErr: "Failed to parse 'filter'",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -178,3 +347,29 @@ func TestMakeWatchPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeWatchResultFilter(t *testing.T) {
+	t.Parallel()
+
+	filter, err := MakeWatchResultFilter(`Flags == 1`)
+	require.NoError(t, err)
+
+	pairs := api.KVPairs{
+		{Key: "a", Flags: 1},
+		{Key: "b", Flags: 0},
+	}
+	out, err := filter(pairs)
+	require.NoError(t, err)
+	require.Equal(t, api.KVPairs{pairs[0]}, out)
+
+	// A single result is kept if it matches, and replaced by nil otherwise.
+	out, err = filter(pairs[0])
+	require.NoError(t, err)
+	require.Equal(t, pairs[0], out)
+	out, err = filter(pairs[1])
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	_, err = MakeWatchResultFilter(`Flags ==`)
+	require.Error(t, err)
+}
@@ -72,8 +72,31 @@ type AgentCheck struct {
 	Type        string
 	ExposedPort int
 	Definition  HealthCheckDefinition
-	Namespace   string `json:",omitempty"`
-	Partition   string `json:",omitempty"`
+	Namespace   string            `json:",omitempty"`
+	Partition   string            `json:",omitempty"`
+	Meta        map[string]string `json:",omitempty"`
+
+	// Flapping is the flap dampening state of the check. It is only set for
+	// checks with a FlapHalfLife.
+	Flapping *AgentCheckFlapState `json:",omitempty"`
+}
+
+// AgentCheckFlapState is the flap dampening state of a check, kept by the
+// agent running the check.
+type AgentCheckFlapState struct {
+	// Flapping is true while the check is held in the warning state.
+	Flapping bool
+
+	// Penalty is the current penalty of the check. Every status transition
+	// adds 1 to it and it halves every FlapHalfLife.
+	Penalty float64
+
+	// Transitions is the number of status transitions of the check within
+	// the last FlapMaxSuppress.
+	Transitions int
+
+	// Status is the last status reported by the check itself.
+	Status string
 }
 
 // AgentWeights represent optional weights for a service
@@ -336,34 +359,49 @@ type AgentCheckRegistration struct {
 
 // AgentServiceCheck is used to define a node or service level check
 type AgentServiceCheck struct {
-	CheckID                string              `json:",omitempty"`
-	Name                   string              `json:",omitempty"`
-	Args                   []string            `json:"ScriptArgs,omitempty"`
-	DockerContainerID      string              `json:",omitempty"`
-	Shell                  string              `json:",omitempty"` // Only supported for Docker.
-	Interval               string              `json:",omitempty"`
-	Timeout                string              `json:",omitempty"`
-	TTL                    string              `json:",omitempty"`
-	HTTP                   string              `json:",omitempty"`
-	Header                 map[string][]string `json:",omitempty"`
-	Method                 string              `json:",omitempty"`
-	Body                   string              `json:",omitempty"`
-	TCP                    string              `json:",omitempty"`
-	TCPUseTLS              bool                `json:",omitempty"`
-	UDP                    string              `json:",omitempty"`
-	Status                 string              `json:",omitempty"`
-	Notes                  string              `json:",omitempty"`
-	TLSServerName          string              `json:",omitempty"`
-	TLSSkipVerify          bool                `json:",omitempty"`
-	GRPC                   string              `json:",omitempty"`
-	GRPCUseTLS             bool                `json:",omitempty"`
-	H2PING                 string              `json:",omitempty"`
-	H2PingUseTLS           bool                `json:",omitempty"`
-	AliasNode              string              `json:",omitempty"`
-	AliasService           string              `json:",omitempty"`
-	SuccessBeforePassing   int                 `json:",omitempty"`
-	FailuresBeforeWarning  int                 `json:",omitempty"`
-	FailuresBeforeCritical int                 `json:",omitempty"`
+	CheckID                string                        `json:",omitempty"`
+	Name                   string                        `json:",omitempty"`
+	Args                   []string                      `json:"ScriptArgs,omitempty"`
+	DockerContainerID      string                        `json:",omitempty"`
+	Shell                  string                        `json:",omitempty"` // Only supported for Docker.
+	Interval               string                        `json:",omitempty"`
+	Timeout                string                        `json:",omitempty"`
+	TTL                    string                        `json:",omitempty"`
+	HTTP                   string                        `json:",omitempty"`
+	Header                 map[string][]string           `json:",omitempty"`
+	Method                 string                        `json:",omitempty"`
+	Body                   string                        `json:",omitempty"`
+	ResponseAssertions     []AgentCheckResponseAssertion `json:",omitempty"`
+	TCP                    string                        `json:",omitempty"`
+	TCPUseTLS              bool                          `json:",omitempty"`
+	UDP                    string                        `json:",omitempty"`
+	Status                 string                        `json:",omitempty"`
+	Notes                  string                        `json:",omitempty"`
+	TLSServerName          string                        `json:",omitempty"`
+	TLSSkipVerify          bool                          `json:",omitempty"`
+	GRPC                   string                        `json:",omitempty"`
+	GRPCUseTLS             bool                          `json:",omitempty"`
+	H2PING                 string                        `json:",omitempty"`
+	H2PingUseTLS           bool                          `json:",omitempty"`
+	AliasNode              string                        `json:",omitempty"`
+	AliasService           string                        `json:",omitempty"`
+	CompositeMode          string                        `json:",omitempty"`
+	CompositeQuorum        int                           `json:",omitempty"`
+	CompositeMembers       []AgentCheckCompositeMember   `json:",omitempty"`
+	CertExpiry             string                        `json:",omitempty"`
+	DNS                    string                        `json:",omitempty"`
+	DNSServer              string                        `json:",omitempty"`
+	FileFreshness          string                        `json:",omitempty"`
+	Outlier                string                        `json:",omitempty"`
+	WarningThreshold       string                        `json:",omitempty"`
+	CriticalThreshold      string                        `json:",omitempty"`
+	SuccessBeforePassing   int                           `json:",omitempty"`
+	FailuresBeforeWarning  int                           `json:",omitempty"`
+	FailuresBeforeCritical int                           `json:",omitempty"`
+	FlapHalfLife           string                        `json:",omitempty"`
+	FlapMaxSuppress        string                        `json:",omitempty"`
+	FlapSuppressThreshold  int                           `json:",omitempty"`
+	FlapReuseThreshold     int                           `json:",omitempty"`
 
 	// In Consul 0.7 and later, checks that are associated with a service
 	// may also contain this optional DeregisterCriticalServiceAfter field,
@@ -375,6 +413,31 @@ type AgentServiceCheck struct {
 }
 type AgentServiceChecks []*AgentServiceCheck
 
+// AgentCheckResponseAssertion overrides the status of an HTTP check when the
+// response matches. Exactly one of BodyRegex, JSONPath or Header must be set.
+// Match is a regular expression the selected JSONPath values or header values
+// must match; when empty, the assertion matches if they are present.
+type AgentCheckResponseAssertion struct {
+	BodyRegex string `json:",omitempty"`
+	JSONPath  string `json:",omitempty"`
+	Header    string `json:",omitempty"`
+	Match     string `json:",omitempty"`
+	Status    string
+}
+
+// AgentCheckCompositeMember selects the checks aggregated by a member of a
+// composite check: a check by CheckID, the instances of a service by
+// ServiceID or ServiceName, or the node-level checks when none of them is
+// set. Node is the node of the checks, the agent's node when empty. Weight is
+// what the member counts for when CompositeMode is "quorum", 1 when zero.
+type AgentCheckCompositeMember struct {
+	Node        string `json:",omitempty"`
+	CheckID     string `json:",omitempty"`
+	ServiceID   string `json:",omitempty"`
+	ServiceName string `json:",omitempty"`
+	Weight      int    `json:",omitempty"`
+}
+
 // AgentToken is used when updating ACL tokens for an agent.
 type AgentToken struct {
 	Token string
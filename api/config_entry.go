@@ -273,6 +273,14 @@ type DestinationConfig struct {
 	Port int `json:",omitempty"`
 }
 
+// OutlierHealthCheck opts the instances of a service into the outlier health
+// checks of their sidecar proxies.
+type OutlierHealthCheck struct {
+	// MinProxies is the number of proxies that must report an instance
+	// ejected for its outlier health check to be warning, 1 when zero.
+	MinProxies int `json:",omitempty" alias:"min_proxies"`
+}
+
 type PassiveHealthCheck struct {
 	// Interval between health check analysis sweeps. Each sweep may remove
 	// hosts or return hosts to the pool.
@@ -378,6 +386,7 @@ type ServiceConfigEntry struct {
 	BalanceInboundConnections string                  `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits             `json:",omitempty" alias:"rate_limits"`
 	EnvoyExtensions           []EnvoyExtension        `json:",omitempty" alias:"envoy_extensions"`
+	OutlierHealthCheck        *OutlierHealthCheck     `json:",omitempty" alias:"outlier_health_check"`
 	Meta                      map[string]string       `json:",omitempty"`
 	CreateIndex               uint64
 	ModifyIndex               uint64
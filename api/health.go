@@ -32,6 +32,15 @@ const (
 
 	// ServiceMaintPrefix is the prefix for a service in maintenance mode.
 	ServiceMaintPrefix = "_service_maintenance:"
+
+	// HealthCheckMetaFlapping is the check metadata key that is set to "true"
+	// while the agent holds a flapping check in the warning state.
+	HealthCheckMetaFlapping = "consul-flapping"
+
+	// HealthCheckMetaOutlierEjected is the check metadata key that an outlier
+	// check lists the upstream hosts ejected by its proxy in, as host:port
+	// separated by commas.
+	HealthCheckMetaOutlierEjected = "consul-outlier-ejected"
 )
 
 // HealthCheck is used to represent a single check
@@ -49,7 +58,8 @@ type HealthCheck struct {
 	Namespace   string `json:",omitempty"`
 	Partition   string `json:",omitempty"`
 	ExposedPort int
-	PeerName    string `json:",omitempty"`
+	PeerName    string            `json:",omitempty"`
+	Meta        map[string]string `json:",omitempty"`
 
 	Definition HealthCheckDefinition
 
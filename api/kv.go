@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // KVPair is used to represent a single K/V entry
@@ -44,6 +45,16 @@ type KVPair struct {
 	// session ID.
 	Session string
 
+	// TTL is the time to live of the key, such as "30s". If it is set when the
+	// key is written, the servers delete the key once the TTL has passed. Each
+	// write sets the TTL again, and a write without a TTL makes the key
+	// permanent.
+	TTL string `json:",omitempty"`
+
+	// Expires is the time after which the servers delete the key. It is set
+	// from the TTL by the servers and is a read-only field.
+	Expires *time.Time `json:",omitempty"`
+
 	// Namespace is the namespace the KVPair is associated with
 	// Namespacing is a Consul Enterprise feature.
 	Namespace string `json:",omitempty"`
@@ -56,6 +67,20 @@ type KVPair struct {
 // KVPairs is a list of KVPair objects
 type KVPairs []*KVPair
 
+// KVRevision is a value a key had at some point. The servers record revisions
+// as keys are written and deleted while KV history is enabled.
+type KVRevision struct {
+	// KVPair is the key as it was written, with ModifyIndex set to the index
+	// of the write. Only the key is set for deletions.
+	KVPair
+
+	// Deleted is set if the key was deleted at this revision.
+	Deleted bool `json:",omitempty"`
+
+	// Time is when the leader accepted the change.
+	Time time.Time
+}
+
 // KV is used to manipulate the K/V API
 type KV struct {
 	c *Client
@@ -88,6 +113,47 @@ func (k *KV) Get(key string, q *QueryOptions) (*KVPair, *QueryMeta, error) {
 	return nil, qm, nil
 }
 
+// GetAt is used to lookup the value a key had at the given index. It is
+// served from the KV history, so it returns nil if the key did not exist at
+// that index, and an error if the revision is no longer retained.
+func (k *KV) GetAt(key string, index uint64, q *QueryOptions) (*KVPair, *QueryMeta, error) {
+	resp, qm, err := k.getInternal(key, map[string]string{"at": strconv.FormatUint(index, 10)}, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil {
+		return nil, qm, nil
+	}
+	defer closeResponseBody(resp)
+
+	var entries []*KVPair
+	if err := decodeBody(resp, &entries); err != nil {
+		return nil, nil, err
+	}
+	if len(entries) > 0 {
+		return entries[0], qm, nil
+	}
+	return nil, qm, nil
+}
+
+// History is used to lookup the recorded revisions of a key, newest first.
+func (k *KV) History(key string, q *QueryOptions) ([]*KVRevision, *QueryMeta, error) {
+	resp, qm, err := k.getInternal(key, map[string]string{"revisions": ""}, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil {
+		return nil, qm, nil
+	}
+	defer closeResponseBody(resp)
+
+	var revs []*KVRevision
+	if err := decodeBody(resp, &revs); err != nil {
+		return nil, nil, err
+	}
+	return revs, qm, nil
+}
+
 // List is used to lookup all keys under a prefix
 func (k *KV) List(prefix string, q *QueryOptions) (KVPairs, *QueryMeta, error) {
 	resp, qm, err := k.getInternal(prefix, map[string]string{"recurse": ""}, q)
@@ -164,6 +230,9 @@ func (k *KV) Put(p *KVPair, q *WriteOptions) (*WriteMeta, error) {
 	if p.Flags != 0 {
 		params["flags"] = strconv.FormatUint(p.Flags, 10)
 	}
+	if p.TTL != "" {
+		params["ttl"] = p.TTL
+	}
 	_, wm, err := k.put(p.Key, params, p.Value, q)
 	return wm, err
 }
@@ -176,6 +245,9 @@ func (k *KV) CAS(p *KVPair, q *WriteOptions) (bool, *WriteMeta, error) {
 	if p.Flags != 0 {
 		params["flags"] = strconv.FormatUint(p.Flags, 10)
 	}
+	if p.TTL != "" {
+		params["ttl"] = p.TTL
+	}
 	params["cas"] = strconv.FormatUint(p.ModifyIndex, 10)
 	return k.put(p.Key, params, p.Value, q)
 }
@@ -188,6 +260,9 @@ func (k *KV) Acquire(p *KVPair, q *WriteOptions) (bool, *WriteMeta, error) {
 	if p.Flags != 0 {
 		params["flags"] = strconv.FormatUint(p.Flags, 10)
 	}
+	if p.TTL != "" {
+		params["ttl"] = p.TTL
+	}
 	params["acquire"] = p.Session
 	return k.put(p.Key, params, p.Value, q)
 }
@@ -200,6 +275,9 @@ func (k *KV) Release(p *KVPair, q *WriteOptions) (bool, *WriteMeta, error) {
 	if p.Flags != 0 {
 		params["flags"] = strconv.FormatUint(p.Flags, 10)
 	}
+	if p.TTL != "" {
+		params["ttl"] = p.TTL
+	}
 	params["release"] = p.Session
 	return k.put(p.Key, params, p.Value, q)
 }
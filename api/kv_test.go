@@ -11,6 +11,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 )
 
 func TestAPI_ClientPutGetDelete(t *testing.T) {
@@ -79,6 +82,94 @@ func TestAPI_ClientPutGetDelete(t *testing.T) {
 	}
 }
 
+func TestAPI_ClientPut_TTL(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	kv := c.KV()
+
+	s.WaitForSerfCheck(t)
+
+	// A key with a long TTL reports when it expires.
+	key := testKey()
+	_, err := kv.Put(&KVPair{Key: key, Value: []byte("test"), TTL: "1h"}, nil)
+	require.NoError(t, err)
+
+	pair, _, err := kv.Get(key, nil)
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Equal(t, "1h", pair.TTL)
+	require.NotNil(t, pair.Expires)
+	require.WithinDuration(t, time.Now().Add(time.Hour), *pair.Expires, time.Minute)
+
+	// Invalid TTLs are rejected.
+	_, err = kv.Put(&KVPair{Key: key, Value: []byte("test"), TTL: "soon"}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid TTL")
+
+	// A key with a short TTL is deleted once it expires.
+	short := testKey()
+	_, err = kv.Put(&KVPair{Key: short, Value: []byte("test"), TTL: "1s"}, nil)
+	require.NoError(t, err)
+
+	retry.Run(t, func(r *retry.R) {
+		pair, _, err := kv.Get(short, nil)
+		require.NoError(r, err)
+		require.Nil(r, pair)
+	})
+}
+
+func TestAPI_ClientHistory(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithConfig(t, nil, func(conf *testutil.TestServerConfig) {
+		conf.Args = []string{"-hcl", "kv_history { retain_count = 10 }"}
+	})
+	defer s.Stop()
+
+	kv := c.KV()
+
+	s.WaitForSerfCheck(t)
+
+	// Recording starts once the leader has shared the retain count.
+	key := testKey()
+	retry.Run(t, func(r *retry.R) {
+		_, err := kv.Put(&KVPair{Key: key, Value: []byte("one")}, nil)
+		require.NoError(r, err)
+		revs, _, err := kv.History(key, nil)
+		require.NoError(r, err)
+		require.NotEmpty(r, revs)
+	})
+	_, err := kv.Put(&KVPair{Key: key, Value: []byte("two"), Flags: 42}, nil)
+	require.NoError(t, err)
+	_, err = kv.Delete(key, nil)
+	require.NoError(t, err)
+
+	revs, meta, err := kv.History(key, nil)
+	require.NoError(t, err)
+	require.NotZero(t, meta.LastIndex)
+	require.GreaterOrEqual(t, len(revs), 3)
+	require.True(t, revs[0].Deleted)
+	require.Equal(t, []byte("two"), revs[1].Value)
+	require.Equal(t, uint64(42), revs[1].Flags)
+	require.False(t, revs[1].Time.IsZero())
+
+	// Reading at an index returns the value the key had then.
+	pair, _, err := kv.GetAt(key, revs[1].ModifyIndex, nil)
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Equal(t, []byte("two"), pair.Value)
+
+	pair, _, err = kv.GetAt(key, revs[0].ModifyIndex, nil)
+	require.NoError(t, err)
+	require.Nil(t, pair)
+
+	// Keys without history return nothing.
+	revs, _, err = kv.History(testKey(), nil)
+	require.NoError(t, err)
+	require.Empty(t, revs)
+}
+
 func TestAPI_ClientList_DeleteRecurse(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t)
@@ -39,6 +39,10 @@ const (
 	// is being used for a lock. It is used to detect a potential
 	// conflict with a semaphore.
 	LockFlagValue = 0x2ddccbc058a50c18
+
+	// LockQueueSuffix is appended to the key of a lock to get the prefix
+	// under which the contenders of a fair lock queue up.
+	LockQueueSuffix = "/.queue/"
 )
 
 var (
@@ -78,11 +82,13 @@ type LockOptions struct {
 	SessionOpts      *SessionEntry // Optional, options to use when creating a session
 	SessionName      string        // Optional, defaults to DefaultLockSessionName (ignored if SessionOpts is given)
 	SessionTTL       string        // Optional, defaults to DefaultLockSessionTTL (ignored if SessionOpts is given)
+	Successors       []string      // Optional, sessions the lock is transferred to if our session is invalidated (ignored if SessionOpts is given)
 	MonitorRetries   int           // Optional, defaults to 0 which means no retries
 	MonitorRetryTime time.Duration // Optional, defaults to DefaultMonitorRetryTime
 	LockWaitTime     time.Duration // Optional, defaults to DefaultLockWaitTime
 	LockTryOnce      bool          // Optional, defaults to false which means try forever
 	LockDelay        time.Duration // Optional, defaults to 15s
+	Fair             bool          // Optional, defaults to false which means contenders race for the lock
 	Namespace        string        `json:",omitempty"` // Optional, defaults to API client config, namespace of ACL token, or "default" namespace
 }
 
@@ -127,6 +133,12 @@ func (c *Client) LockOpts(opts *LockOptions) (*Lock, error) {
 }
 
 // Lock attempts to acquire the lock and blocks while doing so.
+//
+// If the Fair option is set, contenders queue up under the key prefix given
+// by the lock key followed by LockQueueSuffix and acquire the lock in the
+// order they called Lock. Each contender only watches the one ahead of it in
+// the queue, so a release wakes up a single contender. All the contenders of
+// a lock must agree on the Fair option.
 // Providing a non-nil stopCh can be used to abort the lock attempt.
 // Returns a channel that is closed if our lock is lost or an error.
 // This channel could be closed at any time due to session invalidation,
@@ -135,6 +147,10 @@ func (c *Client) LockOpts(opts *LockOptions) (*Lock, error) {
 // created without any associated health checks. By default Consul sessions
 // prefer liveness over safety and an application must be able to handle
 // the lock being lost.
+//
+// If a Session is given, Lock also returns once a session created with the
+// SessionBehaviorTransfer behavior and this session as a successor is
+// invalidated while holding the lock, as the lock is then handed to us.
 func (l *Lock) Lock(stopCh <-chan struct{}) (<-chan struct{}, error) {
 	// Hold the lock as we try to acquire
 	l.l.Lock()
@@ -179,6 +195,20 @@ func (l *Lock) Lock(stopCh <-chan struct{}) (<-chan struct{}, error) {
 		Namespace: l.opts.Namespace,
 	}
 
+	// Join the queue in fair mode, and leave it if we give up
+	queueEntry := l.queueEntry(l.lockSession)
+	if l.opts.Fair {
+		made, err := joinQueue(kv, queueEntry, &wOpts)
+		if err != nil || !made {
+			return nil, fmt.Errorf("failed to make queue entry: %v", err)
+		}
+		defer func() {
+			if !l.isHeld {
+				kv.Delete(queueEntry.Key, &wOpts)
+			}
+		}()
+	}
+
 	start := time.Now()
 	attempts := 0
 WAIT:
@@ -201,6 +231,17 @@ WAIT:
 	}
 	attempts++
 
+	// In fair mode, only the head of the queue contends for the lock
+	if l.opts.Fair {
+		head, err := waitQueueTurn(kv, l.queuePrefix(), queueEntry, 1, qOpts, &wOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read lock queue: %v", err)
+		}
+		if !head {
+			goto WAIT
+		}
+	}
+
 	// Look for an existing lock, blocking until not taken
 	pair, meta, err := kv.Get(l.opts.Key, &qOpts)
 	if err != nil {
@@ -298,6 +339,13 @@ func (l *Lock) Unlock() error {
 	if err != nil {
 		return fmt.Errorf("failed to release lock: %v", err)
 	}
+
+	// Leave the queue, which lets the next contender in
+	if l.opts.Fair {
+		if _, err := kv.Delete(l.queueEntry(lockEnt.Session).Key, &w); err != nil {
+			return fmt.Errorf("failed to remove queue entry: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -359,6 +407,10 @@ func (l *Lock) createSession() (string, error) {
 			TTL:       l.opts.SessionTTL,
 			LockDelay: l.opts.LockDelay,
 		}
+		if len(l.opts.Successors) > 0 {
+			se.Behavior = SessionBehaviorTransfer
+			se.Successors = l.opts.Successors
+		}
 	}
 	w := WriteOptions{Namespace: l.opts.Namespace}
 	id, _, err := session.Create(se, &w)
@@ -378,6 +430,20 @@ func (l *Lock) lockEntry(session string) *KVPair {
 	}
 }
 
+// queuePrefix returns the prefix of the queue entries in fair mode
+func (l *Lock) queuePrefix() string {
+	return l.opts.Key + LockQueueSuffix
+}
+
+// queueEntry returns a formatted KVPair for our entry in the queue
+func (l *Lock) queueEntry(session string) *KVPair {
+	return &KVPair{
+		Key:     l.queuePrefix() + session,
+		Session: session,
+		Flags:   LockFlagValue,
+	}
+}
+
 // monitorLock is a long running routine to monitor a lock ownership
 // It closes the stopCh if we lose our leadership.
 func (l *Lock) monitorLock(session string, stopCh chan struct{}) {
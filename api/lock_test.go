@@ -13,6 +13,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
 )
@@ -136,6 +138,232 @@ func TestAPI_LockForceInvalidate(t *testing.T) {
 	})
 }
 
+func TestAPI_LockTransfer(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+
+	session := c.Session()
+	standby, _, err := session.CreateNoChecks(&SessionEntry{TTL: DefaultLockSessionTTL}, nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	defer session.Destroy(standby, nil)
+
+	// The primary creates its own session with the standby as a successor.
+	primary, err := c.LockOpts(&LockOptions{
+		Key:        "test/lock",
+		Value:      []byte("primary"),
+		Successors: []string{standby},
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	leaderCh, err := primary.Lock(nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if leaderCh == nil {
+		t.Fatalf("not leader")
+	}
+	defer primary.Unlock()
+
+	info, _, err := session.Info(primary.lockSession, nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if info.Behavior != SessionBehaviorTransfer || len(info.Successors) != 1 || info.Successors[0] != standby {
+		t.Fatalf("bad: %#v", info)
+	}
+
+	// The standby queues up for the lock with its session.
+	secondary, err := c.LockOpts(&LockOptions{
+		Key:     "test/lock",
+		Value:   []byte("secondary"),
+		Session: standby,
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	acquired := make(chan (<-chan struct{}), 1)
+	go func() {
+		ch, err := secondary.Lock(nil)
+		if err != nil {
+			t.Errorf("err: %v", err)
+		}
+		acquired <- ch
+	}()
+
+	// Invalidate the session of the primary.
+	if _, err := session.Destroy(primary.lockSession, nil); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	select {
+	case <-leaderCh:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("primary should not be leader")
+	}
+
+	var secondaryCh <-chan struct{}
+	select {
+	case secondaryCh = <-acquired:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("lock should have been transferred")
+	}
+	if secondaryCh == nil {
+		t.Fatalf("standby should be leader")
+	}
+	defer secondary.Unlock()
+
+	// The lock is held by the standby with the contents of the primary.
+	pair, _, err := c.KV().Get("test/lock", nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if pair.Session != standby || string(pair.Value) != "primary" || pair.LockIndex != 2 {
+		t.Fatalf("bad: %#v", pair)
+	}
+}
+
+func TestAPI_LockFair(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+
+	newLock := func() *Lock {
+		lock, err := c.LockOpts(&LockOptions{Key: "test/lock", Fair: true})
+		require.NoError(t, err)
+		return lock
+	}
+	requireQueueLen := func(n int) {
+		t.Helper()
+		retry.Run(t, func(r *retry.R) {
+			keys, _, err := c.KV().Keys("test/lock"+LockQueueSuffix, "", nil)
+			require.NoError(r, err)
+			require.Len(r, keys, n)
+		})
+	}
+
+	holder := newLock()
+	leaderCh, err := holder.Lock(nil)
+	require.NoError(t, err)
+	require.NotNil(t, leaderCh)
+	requireQueueLen(1)
+
+	// Queue up contenders one after the other, with one that gives up while
+	// waiting.
+	acquired := make(chan int, 3)
+	var contenders []*Lock
+	abortCh := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		lock := newLock()
+		stopCh := make(chan struct{})
+		if i == 1 {
+			// The stop channel is checked between blocking queries.
+			lock.opts.LockWaitTime = 100 * time.Millisecond
+			stopCh = abortCh
+		} else {
+			contenders = append(contenders, lock)
+		}
+		n := len(contenders) - 1
+		go func() {
+			ch, err := lock.Lock(stopCh)
+			if err != nil {
+				t.Errorf("err: %v", err)
+			}
+			if ch != nil {
+				acquired <- n
+			}
+		}()
+		requireQueueLen(i + 2)
+	}
+	close(abortCh)
+	requireQueueLen(4)
+
+	// The lock is handed to the contenders in the order they queued up.
+	for i, lock := range contenders {
+		require.NoError(t, holder.Unlock())
+		select {
+		case n := <-acquired:
+			require.Equal(t, i, n)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("contender %d should have acquired the lock", i)
+		}
+		holder = lock
+	}
+	require.NoError(t, holder.Unlock())
+	requireQueueLen(0)
+}
+
+func TestAPI_LockFair_Reacquire(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+
+	holder, err := c.LockOpts(&LockOptions{Key: "test/lock", Fair: true})
+	require.NoError(t, err)
+	leaderCh, err := holder.Lock(nil)
+	require.NoError(t, err)
+	require.NotNil(t, leaderCh)
+
+	// Leave a queue entry behind for the session of the first contender,
+	// as an earlier attempt whose cleanup failed would.
+	first, session := createTestLock(t, c, "test/lock")
+	defer session.Destroy(first.opts.Session, nil)
+	first.opts.Fair = true
+	made, _, err := c.KV().Acquire(first.queueEntry(first.opts.Session), nil)
+	require.NoError(t, err)
+	require.True(t, made)
+
+	second, session := createTestLock(t, c, "test/lock")
+	defer session.Destroy(second.opts.Session, nil)
+	second.opts.Fair = true
+
+	acquired := make(chan *Lock, 2)
+	lock := func(l *Lock) {
+		ch, err := l.Lock(nil)
+		if err != nil {
+			t.Errorf("err: %v", err)
+		}
+		if ch != nil {
+			acquired <- l
+		}
+	}
+	requireQueue := func(sessions ...string) {
+		t.Helper()
+		retry.Run(t, func(r *retry.R) {
+			pairs, _, err := c.KV().List("test/lock"+LockQueueSuffix, nil)
+			require.NoError(r, err)
+			live, _ := queueEntries("test/lock"+LockQueueSuffix, pairs)
+			var got []string
+			for _, pair := range live {
+				got = append(got, pair.Session)
+			}
+			require.Equal(r, sessions, got)
+		})
+	}
+
+	go lock(second)
+	requireQueue(holder.lockSession, first.opts.Session, second.opts.Session)
+
+	// Trying again moves the first contender to the back of the queue.
+	go lock(first)
+	requireQueue(holder.lockSession, second.opts.Session, first.opts.Session)
+
+	for _, want := range []*Lock{second, first} {
+		require.NoError(t, holder.Unlock())
+		select {
+		case l := <-acquired:
+			require.Same(t, want, l)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("contender should have acquired the lock")
+		}
+		holder = want
+	}
+	require.NoError(t, holder.Unlock())
+}
+
 func TestAPI_LockDeleteKey(t *testing.T) {
 	t.Parallel()
 	c, s := makeClientWithoutConnect(t)
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"sort"
+	"strings"
+)
+
+// queueEntries returns the live entries of a fair queue, in the order the
+// contenders joined it. Each contender holds an entry named after its
+// session under prefix, so an entry is live while it is held by that
+// session. The entries of invalidated sessions are returned as dead so that
+// they can be cleaned up. Names starting with a dot are reserved for other
+// keys used for coordination, such as the semaphore lock.
+func queueEntries(prefix string, pairs KVPairs) (live, dead KVPairs) {
+	for _, pair := range pairs {
+		name := strings.TrimPrefix(pair.Key, prefix)
+		if name == "" || strings.HasPrefix(name, ".") || strings.Contains(name, "/") {
+			continue
+		}
+		if pair.Session == name {
+			live = append(live, pair)
+		} else {
+			dead = append(dead, pair)
+		}
+	}
+
+	// The create index of an entry is the Raft index it was written at, which
+	// gives a total order over the contenders.
+	sort.SliceStable(live, func(i, j int) bool {
+		if live[i].CreateIndex == live[j].CreateIndex {
+			return live[i].Key < live[j].Key
+		}
+		return live[i].CreateIndex < live[j].CreateIndex
+	})
+	return live, dead
+}
+
+// queuePosition returns the position of the entry at key in the live queue
+// entries, or -1 if it is not found.
+func queuePosition(live KVPairs, key string) int {
+	for i, pair := range live {
+		if pair.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// joinQueue writes entry at the back of a fair queue. An entry left over from
+// an earlier attempt of the same session is deleted first, since acquiring it
+// again would keep its create index and so its place ahead of the contenders
+// that joined since.
+func joinQueue(kv *KV, entry *KVPair, wOpts *WriteOptions) (bool, error) {
+	if _, err := kv.Delete(entry.Key, wOpts); err != nil {
+		return false, err
+	}
+	made, _, err := kv.Acquire(entry, wOpts)
+	return made, err
+}
+
+// waitQueueTurn reads the fair queue under prefix and reports whether entry
+// is among the first slots entries. If it is not, it blocks until the entry
+// slots places ahead of it changes, so that every contender only watches a
+// single other contender instead of all of them waking up on each release.
+//
+// If entry is missing, for example because an operator deleted it, it is
+// recreated at the back of the queue.
+func waitQueueTurn(kv *KV, prefix string, entry *KVPair, slots int, qOpts QueryOptions, wOpts *WriteOptions) (bool, error) {
+	qOpts.WaitIndex = 0
+	wait := qOpts.WaitTime
+	qOpts.WaitTime = 0
+	pairs, _, err := kv.List(prefix, &qOpts)
+	if err != nil {
+		return false, err
+	}
+
+	live, dead := queueEntries(prefix, pairs)
+	for _, pair := range dead {
+		// Best effort, another contender may be cleaning up as well.
+		_, _, _ = kv.DeleteCAS(pair, wOpts)
+	}
+
+	pos := queuePosition(live, entry.Key)
+	if pos < 0 {
+		if _, _, err := kv.Acquire(entry, wOpts); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	if pos < slots {
+		return true, nil
+	}
+
+	ahead := live[pos-slots]
+	qOpts.WaitIndex = ahead.ModifyIndex
+	qOpts.WaitTime = wait
+	if _, _, err := kv.Get(ahead.Key, &qOpts); err != nil {
+		return false, err
+	}
+	return false, nil
+}
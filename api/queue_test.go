@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_QueueEntries(t *testing.T) {
+	pairs := KVPairs{
+		{Key: "q/.lock", CreateIndex: 1},
+		{Key: "q/c", Session: "c", CreateIndex: 9},
+		{Key: "q/a", Session: "a", CreateIndex: 5},
+		{Key: "q/dead", CreateIndex: 2},
+		{Key: "q/moved", Session: "b", CreateIndex: 3},
+		{Key: "q/b", Session: "b", CreateIndex: 7},
+		{Key: "q/nested/d", Session: "d", CreateIndex: 4},
+	}
+
+	live, dead := queueEntries("q/", pairs)
+
+	var keys []string
+	for _, pair := range live {
+		keys = append(keys, pair.Key)
+	}
+	require.Equal(t, []string{"q/a", "q/b", "q/c"}, keys)
+	require.Equal(t, 1, queuePosition(live, "q/b"))
+	require.Equal(t, -1, queuePosition(live, "q/dead"))
+
+	keys = nil
+	for _, pair := range dead {
+		keys = append(keys, pair.Key)
+	}
+	require.Equal(t, []string{"q/dead", "q/moved"}, keys)
+}
@@ -76,6 +76,7 @@ type SemaphoreOptions struct {
 	MonitorRetryTime  time.Duration // Optional, defaults to DefaultMonitorRetryTime
 	SemaphoreWaitTime time.Duration // Optional, defaults to DefaultSemaphoreWaitTime
 	SemaphoreTryOnce  bool          // Optional, defaults to false which means try forever
+	Fair              bool          // Optional, defaults to false which means contenders race for a slot
 	Namespace         string        `json:",omitempty"` // Optional, defaults to API client config, namespace of ACL token, or "default" namespace
 }
 
@@ -146,6 +147,11 @@ func (c *Client) SemaphoreOpts(opts *SemaphoreOptions) (*Semaphore, error) {
 // created without any associated health checks. By default Consul sessions
 // prefer liveness over safety and an application must be able to handle
 // the session being lost.
+//
+// If the Fair option is set, slots are handed out in the order contenders
+// called Acquire. Each contender only watches the contender Limit places ahead
+// of it in the queue, so a release wakes up a single contender. All the
+// contenders of a semaphore must agree on the Fair option.
 func (s *Semaphore) Acquire(stopCh <-chan struct{}) (<-chan struct{}, error) {
 	// Hold the lock as we try to acquire
 	s.l.Lock()
@@ -182,11 +188,28 @@ func (s *Semaphore) Acquire(stopCh <-chan struct{}) (<-chan struct{}, error) {
 	kv := s.c.KV()
 	wOpts := WriteOptions{Namespace: s.opts.Namespace}
 
-	made, _, err := kv.Acquire(s.contenderEntry(s.lockSession), &wOpts)
+	contender := s.contenderEntry(s.lockSession)
+	var made bool
+	var err error
+	if s.opts.Fair {
+		made, err = joinQueue(kv, contender, &wOpts)
+	} else {
+		made, _, err = kv.Acquire(contender, &wOpts)
+	}
 	if err != nil || !made {
 		return nil, fmt.Errorf("failed to make contender entry: %v", err)
 	}
 
+	// In fair mode, leave the queue if we give up so we don't hold up the
+	// contenders behind us
+	if s.opts.Fair {
+		defer func() {
+			if !s.isHeld {
+				kv.Delete(contender.Key, &wOpts)
+			}
+		}()
+	}
+
 	// Setup the query options
 	qOpts := QueryOptions{
 		WaitTime:  s.opts.SemaphoreWaitTime,
@@ -215,6 +238,18 @@ WAIT:
 	}
 	attempts++
 
+	// In fair mode, only the first Limit contenders of the queue contend
+	// for a slot
+	if s.opts.Fair {
+		turn, err := waitQueueTurn(kv, path.Clean(s.opts.Prefix)+"/", contender, s.opts.Limit, qOpts, &wOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read prefix: %v", err)
+		}
+		if !turn {
+			goto WAIT
+		}
+	}
+
 	// Read the prefix
 	pairs, meta, err := kv.List(s.opts.Prefix, &qOpts)
 	if err != nil {
@@ -12,6 +12,10 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 )
 
 func createTestSemaphore(t *testing.T, c *Client, prefix string, limit int) (*Semaphore, *Session) {
@@ -545,3 +549,69 @@ func TestAPI_SemaphoreOneShot(t *testing.T) {
 		t.Fatalf("should have acquired the semaphore")
 	}
 }
+
+func TestAPI_SemaphoreFair(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	newSemaphore := func() *Semaphore {
+		sema, err := c.SemaphoreOpts(&SemaphoreOptions{Prefix: "test/semaphore", Limit: 2, Fair: true})
+		require.NoError(t, err)
+		return sema
+	}
+	requireContenders := func(n int) {
+		t.Helper()
+		retry.Run(t, func(r *retry.R) {
+			pairs, _, err := c.KV().List("test/semaphore/", nil)
+			require.NoError(r, err)
+			live, _ := queueEntries("test/semaphore/", pairs)
+			require.Len(r, live, n)
+		})
+	}
+
+	// Fill up the slots.
+	var holders []*Semaphore
+	for i := 0; i < 2; i++ {
+		sema := newSemaphore()
+		ch, err := sema.Acquire(nil)
+		require.NoError(t, err)
+		require.NotNil(t, ch)
+		holders = append(holders, sema)
+	}
+
+	// Queue up contenders one after the other.
+	acquired := make(chan int, 3)
+	var contenders []*Semaphore
+	for i := 0; i < 3; i++ {
+		sema := newSemaphore()
+		contenders = append(contenders, sema)
+		go func(i int) {
+			ch, err := sema.Acquire(nil)
+			if err != nil {
+				t.Errorf("err: %v", err)
+			}
+			if ch != nil {
+				acquired <- i
+			}
+		}(i)
+		requireContenders(i + 3)
+	}
+
+	// Each release hands a slot to the next contender in the queue.
+	for i, sema := range contenders {
+		require.NoError(t, holders[0].Release())
+		holders = holders[1:]
+		select {
+		case n := <-acquired:
+			require.Equal(t, i, n)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("contender %d should have acquired a slot", i)
+		}
+		holders = append(holders, sema)
+	}
+	for _, sema := range holders {
+		require.NoError(t, sema.Release())
+	}
+	requireContenders(0)
+}
@@ -18,6 +18,11 @@ const (
 	// behavior to delete all associated locks on session invalidation.
 	// It can be used in a way similar to Ephemeral Nodes in ZooKeeper.
 	SessionBehaviorDelete = "delete"
+
+	// SessionBehaviorTransfer hands all associated locks to the first of the
+	// session's Successors that still exists on session invalidation, keeping
+	// the contents of the keys. If none exists the locks are released.
+	SessionBehaviorTransfer = "transfer"
 )
 
 var ErrSessionExpired = errors.New("session expired")
@@ -40,6 +45,11 @@ type SessionEntry struct {
 	// When associating checks with sessions, namespaces can be specified for service checks.
 	NodeChecks    []string
 	ServiceChecks []ServiceCheck
+
+	// Successors is the ordered list of sessions that take over the locks
+	// of this session when it is invalidated. It is only used by the
+	// SessionBehaviorTransfer behavior.
+	Successors []string `json:",omitempty"`
 }
 
 type ServiceCheck struct {
@@ -75,6 +85,9 @@ func (s *Session) CreateNoChecks(se *SessionEntry, q *WriteOptions) (string, *Wr
 		if se.Behavior != "" {
 			body["Behavior"] = se.Behavior
 		}
+		if len(se.Successors) > 0 {
+			body["Successors"] = se.Successors
+		}
 		if se.TTL != "" {
 			body["TTL"] = se.TTL
 		}
@@ -111,6 +124,9 @@ func (s *Session) Create(se *SessionEntry, q *WriteOptions) (string, *WriteMeta,
 		if se.Behavior != "" {
 			body["Behavior"] = se.Behavior
 		}
+		if len(se.Successors) > 0 {
+			body["Successors"] = se.Successors
+		}
 		if se.TTL != "" {
 			body["TTL"] = se.TTL
 		}
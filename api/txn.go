@@ -27,6 +27,7 @@ type TxnOp struct {
 	Node    *NodeTxnOp
 	Service *ServiceTxnOp
 	Check   *CheckTxnOp
+	If      *IfTxnOp `json:",omitempty"`
 }
 
 // TxnOps is a list of transaction operations.
@@ -38,6 +39,7 @@ type TxnResult struct {
 	Node    *Node
 	Service *AgentService
 	Check   *HealthCheck
+	If      *IfTxnResult
 }
 
 // TxnResults is a list of TxnResult objects.
@@ -75,6 +77,27 @@ const (
 	KVCheckSession   KVOp = "check-session"
 	KVCheckIndex     KVOp = "check-index"
 	KVCheckNotExists KVOp = "check-not-exists"
+
+	// KVCompareValue compares the value of a key with the given value using
+	// the Comparison operator.
+	KVCompareValue KVOp = "compare-value"
+
+	// KVComparePrefixCount compares the number of keys under a prefix with
+	// the given Count using the Comparison operator.
+	KVComparePrefixCount KVOp = "compare-prefix-count"
+)
+
+// KVComparison constants give the operators available to the compare-value
+// and compare-prefix-count operations. Values are compared byte-wise.
+type KVComparison string
+
+const (
+	KVCompareEqual          KVComparison = "equal"
+	KVCompareNotEqual       KVComparison = "not-equal"
+	KVCompareLess           KVComparison = "less"
+	KVCompareLessOrEqual    KVComparison = "less-or-equal"
+	KVCompareGreater        KVComparison = "greater"
+	KVCompareGreaterOrEqual KVComparison = "greater-or-equal"
 )
 
 // KVTxnOp defines a single operation inside a transaction.
@@ -87,6 +110,18 @@ type KVTxnOp struct {
 	Session   string
 	Namespace string `json:",omitempty"`
 	Partition string `json:",omitempty"`
+
+	// Comparison is the operator used by the compare-value and
+	// compare-prefix-count operations. It defaults to KVCompareEqual.
+	Comparison KVComparison `json:",omitempty"`
+
+	// Count is the number of keys the compare-prefix-count operation
+	// compares against.
+	Count uint64 `json:",omitempty"`
+
+	// TTL is the time to live of the key for operations that write it, such
+	// as "30s".
+	TTL string `json:",omitempty"`
 }
 
 // KVTxnOps defines a set of operations to be performed inside a single
@@ -138,6 +173,12 @@ const (
 	ServiceCAS       ServiceOp = "cas"
 	ServiceDelete    ServiceOp = "delete"
 	ServiceDeleteCAS ServiceOp = "delete-cas"
+
+	// ServiceCheckHealth fails unless the service instance and the node it
+	// is registered on have only passing health checks. The service is
+	// looked up by Service.ID, which defaults to Service.Service, and
+	// Service.Service must name the service.
+	ServiceCheckHealth ServiceOp = "check-health"
 )
 
 // ServiceTxnOp defines a single operation inside a transaction.
@@ -164,6 +205,26 @@ type CheckTxnOp struct {
 	Check HealthCheck
 }
 
+// IfTxnOp defines a conditional operation inside a transaction. The Then
+// operations are run if all of the Conditions hold, otherwise the Else
+// operations are run. Conditions may only use the check-index,
+// check-session, check-not-exists, compare-value and compare-prefix-count
+// K/V operations and the check-health service operation. A condition that
+// doesn't hold selects the Else branch instead of failing the transaction.
+type IfTxnOp struct {
+	Conditions TxnOps
+	Then       TxnOps
+	Else       TxnOps
+}
+
+// IfTxnResult is the outcome of a conditional operation. It precedes the
+// results of the operations of the branch that was run.
+type IfTxnResult struct {
+	// Succeeded is true if all of the conditions held and the Then branch
+	// was run.
+	Succeeded bool
+}
+
 // Txn is used to apply multiple Consul operations in a single, atomic transaction.
 //
 // Note that Go will perform the required base64 encoding on the values
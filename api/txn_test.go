@@ -833,3 +833,98 @@ func TestAPI_ClientTxnWrite(t *testing.T) {
 	}
 	require.Equal(t, expectedEntries, entries)
 }
+
+func TestAPI_ClientTxnIf(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	_, err := c.Catalog().Register(&CatalogRegistration{
+		Node:    "foo",
+		Address: "2.2.2.2",
+		Service: &AgentService{
+			ID:      "web1",
+			Service: "web",
+		},
+		Checks: HealthChecks{
+			{
+				CheckID:   "web-check",
+				Name:      "web",
+				Status:    HealthPassing,
+				ServiceID: "web1",
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	// Promote the canary only while the service is healthy and the
+	// release is still the one we staged.
+	_, err = c.KV().Put(&KVPair{Key: "release/staged", Value: []byte("v2")}, nil)
+	require.NoError(t, err)
+
+	promote := TxnOps{
+		{
+			If: &IfTxnOp{
+				Conditions: TxnOps{
+					{
+						Service: &ServiceTxnOp{
+							Verb:    ServiceCheckHealth,
+							Node:    "foo",
+							Service: AgentService{ID: "web1", Service: "web"},
+						},
+					},
+					{
+						KV: &KVTxnOp{
+							Verb:  KVCompareValue,
+							Key:   "release/staged",
+							Value: []byte("v2"),
+						},
+					},
+					{
+						KV: &KVTxnOp{
+							Verb:       KVComparePrefixCount,
+							Key:        "release/",
+							Comparison: KVCompareLessOrEqual,
+							Count:      1,
+						},
+					},
+				},
+				Then: TxnOps{
+					{
+						KV: &KVTxnOp{
+							Verb:  KVSet,
+							Key:   "release/live",
+							Value: []byte("v2"),
+						},
+					},
+				},
+				Else: TxnOps{
+					{
+						KV: &KVTxnOp{
+							Verb: KVGetTree,
+							Key:  "release/",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ok, ret, _, err := c.Txn().Txn(promote, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, ret.Results, 2)
+	require.Equal(t, &IfTxnResult{Succeeded: true}, ret.Results[0].If)
+	require.Equal(t, "release/live", ret.Results[1].KV.Key)
+
+	// There are two keys under the prefix now, so the else branch runs.
+	ok, ret, _, err = c.Txn().Txn(promote, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, ret.Results, 3)
+	require.Equal(t, &IfTxnResult{Succeeded: false}, ret.Results[0].If)
+	require.Equal(t, "release/live", ret.Results[1].KV.Key)
+	require.Equal(t, "release/staged", ret.Results[2].KV.Key)
+}
@@ -6,6 +6,7 @@ package watch
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	consulapi "github.com/hashicorp/consul/api"
 )
@@ -19,16 +20,20 @@ var watchFuncFactory map[string]watchFactory
 
 func init() {
 	watchFuncFactory = map[string]watchFactory{
-		"key":           keyWatch,
-		"keyprefix":     keyPrefixWatch,
-		"services":      servicesWatch,
-		"nodes":         nodesWatch,
-		"service":       serviceWatch,
-		"checks":        checksWatch,
-		"event":         eventWatch,
-		"connect_roots": connectRootsWatch,
-		"connect_leaf":  connectLeafWatch,
-		"agent_service": agentServiceWatch,
+		"key":            keyWatch,
+		"keyprefix":      keyPrefixWatch,
+		"services":       servicesWatch,
+		"nodes":          nodesWatch,
+		"service":        serviceWatch,
+		"checks":         checksWatch,
+		"event":          eventWatch,
+		"connect_roots":  connectRootsWatch,
+		"connect_leaf":   connectLeafWatch,
+		"agent_service":  agentServiceWatch,
+		"config_entry":   configEntryWatch,
+		"intentions":     intentionsWatch,
+		"peerings":       peeringsWatch,
+		"service_health": serviceHealthWatch,
 	}
 }
 
@@ -337,10 +342,255 @@ func agentServiceWatch(params map[string]interface{}) (WatcherFunc, error) {
 	return fn, nil
 }
 
+// configEntryWatch is used to watch the config entries of a kind, or a single
+// config entry if a name is given.
+func configEntryWatch(params map[string]interface{}) (WatcherFunc, error) {
+	stale := false
+	if err := assignValueBool(params, "stale", &stale); err != nil {
+		return nil, err
+	}
+
+	var kind, name, filter string
+	if err := assignValue(params, "kind", &kind); err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		return nil, fmt.Errorf("Must specify a config entry kind to watch")
+	}
+	if err := assignValue(params, "name", &name); err != nil {
+		return nil, err
+	}
+	// The servers only filter service-defaults entries. The filter of the
+	// other kinds is left to the plan, which applies it to the result.
+	if kind == consulapi.ServiceDefaults {
+		if err := assignValue(params, "filter", &filter); err != nil {
+			return nil, err
+		}
+	}
+
+	fn := func(p *Plan) (BlockingParamVal, interface{}, error) {
+		configEntries := p.client.ConfigEntries()
+		opts := makeQueryOptionsWithContext(p, stale)
+		if filter != "" {
+			opts.Filter = filter
+		}
+		defer p.cancelFunc()
+
+		// A single entry is picked out of the list, as reading a missing
+		// entry returns an error rather than an index to block on.
+		entries, meta, err := configEntries.List(kind, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		if name == "" {
+			return WaitIndexVal(meta.LastIndex), entries, err
+		}
+		for _, entry := range entries {
+			if entry.GetName() == name {
+				return WaitIndexVal(meta.LastIndex), entry, err
+			}
+		}
+		return WaitIndexVal(meta.LastIndex), nil, err
+	}
+	return fn, nil
+}
+
+// intentionsWatch is used to watch all of the intentions, or the intentions
+// that match a destination service.
+func intentionsWatch(params map[string]interface{}) (WatcherFunc, error) {
+	stale := false
+	if err := assignValueBool(params, "stale", &stale); err != nil {
+		return nil, err
+	}
+
+	var destination, filter string
+	if err := assignValue(params, "destination", &destination); err != nil {
+		return nil, err
+	}
+	if err := assignValue(params, "filter", &filter); err != nil {
+		return nil, err
+	}
+	if destination != "" && filter != "" {
+		return nil, fmt.Errorf("Cannot specify destination and filter")
+	}
+
+	fn := func(p *Plan) (BlockingParamVal, interface{}, error) {
+		connect := p.client.Connect()
+		opts := makeQueryOptionsWithContext(p, stale)
+		defer p.cancelFunc()
+
+		if destination == "" {
+			if filter != "" {
+				opts.Filter = filter
+			}
+			intentions, meta, err := connect.Intentions(&opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			return WaitIndexVal(meta.LastIndex), intentions, err
+		}
+
+		matches, meta, err := connect.IntentionMatch(&consulapi.IntentionMatch{
+			By:    consulapi.IntentionMatchDestination,
+			Names: []string{destination},
+		}, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return WaitIndexVal(meta.LastIndex), matches[destination], err
+	}
+	return fn, nil
+}
+
+// peeringsWatch is used to watch the state of all of the peerings, or of a
+// single peering if a name is given.
+func peeringsWatch(params map[string]interface{}) (WatcherFunc, error) {
+	// We don't support stale since peerings are always read from the leader.
+
+	var name string
+	if err := assignValue(params, "name", &name); err != nil {
+		return nil, err
+	}
+
+	fn := func(p *Plan) (BlockingParamVal, interface{}, error) {
+		peerings := p.client.Peerings()
+		opts := makeQueryOptionsWithContext(p, false)
+		defer p.cancelFunc()
+
+		if name == "" {
+			list, meta, err := peerings.List(opts.Context(), &opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			return WaitIndexVal(meta.LastIndex), list, err
+		}
+
+		peering, meta, err := peerings.Read(opts.Context(), name, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		if peering == nil {
+			return WaitIndexVal(meta.LastIndex), nil, err
+		}
+		return WaitIndexVal(meta.LastIndex), peering, err
+	}
+	return fn, nil
+}
+
+// ServiceHealthTransition is a change of the aggregated health of a service
+// instance, as returned by the service_health watch type. The old status is
+// empty for an instance that was registered, and the new status is empty for
+// an instance that was deregistered.
+type ServiceHealthTransition struct {
+	Node        string
+	ServiceID   string
+	ServiceName string
+	OldStatus   string
+	NewStatus   string
+}
+
+// serviceHealthWatch is used to watch the health of the instances of a
+// service, returning the instances whose health changed.
+func serviceHealthWatch(params map[string]interface{}) (WatcherFunc, error) {
+	stale := false
+	filter := ""
+	if err := assignValueBool(params, "stale", &stale); err != nil {
+		return nil, err
+	}
+	if err := assignValue(params, "filter", &filter); err != nil {
+		return nil, err
+	}
+
+	var service string
+	if err := assignValue(params, "service", &service); err != nil {
+		return nil, err
+	}
+	if service == "" {
+		return nil, fmt.Errorf("Must specify a single service to watch")
+	}
+
+	type instance struct {
+		node, serviceID string
+	}
+	var (
+		statuses    map[instance]string
+		transitions []*ServiceHealthTransition
+	)
+
+	fn := func(p *Plan) (BlockingParamVal, interface{}, error) {
+		health := p.client.Health()
+		opts := makeQueryOptionsWithContext(p, stale)
+		if filter != "" {
+			opts.Filter = filter
+		}
+		defer p.cancelFunc()
+		entries, meta, err := health.Service(service, "", false, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		current := make(map[instance]string, len(entries))
+		var changed []*ServiceHealthTransition
+		for _, entry := range entries {
+			key := instance{node: entry.Node.Node, serviceID: entry.Service.ID}
+			status := entry.Checks.AggregatedStatus()
+			current[key] = status
+			if statuses == nil {
+				continue
+			}
+			if old, ok := statuses[key]; !ok || old != status {
+				changed = append(changed, &ServiceHealthTransition{
+					Node:        key.node,
+					ServiceID:   key.serviceID,
+					ServiceName: entry.Service.Service,
+					OldStatus:   old,
+					NewStatus:   status,
+				})
+			}
+		}
+		for key, old := range statuses {
+			if _, ok := current[key]; !ok {
+				changed = append(changed, &ServiceHealthTransition{
+					Node:        key.node,
+					ServiceID:   key.serviceID,
+					ServiceName: service,
+					OldStatus:   old,
+				})
+			}
+		}
+		// The first run only records the existing instances, which are not
+		// transitions.
+		if statuses == nil {
+			statuses = current
+			transitions = []*ServiceHealthTransition{}
+			return WaitIndexVal(meta.LastIndex), transitions, err
+		}
+		statuses = current
+
+		// Without any transitions the last ones are returned again, so that
+		// the handler is not invoked.
+		if len(changed) == 0 && transitions != nil {
+			return WaitIndexVal(meta.LastIndex), transitions, err
+		}
+		sort.Slice(changed, func(i, j int) bool {
+			if changed[i].Node == changed[j].Node {
+				return changed[i].ServiceID < changed[j].ServiceID
+			}
+			return changed[i].Node < changed[j].Node
+		})
+		if changed == nil {
+			changed = []*ServiceHealthTransition{}
+		}
+		transitions = changed
+		return WaitIndexVal(meta.LastIndex), transitions, err
+	}
+	return fn, nil
+}
+
 func makeQueryOptionsWithContext(p *Plan, stale bool) consulapi.QueryOptions {
 	ctx, cancel := context.WithCancel(context.Background())
 	p.setCancelFunc(cancel)
-	opts := consulapi.QueryOptions{AllowStale: stale}
+	opts := consulapi.QueryOptions{AllowStale: stale, WaitTime: p.waitTime}
 	switch param := p.lastParamVal.(type) {
 	case WaitIndexVal:
 		opts.WaitIndex = uint64(param)
@@ -5,6 +5,7 @@ package watch_test
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -1582,6 +1583,454 @@ func TestAgentServiceWatch(t *testing.T) {
 	}
 }
 
+func TestConfigEntryWatch(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	var (
+		wakeups  []api.ConfigEntry
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"config_entry", "kind":"service-defaults", "name":"web"}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		var v api.ConfigEntry
+		if raw != nil { // nil is a valid return value
+			var ok bool
+			if v, ok = raw.(api.ConfigEntry); !ok {
+				return // ignore
+			}
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// Wait for first wakeup.
+	<-notifyCh
+	{
+		entries := c.ConfigEntries()
+
+		// An entry with another name does not wake up the watch.
+		_, _, err := entries.Set(&api.ServiceConfigEntry{
+			Kind:     api.ServiceDefaults,
+			Name:     "api",
+			Protocol: "grpc",
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = entries.Set(&api.ServiceConfigEntry{
+			Kind:     api.ServiceDefaults,
+			Name:     "web",
+			Protocol: "http",
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+
+	{
+		v := wakeups[0]
+		require.Nil(t, v)
+	}
+	{
+		v, ok := wakeups[1].(*api.ServiceConfigEntry)
+		require.True(t, ok)
+		require.Equal(t, "web", v.Name)
+		require.Equal(t, "http", v.Protocol)
+	}
+}
+
+func TestConfigEntryWatch_Filter(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	var (
+		wakeups  [][]api.ConfigEntry
+		notifyCh = make(chan struct{})
+	)
+
+	// The servers do not filter service-resolver entries, so the filter is
+	// applied to the result.
+	plan := mustParse(t, `{"type":"config_entry", "kind":"service-resolver", "filter":"Meta.env == \"prod\""}`)
+	plan.ResultFilter = func(result interface{}) (interface{}, error) {
+		var out []api.ConfigEntry
+		for _, entry := range result.([]api.ConfigEntry) {
+			if entry.GetMeta()["env"] == "prod" {
+				out = append(out, entry)
+			}
+		}
+		return out, nil
+	}
+	plan.Handler = func(idx uint64, raw interface{}) {
+		v, ok := raw.([]api.ConfigEntry)
+		if !ok {
+			return // ignore
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// Wait for first wakeup.
+	<-notifyCh
+	{
+		entries := c.ConfigEntries()
+
+		// An entry that does not match the filter does not wake up the watch.
+		_, _, err := entries.Set(&api.ServiceResolverConfigEntry{
+			Kind: api.ServiceResolver,
+			Name: "api",
+			Meta: map[string]string{"env": "dev"},
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = entries.Set(&api.ServiceResolverConfigEntry{
+			Kind: api.ServiceResolver,
+			Name: "web",
+			Meta: map[string]string{"env": "prod"},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+	require.Len(t, wakeups[0], 0)
+	require.Len(t, wakeups[1], 1)
+	require.Equal(t, "web", wakeups[1][0].GetName())
+}
+
+func TestIntentionsWatch_Destination(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	var (
+		wakeups  [][]*api.Intention
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"intentions", "destination":"web"}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		v, ok := raw.([]*api.Intention)
+		if !ok {
+			return // ignore
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// Wait for first wakeup.
+	<-notifyCh
+	{
+		_, err := c.Connect().IntentionUpsert(&api.Intention{
+			SourceName:      "api",
+			DestinationName: "web",
+			Action:          api.IntentionActionAllow,
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+
+	{
+		v := wakeups[0]
+		require.Len(t, v, 0)
+	}
+	{
+		v := wakeups[1]
+		require.Len(t, v, 1)
+		require.Equal(t, "api", v[0].SourceName)
+		require.Equal(t, api.IntentionActionAllow, v[0].Action)
+	}
+}
+
+func TestPeeringsWatch(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	var (
+		wakeups  []*api.Peering
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"peerings", "name":"peer1"}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		var v *api.Peering
+		if raw != nil { // nil is a valid return value
+			var ok bool
+			if v, ok = raw.(*api.Peering); !ok {
+				return // ignore
+			}
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// Wait for first wakeup.
+	<-notifyCh
+	{
+		_, _, err := c.Peerings().GenerateToken(context.Background(), api.PeeringGenerateTokenRequest{
+			PeerName: "peer1",
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+
+	{
+		v := wakeups[0]
+		require.Nil(t, v)
+	}
+	{
+		v := wakeups[1]
+		require.Equal(t, "peer1", v.Name)
+		require.Equal(t, api.PeeringStatePending, v.State)
+	}
+}
+
+func TestServiceHealthWatch(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	var (
+		wakeups  [][]*watch.ServiceHealthTransition
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"service_health", "service":"foo"}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		v, ok := raw.([]*watch.ServiceHealthTransition)
+		if !ok {
+			return // ignore
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	register := func(status string) {
+		reg := &api.CatalogRegistration{
+			Node:       "foobar",
+			Address:    "1.1.1.1",
+			Datacenter: "dc1",
+			Service: &api.AgentService{
+				ID:      "foo1",
+				Service: "foo",
+			},
+			Check: &api.AgentCheck{
+				Node:      "foobar",
+				CheckID:   "foo1",
+				Name:      "foo1",
+				Status:    status,
+				ServiceID: "foo1",
+			},
+		}
+		_, err := c.Catalog().Register(reg, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for first wakeup.
+	<-notifyCh
+	register(api.HealthPassing)
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	// Registering the instance again with the same status changes the index
+	// without a transition, which does not wake up the watch.
+	register(api.HealthPassing)
+	register(api.HealthCritical)
+
+	// Wait for third wakeup.
+	<-notifyCh
+	{
+		_, err := c.Catalog().Deregister(&api.CatalogDeregistration{
+			Node:      "foobar",
+			ServiceID: "foo1",
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	// Wait for fourth wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 4)
+	require.Len(t, wakeups[0], 0)
+	require.Equal(t, []*watch.ServiceHealthTransition{{
+		Node:        "foobar",
+		ServiceID:   "foo1",
+		ServiceName: "foo",
+		NewStatus:   api.HealthPassing,
+	}}, wakeups[1])
+	require.Equal(t, []*watch.ServiceHealthTransition{{
+		Node:        "foobar",
+		ServiceID:   "foo1",
+		ServiceName: "foo",
+		OldStatus:   api.HealthPassing,
+		NewStatus:   api.HealthCritical,
+	}}, wakeups[2])
+	require.Equal(t, []*watch.ServiceHealthTransition{{
+		Node:        "foobar",
+		ServiceID:   "foo1",
+		ServiceName: "foo",
+		OldStatus:   api.HealthCritical,
+	}}, wakeups[3])
+}
+
+func TestServiceHealthWatch_ExistingInstances(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	register := func(id, status string) {
+		reg := &api.CatalogRegistration{
+			Node:       "foobar",
+			Address:    "1.1.1.1",
+			Datacenter: "dc1",
+			Service: &api.AgentService{
+				ID:      id,
+				Service: "foo",
+			},
+			Check: &api.AgentCheck{
+				Node:      "foobar",
+				CheckID:   id,
+				Name:      id,
+				Status:    status,
+				ServiceID: id,
+			},
+		}
+		_, err := c.Catalog().Register(reg, nil)
+		require.NoError(t, err)
+	}
+	register("foo1", api.HealthPassing)
+	register("foo2", api.HealthCritical)
+
+	var (
+		wakeups  [][]*watch.ServiceHealthTransition
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"service_health", "service":"foo"}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		v, ok := raw.([]*watch.ServiceHealthTransition)
+		if !ok {
+			return // ignore
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// The instances that exist when the watch starts are not transitions.
+	<-notifyCh
+	register("foo2", api.HealthPassing)
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+	require.Len(t, wakeups[0], 0)
+	require.Equal(t, []*watch.ServiceHealthTransition{{
+		Node:        "foobar",
+		ServiceID:   "foo2",
+		ServiceName: "foo",
+		OldStatus:   api.HealthCritical,
+		NewStatus:   api.HealthPassing,
+	}}, wakeups[1])
+}
+
 func mustParse(t *testing.T, q string) *watch.Plan {
 	t.Helper()
 	var params map[string]interface{}
@@ -4,7 +4,11 @@
 package watch
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -68,12 +72,27 @@ func (p *Plan) RunWithClientAndHclog(client *consulapi.Client, logger hclog.Logg
 		watchLogger = logger.Named(watchLoggerName)
 	}
 
+	if p.Filter != "" && p.ResultFilter == nil {
+		return fmt.Errorf("watch has a filter but no ResultFilter to apply it")
+	}
+
 	p.client = client
 
 	// Loop until we are canceled
 	failures := 0
 OUTER:
 	for !p.shouldStop() {
+		// Invoke the handler with a result that waited long enough, and
+		// otherwise bound the query by the time left to wait
+		p.waitTime = 0
+		if p.pending != nil {
+			if wait := time.Until(p.deadline); wait > 0 {
+				p.waitTime = wait
+			} else {
+				p.invokeHandler(p.pending.paramVal, p.pending.result, watchLogger)
+			}
+		}
+
 		// Invoke the handler
 		blockParamVal, result, err := p.Watcher(p)
 
@@ -83,6 +102,11 @@ OUTER:
 			break
 		}
 
+		// Apply the filter to the result
+		if err == nil && p.Filter != "" && result != nil {
+			result, err = p.ResultFilter(result)
+		}
+
 		// Handle an error in the watch function
 		if err != nil {
 			// Perform an exponential backoff
@@ -119,20 +143,31 @@ OUTER:
 		if oldParamVal != nil && reflect.DeepEqual(p.lastResult, result) {
 			continue
 		}
-
-		// Handle the updated result
 		p.lastResult = result
-		// If a hybrid handler exists use that
-		if p.HybridHandler != nil {
-			p.HybridHandler(blockParamVal, result)
-		} else if p.Handler != nil {
-			idx, ok := blockParamVal.(WaitIndexVal)
-			if !ok {
-				watchLogger.Error("Handler only supports index-based " +
-					" watches but non index-based watch run. Skipping Handler.")
+
+		// Look for a change of the hash, which ignores the Raft indexes
+		if p.OnlyOnChange {
+			hash, err := hashResult(result)
+			if err != nil {
+				watchLogger.Warn("Failed to hash the result of the watch", "type", p.Type, "error", err)
+			} else if oldParamVal != nil && hash == p.lastHash {
+				continue
 			}
-			p.Handler(uint64(idx), result)
+			p.lastHash = hash
 		}
+
+		// Wait for the result to settle before handling it
+		if p.Debounce > 0 || p.MinInterval > 0 {
+			p.pending = &pendingResult{paramVal: blockParamVal, result: result}
+			p.deadline = time.Now().Add(p.Debounce)
+			if next := p.lastInvoke.Add(p.MinInterval); next.After(p.deadline) {
+				p.deadline = next
+			}
+			continue
+		}
+
+		// Handle the updated result
+		p.invokeHandler(blockParamVal, result, watchLogger)
 	}
 	return nil
 }
@@ -210,6 +245,68 @@ OUTER:
 	return nil
 }
 
+// pendingResult is a result whose handling is delayed by the Debounce or
+// MinInterval of the plan.
+type pendingResult struct {
+	paramVal BlockingParamVal
+	result   interface{}
+}
+
+// invokeHandler handles a result with the handler of the plan.
+func (p *Plan) invokeHandler(blockParamVal BlockingParamVal, result interface{}, logger hclog.Logger) {
+	p.pending = nil
+	p.lastInvoke = time.Now()
+
+	// If a hybrid handler exists use that
+	if p.HybridHandler != nil {
+		p.HybridHandler(blockParamVal, result)
+	} else if p.Handler != nil {
+		idx, ok := blockParamVal.(WaitIndexVal)
+		if !ok {
+			logger.Error("Handler only supports index-based " +
+				" watches but non index-based watch run. Skipping Handler.")
+		}
+		p.Handler(uint64(idx), result)
+	}
+}
+
+// hashResult returns a hash of the JSON encoding of a result, leaving out
+// the CreateIndex and ModifyIndex fields which change without the data.
+func hashResult(result interface{}) (string, error) {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+	raw, err = json.Marshal(stripRaftIndexes(v))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func stripRaftIndexes(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		delete(v, "CreateIndex")
+		delete(v, "ModifyIndex")
+		for key, val := range v {
+			v[key] = stripRaftIndexes(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = stripRaftIndexes(val)
+		}
+	}
+	return v
+}
+
 // Stop is used to stop running the watch plan
 func (p *Plan) Stop() {
 	p.stopLock.Lock()
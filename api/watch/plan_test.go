@@ -4,8 +4,11 @@
 package watch
 
 import (
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -151,3 +154,166 @@ func TestRunWithClientAndLogger_NilLogger(t *testing.T) {
 		t.Fatalf("watcher didn't exit")
 	}
 }
+
+type testResult struct {
+	Name        string
+	ModifyIndex uint64
+}
+
+// scriptedWatch returns the results in turn, one every interval, and then
+// blocks like a query without changes.
+func scriptedWatch(results []interface{}, interval time.Duration) WatcherFunc {
+	return func(p *Plan) (BlockingParamVal, interface{}, error) {
+		idx := 0
+		if i, ok := p.lastParamVal.(WaitIndexVal); ok {
+			idx = int(i)
+		}
+		if idx < len(results) {
+			if idx > 0 {
+				time.Sleep(interval)
+			}
+			return WaitIndexVal(idx + 1), results[idx], nil
+		}
+
+		wait := p.waitTime
+		if wait == 0 {
+			wait = time.Second
+		}
+		select {
+		case <-time.After(wait):
+		case <-p.stopCh:
+		}
+		return WaitIndexVal(idx), results[idx-1], nil
+	}
+}
+
+// runScripted runs the plan until the handler was invoked count times, and
+// returns the results it was invoked with.
+func runScripted(t *testing.T, plan *Plan, count int, timeout time.Duration) []interface{} {
+	var (
+		lock    sync.Mutex
+		handled []interface{}
+	)
+	doneCh := make(chan struct{})
+	plan.Handler = func(idx uint64, val interface{}) {
+		lock.Lock()
+		defer lock.Unlock()
+		handled = append(handled, val)
+		if len(handled) == count {
+			close(doneCh)
+		}
+	}
+
+	go plan.Run("127.0.0.1:8500")
+	defer plan.Stop()
+
+	select {
+	case <-doneCh:
+	case <-time.After(timeout):
+		lock.Lock()
+		defer lock.Unlock()
+		t.Fatalf("handler ran %d times, expected %d: %v", len(handled), count, handled)
+	}
+
+	// Give the plan a chance to invoke the handler again.
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	return handled
+}
+
+// nameFilter keeps the results named "a", like the filter Name == "a".
+func nameFilter(result interface{}) (interface{}, error) {
+	switch result := result.(type) {
+	case []*testResult:
+		var out []*testResult
+		for _, r := range result {
+			if r.Name == "a" {
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	case *testResult:
+		if result.Name == "a" {
+			return result, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestRun_Filter(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "filter":"Name == \"a\""}`)
+	plan.ResultFilter = nameFilter
+	plan.Watcher = scriptedWatch([]interface{}{
+		[]*testResult{{Name: "a"}, {Name: "b"}},
+		[]*testResult{{Name: "a"}, {Name: "c"}},
+		[]*testResult{{Name: "a", ModifyIndex: 3}},
+	}, 10*time.Millisecond)
+
+	// The second result is the same as the first once filtered.
+	handled := runScripted(t, plan, 2, time.Second)
+	require.Equal(t, []interface{}{
+		[]*testResult{{Name: "a"}},
+		[]*testResult{{Name: "a", ModifyIndex: 3}},
+	}, handled)
+}
+
+func TestRun_Filter_single(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "filter":"Name == \"a\""}`)
+	plan.ResultFilter = nameFilter
+	plan.Watcher = scriptedWatch([]interface{}{
+		&testResult{Name: "a"},
+		&testResult{Name: "b"},
+	}, 10*time.Millisecond)
+
+	handled := runScripted(t, plan, 2, time.Second)
+	require.Equal(t, []interface{}{&testResult{Name: "a"}, nil}, handled)
+}
+
+func TestRun_Filter_noResultFilter(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "filter":"Name == \"a\""}`)
+	err := plan.Run("127.0.0.1:8500")
+	require.ErrorContains(t, err, "watch has a filter but no ResultFilter to apply it")
+}
+
+func TestRun_OnlyOnChange(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "only_on_change":true}`)
+	plan.Watcher = scriptedWatch([]interface{}{
+		[]*testResult{{Name: "a", ModifyIndex: 1}},
+		[]*testResult{{Name: "a", ModifyIndex: 2}},
+		[]*testResult{{Name: "b", ModifyIndex: 3}},
+	}, 10*time.Millisecond)
+
+	handled := runScripted(t, plan, 2, time.Second)
+	require.Equal(t, []interface{}{
+		[]*testResult{{Name: "a", ModifyIndex: 1}},
+		[]*testResult{{Name: "b", ModifyIndex: 3}},
+	}, handled)
+}
+
+func TestRun_Debounce(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "debounce":"200ms"}`)
+	plan.Watcher = scriptedWatch([]interface{}{"a", "b", "c", "d"}, 20*time.Millisecond)
+
+	// The burst of changes is handled once with the last result.
+	start := time.Now()
+	handled := runScripted(t, plan, 1, 2*time.Second)
+	require.Equal(t, []interface{}{"d"}, handled)
+	require.GreaterOrEqual(t, time.Since(start), 260*time.Millisecond)
+}
+
+func TestRun_MinInterval(t *testing.T) {
+	t.Parallel()
+	plan := mustParse(t, `{"type":"noop", "min_interval":"300ms"}`)
+	plan.Watcher = scriptedWatch([]interface{}{"a", "b", "c", "d"}, 20*time.Millisecond)
+
+	// The first result is handled right away, and the changes within the
+	// interval are coalesced into the last one.
+	handled := runScripted(t, plan, 2, 2*time.Second)
+	require.Equal(t, []interface{}{"a", "d"}, handled)
+}
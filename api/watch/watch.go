@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -28,6 +30,26 @@ type Plan struct {
 	HandlerType string
 	Exempt      map[string]interface{}
 
+	// Filter is a boolean expression applied to the result of the watch, for
+	// the types that do not filter their results through the API. It is
+	// evaluated by ResultFilter, which must be set when Filter is.
+	Filter string
+
+	// ResultFilter applies Filter to the result of the watch.
+	ResultFilter ResultFilterFunc
+
+	// Debounce delays the handler until the result has not changed for this
+	// long, so that a burst of changes invokes it once with the last result.
+	Debounce time.Duration
+
+	// MinInterval is the shortest time between two invocations of the
+	// handler. Changes within the interval are coalesced into the last one.
+	MinInterval time.Duration
+
+	// OnlyOnChange invokes the handler only when the hash of the result
+	// changed, ignoring the CreateIndex and ModifyIndex fields.
+	OnlyOnChange bool
+
 	Watcher WatcherFunc
 	// Handler is kept for backward compatibility but only supports watches based
 	// on index param. To support hash based watches, set HybridHandler instead.
@@ -42,6 +64,15 @@ type Plan struct {
 	client       *consulapi.Client
 	lastParamVal BlockingParamVal
 	lastResult   interface{}
+	lastHash     string
+
+	// pending is the result waiting for Debounce or MinInterval to elapse
+	// until the deadline, and waitTime bounds the blocking queries until
+	// then.
+	pending    *pendingResult
+	deadline   time.Time
+	lastInvoke time.Time
+	waitTime   time.Duration
 
 	stop       bool
 	stopCh     chan struct{}
@@ -58,6 +89,29 @@ type HttpHandlerConfig struct {
 	TLSSkipVerify bool                `mapstructure:"tls_skip_verify"`
 }
 
+// FileHandlerConfig configures the "file" handler type, which atomically
+// writes the JSON encoded result of the watch to a file.
+type FileHandlerConfig struct {
+	Path       string      `mapstructure:"path"`
+	Mode       os.FileMode `mapstructure:"-"`
+	ModeRaw    string      `mapstructure:"mode"`
+	MaxRetries int         `mapstructure:"max_retries"`
+}
+
+// SocketHandlerConfig configures the "socket" handler type, which writes each
+// result of the watch as a line of JSON to a Unix domain socket over a
+// connection that is kept open between results.
+type SocketHandlerConfig struct {
+	Path       string        `mapstructure:"path"`
+	Timeout    time.Duration `mapstructure:"-"`
+	TimeoutRaw string        `mapstructure:"timeout"`
+	MaxRetries int           `mapstructure:"max_retries"`
+}
+
+// DefaultMaxRetries is the number of times the file and socket handlers retry
+// a result that fails to be written.
+const DefaultMaxRetries = 3
+
 // BlockingParamVal is an interface representing the common operations needed for
 // different styles of blocking. It's used to abstract the core watch plan from
 // whether we are performing index-based or hash-based blocking.
@@ -131,6 +185,11 @@ type HandlerFunc func(uint64, interface{})
 // index-based or hash-based watches via the BlockingParamVal.
 type HybridHandlerFunc func(BlockingParamVal, interface{})
 
+// ResultFilterFunc is used to filter the result of a watch. It returns the
+// elements of a collection that match, and a single result if it matches or
+// nil otherwise.
+type ResultFilterFunc func(interface{}) (interface{}, error)
+
 // Parse takes a watch query and compiles it into a WatchPlan or an error
 func Parse(params map[string]interface{}) (*Plan, error) {
 	return ParseExempt(params, nil)
@@ -175,6 +234,28 @@ func ParseExempt(params map[string]interface{}, exempt []string) (*Plan, error)
 		plan.Exempt["http_handler_config"] = config
 		delete(params, "http_handler_config")
 
+	case "file":
+		if _, ok := params["file_handler_config"]; !ok {
+			return nil, fmt.Errorf("Handler type 'file' requires 'file_handler_config' to be set")
+		}
+		config, err := parseFileHandlerConfig(params["file_handler_config"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse 'file_handler_config': %v", err)
+		}
+		plan.Exempt["file_handler_config"] = config
+		delete(params, "file_handler_config")
+
+	case "socket":
+		if _, ok := params["socket_handler_config"]; !ok {
+			return nil, fmt.Errorf("Handler type 'socket' requires 'socket_handler_config' to be set")
+		}
+		config, err := parseSocketHandlerConfig(params["socket_handler_config"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse 'socket_handler_config': %v", err)
+		}
+		plan.Exempt["socket_handler_config"] = config
+		delete(params, "socket_handler_config")
+
 	case "script":
 		// Let the caller check for configuration in exempt parameters
 	}
@@ -192,6 +273,20 @@ func ParseExempt(params map[string]interface{}, exempt []string) (*Plan, error)
 	}
 	plan.Watcher = fn
 
+	// A filter that is not used by the watch type is applied to its result.
+	if err := assignValue(params, "filter", &plan.Filter); err != nil {
+		return nil, err
+	}
+	if err := assignValueDuration(params, "debounce", &plan.Debounce); err != nil {
+		return nil, err
+	}
+	if err := assignValueDuration(params, "min_interval", &plan.MinInterval); err != nil {
+		return nil, err
+	}
+	if err := assignValueBool(params, "only_on_change", &plan.OnlyOnChange); err != nil {
+		return nil, err
+	}
+
 	// Remove the exempt parameters
 	if len(exempt) > 0 {
 		for _, ex := range exempt {
@@ -227,6 +322,27 @@ func assignValue(params map[string]interface{}, name string, out *string) error
 	return nil
 }
 
+// assignValueDuration is used to extract a value ensuring it is a
+// non-negative duration
+func assignValueDuration(params map[string]interface{}, name string, out *time.Duration) error {
+	var raw string
+	if err := assignValue(params, name, &raw); err != nil {
+		return err
+	}
+	if raw == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return fmt.Errorf("Failed to parse %s: %v", name, err)
+	}
+	if d < 0 {
+		return fmt.Errorf("%s cannot be negative", name)
+	}
+	*out = d
+	return nil
+}
+
 // assignValueBool is used to extract a value ensuring it is a bool
 func assignValueBool(params map[string]interface{}, name string, out *bool) error {
 	if raw, ok := params[name]; ok {
@@ -294,3 +410,65 @@ func parseHttpHandlerConfig(configParams interface{}) (*HttpHandlerConfig, error
 
 	return &config, nil
 }
+
+// decodeHandlerConfig decodes the parameters of a handler, converting numbers
+// given as strings or floats, as JSON numbers are.
+func decodeHandlerConfig(configParams interface{}, out interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           out,
+		WeaklyTypedInput: true,
+		ErrorUnused:      true,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(configParams)
+}
+
+// Parse the 'file_handler_config' parameters
+func parseFileHandlerConfig(configParams interface{}) (*FileHandlerConfig, error) {
+	config := FileHandlerConfig{MaxRetries: DefaultMaxRetries}
+	if err := decodeHandlerConfig(configParams, &config); err != nil {
+		return nil, err
+	}
+
+	if config.Path == "" {
+		return nil, fmt.Errorf("Requires 'path' to be set")
+	}
+	if config.ModeRaw == "" {
+		config.Mode = 0600
+	} else if mode, err := strconv.ParseUint(config.ModeRaw, 8, 32); err != nil || mode > 0777 {
+		return nil, fmt.Errorf("Invalid mode %q, must be octal permissions such as \"0640\"", config.ModeRaw)
+	} else {
+		config.Mode = os.FileMode(mode)
+	}
+	if config.MaxRetries < 0 {
+		return nil, fmt.Errorf("'max_retries' cannot be negative")
+	}
+
+	return &config, nil
+}
+
+// Parse the 'socket_handler_config' parameters
+func parseSocketHandlerConfig(configParams interface{}) (*SocketHandlerConfig, error) {
+	config := SocketHandlerConfig{MaxRetries: DefaultMaxRetries}
+	if err := decodeHandlerConfig(configParams, &config); err != nil {
+		return nil, err
+	}
+
+	if config.Path == "" {
+		return nil, fmt.Errorf("Requires 'path' to be set")
+	}
+	if config.TimeoutRaw == "" {
+		config.Timeout = DefaultTimeout
+	} else if timeout, err := time.ParseDuration(config.TimeoutRaw); err != nil {
+		return nil, fmt.Errorf("Failed to parse timeout: %v", err)
+	} else {
+		config.Timeout = timeout
+	}
+	if config.MaxRetries < 0 {
+		return nil, fmt.Errorf("'max_retries' cannot be negative")
+	}
+
+	return &config, nil
+}
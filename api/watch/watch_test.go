@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestParseBasic(t *testing.T) {
@@ -43,6 +44,67 @@ func TestParse_exempt(t *testing.T) {
 	}
 }
 
+func TestParse_fileHandler(t *testing.T) {
+	t.Parallel()
+	params := makeParams(t, `{"type":"key", "key":"foo", "handler_type":"file",
+		"file_handler_config": {"path": "/tmp/foo.json", "mode": "0640", "max_retries": 5}}`)
+	p, err := Parse(params)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	config := p.Exempt["file_handler_config"].(*FileHandlerConfig)
+	if config.Path != "/tmp/foo.json" || config.Mode != 0640 || config.MaxRetries != 5 {
+		t.Fatalf("bad: %#v", config)
+	}
+
+	params = makeParams(t, `{"type":"key", "key":"foo", "handler_type":"file",
+		"file_handler_config": {"path": "/tmp/foo.json"}}`)
+	p, err = Parse(params)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	config = p.Exempt["file_handler_config"].(*FileHandlerConfig)
+	if config.Mode != 0600 || config.MaxRetries != DefaultMaxRetries {
+		t.Fatalf("bad: %#v", config)
+	}
+
+	for _, raw := range []string{
+		`{"type":"key", "key":"foo", "handler_type":"file"}`,
+		`{"type":"key", "key":"foo", "handler_type":"file", "file_handler_config": {}}`,
+		`{"type":"key", "key":"foo", "handler_type":"file", "file_handler_config": {"path": "a", "mode": "999"}}`,
+		`{"type":"key", "key":"foo", "handler_type":"file", "file_handler_config": {"path": "a", "max_retries": -1}}`,
+		`{"type":"key", "key":"foo", "handler_type":"file", "file_handler_config": {"path": "a", "nope": 1}}`,
+	} {
+		if _, err := Parse(makeParams(t, raw)); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
+
+func TestParse_socketHandler(t *testing.T) {
+	t.Parallel()
+	params := makeParams(t, `{"type":"key", "key":"foo", "handler_type":"socket",
+		"socket_handler_config": {"path": "/tmp/watch.sock", "timeout": "2s"}}`)
+	p, err := Parse(params)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	config := p.Exempt["socket_handler_config"].(*SocketHandlerConfig)
+	if config.Path != "/tmp/watch.sock" || config.Timeout != 2*time.Second || config.MaxRetries != DefaultMaxRetries {
+		t.Fatalf("bad: %#v", config)
+	}
+
+	for _, raw := range []string{
+		`{"type":"key", "key":"foo", "handler_type":"socket"}`,
+		`{"type":"key", "key":"foo", "handler_type":"socket", "socket_handler_config": {"timeout": "2s"}}`,
+		`{"type":"key", "key":"foo", "handler_type":"socket", "socket_handler_config": {"path": "a", "timeout": "soon"}}`,
+	} {
+		if _, err := Parse(makeParams(t, raw)); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
+
 func makeParams(t *testing.T, s string) map[string]interface{} {
 	var out map[string]interface{}
 	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
@@ -51,3 +113,37 @@ func makeParams(t *testing.T, s string) map[string]interface{} {
 	}
 	return out
 }
+
+func TestParse_triggering(t *testing.T) {
+	t.Parallel()
+	params := makeParams(t, `{"type":"key", "key":"foo", "filter":"Flags == 1",
+		"debounce":"2s", "min_interval":"1m", "only_on_change":true}`)
+	p, err := Parse(params)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if p.Filter != "Flags == 1" || p.Debounce != 2*time.Second ||
+		p.MinInterval != time.Minute || !p.OnlyOnChange {
+		t.Fatalf("bad: %#v", p)
+	}
+
+	// The filter of a type that filters through the API is left to it.
+	params = makeParams(t, `{"type":"services", "filter":"foo in ServiceTags"}`)
+	p, err = Parse(params)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if p.Filter != "" {
+		t.Fatalf("bad: %#v", p)
+	}
+
+	for _, raw := range []string{
+		`{"type":"key", "key":"foo", "debounce":"soon"}`,
+		`{"type":"key", "key":"foo", "min_interval":"-1s"}`,
+		`{"type":"key", "key":"foo", "only_on_change":"yes"}`,
+	} {
+		if _, err := Parse(makeParams(t, raw)); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package analyze
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+
+	// flags
+	top int
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.IntVar(&c.top, "top", 10,
+		"The number of functions and log messages to list in each section. Defaults to 10.")
+
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	var path string
+	args = c.flags.Args()
+	switch len(args) {
+	case 0:
+		c.UI.Error("Missing BUNDLE argument")
+		return 1
+	case 1:
+		path = args[0]
+	default:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 1, got %d)", len(args)))
+		return 1
+	}
+
+	dir, cleanup, err := openBundle(path)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error opening debug bundle: %s", err))
+		return 1
+	}
+	defer cleanup()
+
+	b, err := loadBundle(dir)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading debug bundle: %s", err))
+		return 1
+	}
+
+	out, err := c.report(b)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error analyzing debug bundle: %s", err))
+		return 1
+	}
+	c.UI.Output(out)
+	return 0
+}
+
+func (c *cmd) report(b *bundle) (string, error) {
+	goroutines, err := b.goroutines(c.top)
+	if err != nil {
+		return "", err
+	}
+	heap, err := b.heap(c.top)
+	if err != nil {
+		return "", err
+	}
+	latencies, err := b.latencies()
+	if err != nil {
+		return "", err
+	}
+	logs, err := b.logs(c.top)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	section := func(title string) {
+		if buf.Len() > 0 {
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintln(tw, title)
+	}
+
+	section("Bundle")
+	fmt.Fprintf(tw, " Agent Version\t%s\n", b.index.AgentVersion)
+	fmt.Fprintf(tw, " Interval\t%s\n", b.index.Interval)
+	fmt.Fprintf(tw, " Duration\t%s\n", b.index.Duration)
+	fmt.Fprintf(tw, " Targets\t%s\n", strings.Join(b.index.Targets, ", "))
+
+	section("Goroutines")
+	if len(goroutines.Totals) == 0 {
+		fmt.Fprintln(tw, " No goroutine profiles captured")
+	} else {
+		fmt.Fprintln(tw, " Interval\tGoroutines")
+		for _, t := range goroutines.Totals {
+			fmt.Fprintf(tw, " %s\t%d\n", t.Time.Format(time.RFC3339), t.Value)
+		}
+		if len(goroutines.Growth) > 0 {
+			fmt.Fprintln(tw, "\n Growing Function\tGoroutines")
+			for _, g := range goroutines.Growth {
+				fmt.Fprintf(tw, " %s\t+%d\n", g.Function, g.Value)
+			}
+		}
+	}
+
+	section("Heap In Use")
+	if len(heap) == 0 {
+		fmt.Fprintln(tw, " No heap profiles captured")
+	} else {
+		fmt.Fprintln(tw, " Function\tSize")
+		for _, h := range heap {
+			fmt.Fprintf(tw, " %s\t%s\n", h.Function, byteSize(h.Value))
+		}
+	}
+
+	section("Raft and RPC Latency")
+	if len(latencies) == 0 {
+		fmt.Fprintln(tw, " No Raft or RPC timings captured")
+	} else {
+		fmt.Fprintln(tw, " Metric\tCount\tMean\tMax")
+		for _, l := range latencies {
+			max := "-"
+			if l.HasMax {
+				max = fmt.Sprintf("%.3fms", l.Max)
+			}
+			fmt.Fprintf(tw, " %s\t%d\t%.3fms\t%s\n", l.Name, l.Count, l.Mean(), max)
+		}
+	}
+
+	section("Logs")
+	if logs == nil {
+		fmt.Fprintln(tw, " No logs captured")
+	} else {
+		fmt.Fprintln(tw, " Level\tCount")
+		for _, level := range []string{"ERROR", "WARN", "INFO", "DEBUG", "TRACE"} {
+			fmt.Fprintf(tw, " %s\t%d\n", level, logs.Levels[level])
+		}
+		if len(logs.Buckets) > 0 {
+			fmt.Fprintln(tw, "\n Interval\tErrors\tWarnings")
+			for _, bucket := range logs.Buckets {
+				fmt.Fprintf(tw, " %s\t%d\t%d\n", bucket.Start.Format(time.RFC3339), bucket.Errors, bucket.Warnings)
+			}
+		}
+		if len(logs.Errors) > 0 {
+			fmt.Fprintln(tw, "\n Error\tCount")
+			for _, e := range logs.Errors {
+				fmt.Fprintf(tw, " %s\t%d\n", e.Message, e.Count)
+			}
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
+
+// byteSize returns a human-readable byte string of the form 10MB, 12.5KB.
+func byteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Summarizes a debug bundle captured by consul debug"
+const help = `
+Usage: consul debug analyze [options] BUNDLE
+
+  Reads a debug bundle written by "consul debug", either the .tar.gz archive
+  or the extracted directory, and prints a report of:
+
+    - The number of goroutines per interval, and the functions with the most
+      new goroutines between the first and the last interval.
+    - The functions with the most in-use memory in the last heap profile.
+    - The count, mean and maximum latency of the Raft and RPC timers.
+    - The log messages per level, the errors and warnings per interval and
+      the most frequent errors.
+
+  Sections for which the bundle has no data are reported as such.
+
+  To analyze the archive "consul-debug-1234.tar.gz":
+
+      $ consul debug analyze consul-debug-1234.tar.gz
+
+  For a full list of options and examples, please see the Consul documentation.
+`
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package analyze
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestAnalyzeCommand_noTabs(t *testing.T) {
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func testProfile(sampleType string, samples map[string]int64) *profile.Profile {
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{{Type: sampleType, Unit: "count"}},
+	}
+	var id uint64
+	for stack, value := range samples {
+		var locs []*profile.Location
+		// Stacks are given root first, profiles list the leaf first.
+		frames := strings.Split(stack, ";")
+		for i := len(frames) - 1; i >= 0; i-- {
+			id++
+			fn := &profile.Function{ID: id, Name: frames[i]}
+			loc := &profile.Location{ID: id, Line: []profile.Line{{Function: fn}}}
+			p.Function = append(p.Function, fn)
+			p.Location = append(p.Location, loc)
+			locs = append(locs, loc)
+		}
+		p.Sample = append(p.Sample, &profile.Sample{Location: locs, Value: []int64{value}})
+	}
+	return p
+}
+
+func writeProfile(t *testing.T, path string, p *profile.Profile) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, p.Write(f))
+}
+
+func writeTestBundle(t *testing.T, dir string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	index, err := json.Marshal(index{
+		Version:      2,
+		AgentVersion: "1.17.0",
+		Interval:     "30s",
+		Duration:     "1m0s",
+		Targets:      []string{"metrics", "logs", "pprof"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), index, 0644))
+
+	first := filepath.Join(dir, "2021-07-08T09-10-41Z")
+	second := filepath.Join(dir, "2021-07-08T09-11-11Z")
+	require.NoError(t, os.Mkdir(first, 0755))
+	require.NoError(t, os.Mkdir(second, 0755))
+	writeProfile(t, filepath.Join(first, "goroutine.prof"), testProfile("goroutine", map[string]int64{
+		"main.main;runtime.gopark":                               1,
+		"agent.(*Agent).handle;sync.(*Cond).Wait;runtime.gopark": 10,
+	}))
+	writeProfile(t, filepath.Join(second, "goroutine.prof"), testProfile("goroutine", map[string]int64{
+		"main.main;runtime.gopark":                               1,
+		"agent.(*Agent).handle;sync.(*Cond).Wait;runtime.gopark": 50,
+		"rpc.(*Server).serve;runtime.gopark":                     5,
+	}))
+	writeProfile(t, filepath.Join(second, "heap.prof"), testProfile("inuse_space", map[string]int64{
+		"main.main;bytes.growSlice":    4096,
+		"main.main;state.(*Store).Get": 2 * 1024 * 1024,
+	}))
+
+	f, err := os.Create(filepath.Join(dir, "metrics.json"))
+	require.NoError(t, err)
+	enc := json.NewEncoder(f)
+	require.NoError(t, enc.Encode(api.MetricsInfo{
+		Timestamp: "2021-07-08 09:10:20 +0000 UTC",
+		Samples: []api.SampledValue{
+			{Name: "consul.raft.commitTime", Count: 2, Sum: 10, Max: 8},
+			{Name: "consul.http.GET.v1.kv", Count: 1, Sum: 1, Max: 1},
+		},
+	}))
+	require.NoError(t, enc.Encode(api.MetricsInfo{
+		Timestamp: "2021-07-08 09:10:30 +0000 UTC",
+		Samples: []api.SampledValue{
+			{Name: "consul.raft.commitTime", Count: 2, Sum: 2, Max: 1.5},
+			{Name: "consul.rpc.request", Count: 4, Sum: 2, Max: 1},
+		},
+	}))
+	// The capture cuts off the stream.
+	_, err = f.WriteString(`{"Timestamp": "2021-07-08 09:10:40 +0000 UTC", "Sam`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	logs := []string{
+		`2021-07-08T09:10:12.000Z [INFO]  agent: Synced node info`,
+		`2021-07-08T09:10:13.000Z [ERROR] agent.anti_entropy: failed to sync remote state: error="No cluster leader"`,
+		`2021-07-08T09:10:20.000Z [WARN]  agent: Check is now critical: check=web`,
+		`2021-07-08T09:10:50.000Z [ERROR] agent.anti_entropy: failed to sync remote state: error="rpc error"`,
+		`2021-07-08T09:10:51.000Z [ERROR] agent: Coordinate update error: error="timeout"`,
+		`2021-07-08T09:10:52.000Z [DEBUG] agent: Node info in sync`,
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "consul.log"), []byte(strings.Join(logs, "\n")+"\n"), 0644))
+}
+
+func writeArchive(t *testing.T, src, path string) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	err = filepath.Walk(src, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(fi, fi.Name())
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.Join(filepath.Base(src), strings.TrimPrefix(file, src))
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		in, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(tw, in)
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+}
+
+func TestAnalyzeCommand(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "consul-debug-2021-07-08T09-10-11Z")
+	writeTestBundle(t, dir)
+	archive := dir + ".tar.gz"
+	writeArchive(t, dir, archive)
+
+	for name, path := range map[string]string{"directory": dir, "archive": archive} {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			code := New(ui).Run([]string{path})
+			require.Equal(t, 0, code, ui.ErrorWriter.String())
+			out := ui.OutputWriter.String()
+
+			require.Contains(t, out, "1.17.0")
+			for _, expected := range [][]string{
+				// Goroutines
+				{"2021-07-08T09:10:41Z", "11"},
+				{"2021-07-08T09:11:11Z", "56"},
+				{"sync.(*Cond).Wait", "+40"},
+				{"rpc.(*Server).serve", "+5"},
+				// Heap
+				{"state.(*Store).Get", "2.0MB"},
+				{"bytes.growSlice", "4.0KB"},
+				// Latency
+				{"consul.raft.commitTime", "4", "3.000ms", "8.000ms"},
+				{"consul.rpc.request", "4", "0.500ms", "1.000ms"},
+				// Logs
+				{"ERROR", "3"},
+				{"2021-07-08T09:10:13Z", "1", "1"},
+				{"2021-07-08T09:10:43Z", "2", "0"},
+				{"agent.anti_entropy: failed to sync remote state", "2"},
+				{"agent: Coordinate update error", "1"},
+			} {
+				requireLine(t, out, expected...)
+			}
+			require.NotContains(t, out, "consul.http")
+			require.NotContains(t, out, "main.main")
+		})
+	}
+}
+
+func TestAnalyzeCommand_OpenMetrics(t *testing.T) {
+	dir := t.TempDir()
+	index, err := json.Marshal(index{Interval: "30s"})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), index, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metrics.om"), []byte(`# TYPE consul_raft_commitTime summary
+consul_raft_commitTime_count 2 1625735410
+consul_raft_commitTime_sum 10 1625735410
+consul_raft_commitTime_count 4 1625735420
+consul_raft_commitTime_sum 12 1625735420
+# TYPE consul_rpc_request summary
+consul_rpc_request_count{method="KVS.Get"} 1 1625735410
+consul_rpc_request_sum{method="KVS.Get"} 1 1625735410
+consul_rpc_request_count{method="Catalog.List"} 3 1625735410
+consul_rpc_request_sum{method="Catalog.List"} 5 1625735410
+# EOF
+`), 0644))
+
+	ui := cli.NewMockUi()
+	code := New(ui).Run([]string{dir})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	out := ui.OutputWriter.String()
+
+	requireLine(t, out, "consul_raft_commitTime", "4", "3.000ms", "-")
+	requireLine(t, out, "consul_rpc_request", "4", "1.500ms", "-")
+	require.Contains(t, out, "No goroutine profiles captured")
+	require.Contains(t, out, "No heap profiles captured")
+	require.Contains(t, out, "No logs captured")
+}
+
+func TestAnalyzeCommand_BadArgs(t *testing.T) {
+	t.Run("missing bundle", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		require.Equal(t, 1, New(ui).Run(nil))
+		require.Contains(t, ui.ErrorWriter.String(), "Missing BUNDLE argument")
+	})
+
+	t.Run("not a bundle", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		require.Equal(t, 1, New(ui).Run([]string{t.TempDir()}))
+		require.Contains(t, ui.ErrorWriter.String(), "no index.json found")
+	})
+
+	t.Run("path traversal", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "evil.tar.gz")
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		gz := gzip.NewWriter(f)
+		tw := tar.NewWriter(gz)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../index.json", Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+		_, err = tw.Write([]byte("{}"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		require.NoError(t, f.Close())
+
+		ui := cli.NewMockUi()
+		require.Equal(t, 1, New(ui).Run([]string{path}))
+		require.Contains(t, ui.ErrorWriter.String(), "invalid file name in archive")
+	})
+}
+
+// requireLine asserts that a line of out consists of the given fields,
+// separated by whitespace.
+func requireLine(t *testing.T, out string, fields ...string) {
+	t.Helper()
+	quoted := make([]string, len(fields))
+	for i, field := range fields {
+		quoted[i] = regexp.QuoteMeta(field)
+	}
+	re := regexp.MustCompile(`(?m)^\s*` + strings.Join(quoted, `\s+`) + `\s*$`)
+	require.Regexp(t, re, out)
+}
@@ -0,0 +1,626 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package analyze
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// timeDateFormat is the format of the interval directory names written by
+// consul debug.
+const timeDateFormat = "2006-01-02T15-04-05Z0700"
+
+// logTimeFormat is the format of the timestamps in consul.log.
+const logTimeFormat = "2006-01-02T15:04:05.000Z0700"
+
+// latencyPrefixes are the metric name prefixes of the timers reported in the
+// latency section, both as go-metrics and as OpenMetrics names.
+var latencyPrefixes = []string{"consul.raft.", "consul.rpc.", "consul_raft_", "consul_rpc_"}
+
+// index is the part of the index.json of a debug bundle used by the report.
+type index struct {
+	Version      int
+	AgentVersion string
+	Interval     string
+	Duration     string
+	Targets      []string
+}
+
+// bundle is an extracted debug bundle.
+type bundle struct {
+	dir       string
+	index     index
+	interval  time.Duration
+	intervals []interval
+}
+
+// interval is a directory of the profiles captured at the end of an interval.
+type interval struct {
+	time time.Time
+	dir  string
+}
+
+// openBundle returns the directory holding the contents of the debug bundle at
+// path, which is either a directory or a .tar.gz archive. Archives are
+// extracted to a temporary directory, which is removed by the returned
+// cleanup function.
+func openBundle(path string) (dir string, cleanup func(), err error) {
+	cleanup = func() {}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", cleanup, err
+	}
+	if fi.IsDir() {
+		return path, cleanup, nil
+	}
+
+	tmp, err := os.MkdirTemp("", "consul-debug-analyze")
+	if err != nil {
+		return "", cleanup, err
+	}
+	cleanup = func() { os.RemoveAll(tmp) }
+
+	if err := extractArchive(path, tmp); err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+	return tmp, cleanup, nil
+}
+
+func extractArchive(path, dest string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		name := filepath.Join(dest, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(name, filepath.Clean(dest)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file name in archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(name, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(out, tr)
+			out.Close()
+			if err != nil {
+				return fmt.Errorf("failed to extract %s: %w", header.Name, err)
+			}
+		}
+	}
+}
+
+// loadBundle finds the root of the debug bundle under dir, which is the
+// directory containing index.json, and reads its index.
+func loadBundle(dir string) (*bundle, error) {
+	var root string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == "index.json" {
+			root = filepath.Dir(path)
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if root == "" {
+		return nil, fmt.Errorf("no index.json found in %s, is it a debug bundle?", dir)
+	}
+
+	b := &bundle{dir: root}
+	raw, err := os.ReadFile(filepath.Join(root, "index.json"))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &b.index); err != nil {
+		return nil, fmt.Errorf("failed to parse index.json: %w", err)
+	}
+	if b.index.Interval != "" {
+		if b.interval, err = time.ParseDuration(b.index.Interval); err != nil {
+			return nil, fmt.Errorf("invalid interval in index.json: %w", err)
+		}
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		t, err := time.Parse(timeDateFormat, e.Name())
+		if err != nil {
+			continue
+		}
+		b.intervals = append(b.intervals, interval{time: t, dir: filepath.Join(root, e.Name())})
+	}
+	sort.Slice(b.intervals, func(i, j int) bool {
+		return b.intervals[i].time.Before(b.intervals[j].time)
+	})
+	return b, nil
+}
+
+func (b *bundle) path(name string) string {
+	return filepath.Join(b.dir, name)
+}
+
+// functionCount is a count attributed to a function.
+type functionCount struct {
+	Function string
+	Value    int64
+}
+
+// goroutineReport summarizes the goroutine profiles of each interval.
+type goroutineReport struct {
+	Totals []intervalValue
+	// Growth lists the functions with the most goroutines created between
+	// the first and the last interval.
+	Growth []functionCount
+}
+
+type intervalValue struct {
+	Time  time.Time
+	Value int64
+}
+
+func (b *bundle) goroutines(top int) (*goroutineReport, error) {
+	r := &goroutineReport{}
+	var first, last map[string]int64
+	for _, iv := range b.intervals {
+		p, err := readProfile(filepath.Join(iv.dir, "goroutine.prof"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		byFunc := make(map[string]int64)
+		var total int64
+		for _, s := range p.Sample {
+			total += s.Value[0]
+			byFunc[callerFunction(s)] += s.Value[0]
+		}
+		r.Totals = append(r.Totals, intervalValue{Time: iv.time, Value: total})
+		if first == nil {
+			first = byFunc
+		}
+		last = byFunc
+	}
+
+	for fn, n := range last {
+		if growth := n - first[fn]; growth > 0 {
+			r.Growth = append(r.Growth, functionCount{Function: fn, Value: growth})
+		}
+	}
+	r.Growth = topFunctions(r.Growth, top)
+	return r, nil
+}
+
+// heap returns the functions with the most in-use memory in the heap profile
+// of the last interval.
+func (b *bundle) heap(top int) ([]functionCount, error) {
+	for i := len(b.intervals) - 1; i >= 0; i-- {
+		p, err := readProfile(filepath.Join(b.intervals[i].dir, "heap.prof"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		idx := -1
+		for j, st := range p.SampleType {
+			if st.Type == "inuse_space" {
+				idx = j
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("heap profile of %s has no inuse_space samples", b.intervals[i].dir)
+		}
+
+		byFunc := make(map[string]int64)
+		for _, s := range p.Sample {
+			byFunc[leafFunction(s)] += s.Value[idx]
+		}
+		var funcs []functionCount
+		for fn, n := range byFunc {
+			if n > 0 {
+				funcs = append(funcs, functionCount{Function: fn, Value: n})
+			}
+		}
+		return topFunctions(funcs, top), nil
+	}
+	return nil, nil
+}
+
+func readProfile(path string) (*profile.Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := profile.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// sampleFunctions returns the functions of the stack of a sample, starting
+// with the leaf.
+func sampleFunctions(s *profile.Sample) []string {
+	var funcs []string
+	for _, loc := range s.Location {
+		for _, line := range loc.Line {
+			if line.Function != nil {
+				funcs = append(funcs, line.Function.Name)
+			}
+		}
+	}
+	return funcs
+}
+
+func leafFunction(s *profile.Sample) string {
+	if funcs := sampleFunctions(s); len(funcs) > 0 {
+		return funcs[0]
+	}
+	return "unknown"
+}
+
+// callerFunction returns the first function of the stack of a goroutine that
+// is not part of the runtime, which is the code that is blocked.
+func callerFunction(s *profile.Sample) string {
+	funcs := sampleFunctions(s)
+	for _, fn := range funcs {
+		if !strings.HasPrefix(fn, "runtime.") && !strings.HasPrefix(fn, "runtime/internal/") {
+			return fn
+		}
+	}
+	if len(funcs) > 0 {
+		return funcs[0]
+	}
+	return "unknown"
+}
+
+func topFunctions(funcs []functionCount, top int) []functionCount {
+	sort.Slice(funcs, func(i, j int) bool {
+		if funcs[i].Value == funcs[j].Value {
+			return funcs[i].Function < funcs[j].Function
+		}
+		return funcs[i].Value > funcs[j].Value
+	})
+	if top > 0 && len(funcs) > top {
+		funcs = funcs[:top]
+	}
+	return funcs
+}
+
+// latency is the aggregate of a timer over the whole capture.
+type latency struct {
+	Name  string
+	Count int64
+	Sum   float64
+	// Max is only known for metrics captured as JSON.
+	Max    float64
+	HasMax bool
+}
+
+func (l latency) Mean() float64 {
+	if l.Count == 0 {
+		return 0
+	}
+	return l.Sum / float64(l.Count)
+}
+
+// latencies aggregates the Raft and RPC timers from metrics.json or
+// metrics.om, whichever the bundle contains.
+func (b *bundle) latencies() ([]latency, error) {
+	byName := make(map[string]*latency)
+	get := func(name string) *latency {
+		l, ok := byName[name]
+		if !ok {
+			l = &latency{Name: name}
+			byName[name] = l
+		}
+		return l
+	}
+
+	if f, err := os.Open(b.path("metrics.json")); err == nil {
+		defer f.Close()
+		dec := json.NewDecoder(f)
+		for {
+			var summary api.MetricsInfo
+			if err := dec.Decode(&summary); err != nil {
+				// The stream is cut off when the capture ends, so a
+				// truncated last summary is expected.
+				if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+					return nil, fmt.Errorf("failed to decode metrics.json: %w", err)
+				}
+				break
+			}
+			for _, s := range summary.Samples {
+				if !isLatencyMetric(s.Name) {
+					continue
+				}
+				l := get(s.Name)
+				l.Count += int64(s.Count)
+				l.Sum += s.Sum
+				if s.Count > 0 && (!l.HasMax || s.Max > l.Max) {
+					l.Max, l.HasMax = s.Max, true
+				}
+			}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	} else if err := b.openMetricsLatencies(get); err != nil {
+		return nil, err
+	}
+
+	result := make([]latency, 0, len(byName))
+	for _, l := range byName {
+		result = append(result, *l)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// openMetricsLatencies reads the summaries written by consul debug to
+// metrics.om. Their samples are cumulative, so the last sample of each series
+// is the total over the capture.
+func (b *bundle) openMetricsLatencies(get func(name string) *latency) error {
+	f, err := os.Open(b.path("metrics.om"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	type total struct {
+		count, sum float64
+	}
+	series := make(map[string]map[string]*total)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, labels, value, ok := parseOpenMetricsSample(line)
+		if !ok || !isLatencyMetric(name) {
+			continue
+		}
+
+		var family string
+		switch {
+		case strings.HasSuffix(name, "_count"):
+			family = strings.TrimSuffix(name, "_count")
+		case strings.HasSuffix(name, "_sum"):
+			family = strings.TrimSuffix(name, "_sum")
+		default:
+			continue
+		}
+		if series[family] == nil {
+			series[family] = make(map[string]*total)
+		}
+		t := series[family][labels]
+		if t == nil {
+			t = &total{}
+			series[family][labels] = t
+		}
+		if strings.HasSuffix(name, "_count") {
+			t.count = value
+		} else {
+			t.sum = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read metrics.om: %w", err)
+	}
+
+	for family, byLabels := range series {
+		l := get(family)
+		for _, t := range byLabels {
+			l.Count += int64(t.count)
+			l.Sum += t.sum
+		}
+	}
+	return nil
+}
+
+// parseOpenMetricsSample parses a sample line of the form
+// name{labels} value [timestamp].
+func parseOpenMetricsSample(line string) (name, labels string, value float64, ok bool) {
+	i := strings.IndexAny(line, "{ ")
+	if i < 0 {
+		return "", "", 0, false
+	}
+	name, rest := line[:i], line[i:]
+	if strings.HasPrefix(rest, "{") {
+		end := strings.LastIndex(rest, "}")
+		if end < 0 {
+			return "", "", 0, false
+		}
+		labels, rest = rest[:end+1], rest[end+1:]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", "", 0, false
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return "", "", 0, false
+	}
+	return name, labels, v, true
+}
+
+func isLatencyMetric(name string) bool {
+	for _, prefix := range latencyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// logReport summarizes consul.log.
+type logReport struct {
+	Levels map[string]int
+	// Buckets counts the errors and warnings per interval of the capture.
+	Buckets []logBucket
+	// Errors lists the most frequent error messages.
+	Errors []messageCount
+}
+
+type logBucket struct {
+	Start    time.Time
+	Errors   int
+	Warnings int
+}
+
+type messageCount struct {
+	Message string
+	Count   int
+}
+
+var logLineRe = regexp.MustCompile(`^(\S+) \[(TRACE|DEBUG|INFO|WARN|ERROR)\]\s+(.*)$`)
+
+func (b *bundle) logs(top int) (*logReport, error) {
+	f, err := os.Open(b.path("consul.log"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := &logReport{Levels: make(map[string]int)}
+	errorCounts := make(map[string]int)
+	var start time.Time
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		m := logLineRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		level := m[2]
+		r.Levels[level]++
+		if level != "ERROR" && level != "WARN" {
+			continue
+		}
+		if level == "ERROR" {
+			errorCounts[normalizeLogMessage(m[3])]++
+		}
+
+		t, err := time.Parse(logTimeFormat, m[1])
+		if err != nil || b.interval <= 0 {
+			continue
+		}
+		if start.IsZero() {
+			start = t
+		}
+		i := 0
+		if t.After(start) {
+			i = int(t.Sub(start) / b.interval)
+		}
+		for len(r.Buckets) <= i {
+			r.Buckets = append(r.Buckets, logBucket{Start: start.Add(time.Duration(len(r.Buckets)) * b.interval)})
+		}
+		if level == "ERROR" {
+			r.Buckets[i].Errors++
+		} else {
+			r.Buckets[i].Warnings++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read consul.log: %w", err)
+	}
+
+	for msg, n := range errorCounts {
+		r.Errors = append(r.Errors, messageCount{Message: msg, Count: n})
+	}
+	sort.Slice(r.Errors, func(i, j int) bool {
+		if r.Errors[i].Count == r.Errors[j].Count {
+			return r.Errors[i].Message < r.Errors[j].Message
+		}
+		return r.Errors[i].Count > r.Errors[j].Count
+	})
+	if top > 0 && len(r.Errors) > top {
+		r.Errors = r.Errors[:top]
+	}
+	return r, nil
+}
+
+// normalizeLogMessage strips the key=value pairs from a log message so that
+// the same error logged with different values is counted once.
+func normalizeLogMessage(msg string) string {
+	fields := strings.Fields(msg)
+	for i, field := range fields {
+		if strings.Contains(field, "=") {
+			fields = fields[:i]
+			break
+		}
+	}
+	return strings.TrimSuffix(strings.Join(fields, " "), ":")
+}
@@ -54,6 +54,11 @@ const (
 	// debugArchiveExtension is the extension for archive files
 	debugArchiveExtension = ".tar.gz"
 
+	// metricsFormatJSON and metricsFormatOpenMetrics are the formats the
+	// metrics target can be captured in.
+	metricsFormatJSON        = "json"
+	metricsFormatOpenMetrics = "openmetrics"
+
 	// debugProtocolVersion is the version of the package that is
 	// generated. If this format changes interface, this version
 	// can be incremented so clients can selectively support packages
@@ -86,6 +91,7 @@ type cmd struct {
 	since    string
 	archive  bool
 	capture  []string
+	format   string
 	client   *api.Client
 	// validateTiming can be used to skip validation of interval, duration. This
 	// is primarily useful for testing
@@ -138,6 +144,10 @@ func (c *cmd) init() {
 	c.flags.StringVar(&c.output, "output", defaultFilename, "The path "+
 		"to the compressed archive that will be created with the "+
 		"information after collection.")
+	c.flags.StringVar(&c.format, "format", metricsFormatJSON, "The format of the "+
+		"captured metrics. 'json' records the raw stream of metrics summaries to "+
+		"metrics.json, 'openmetrics' records them as an OpenMetrics time series to "+
+		"metrics.om.")
 	c.flags.StringVar(&c.since, "since", "", "Flag used for hcdiag command, time within"+
 		"which information is collected")
 
@@ -272,6 +282,10 @@ func (c *cmd) prepare() (version string, err error) {
 		}
 	}
 
+	if c.format != metricsFormatJSON && c.format != metricsFormatOpenMetrics {
+		return "", fmt.Errorf("invalid metrics format: %s", c.format)
+	}
+
 	// Retrieve and process agent information necessary to validate
 	self, err := c.client.Agent().Self()
 	if err != nil {
@@ -550,6 +564,10 @@ func (c *cmd) captureMetrics(ctx context.Context) error {
 	}
 	defer stream.Close()
 
+	if c.format == metricsFormatOpenMetrics {
+		return c.captureOpenMetrics(ctx, stream)
+	}
+
 	fh, err := os.Create(filepath.Join(c.output, "metrics.json"))
 	if err != nil {
 		return fmt.Errorf("failed to create metrics file: %w", err)
@@ -564,6 +582,36 @@ func (c *cmd) captureMetrics(ctx context.Context) error {
 	return nil
 }
 
+// captureOpenMetrics decodes the metrics summaries from stream until ctx is
+// done and writes them as a single OpenMetrics time series. The summaries are
+// buffered because OpenMetrics requires the samples of a metric family to be
+// written together.
+func (c *cmd) captureOpenMetrics(ctx context.Context, stream io.Reader) error {
+	var summaries []api.MetricsInfo
+	dec := json.NewDecoder(stream)
+	for {
+		var summary api.MetricsInfo
+		if err := dec.Decode(&summary); err != nil {
+			if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+				return fmt.Errorf("failed to decode metrics: %w", err)
+			}
+			break
+		}
+		summaries = append(summaries, summary)
+	}
+
+	fh, err := os.Create(filepath.Join(c.output, "metrics.om"))
+	if err != nil {
+		return fmt.Errorf("failed to create metrics file: %w", err)
+	}
+	defer fh.Close()
+
+	if err := writeOpenMetrics(fh, summaries); err != nil {
+		return fmt.Errorf("failed to write metrics to file: %w", err)
+	}
+	return nil
+}
+
 // allowedTarget returns true if the target is a recognized name of a capture
 // target.
 func allowedTarget(target string) bool {
@@ -785,6 +833,15 @@ Usage: consul debug [options]
   strongly recommend review of the data within the archive prior to
   transmitting it.
 
+  Metrics are recorded as the raw JSON stream by default. To record them as
+  an OpenMetrics time series that can be imported into Prometheus instead:
+
+      $ consul debug -capture metrics -format=openmetrics
+
+  A debug archive can be summarized offline with the analyze subcommand:
+
+      $ consul debug analyze consul-debug-1234.tar.gz
+
   To get information from past, -since flag can be used. It internally uses
   hcdiag -consul -since
       
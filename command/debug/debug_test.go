@@ -269,6 +269,28 @@ func TestDebugCommand_InvalidFlags(t *testing.T) {
 	}
 }
 
+func TestDebugCommand_InvalidFormat(t *testing.T) {
+	ui := cli.NewMockUi()
+	cmd := New(ui)
+	cmd.validateTiming = false
+
+	args := []string{
+		"-output=" + testutil.TempDir(t, "debug") + "/debug",
+		"-format=csv",
+		"-duration=100ms",
+		"-interval=50ms",
+	}
+
+	if code := cmd.Run(args); code == 0 {
+		t.Fatalf("should exit non-zero, got code: %d", code)
+	}
+
+	errOutput := ui.ErrorWriter.String()
+	if !strings.Contains(errOutput, "invalid metrics format: csv") {
+		t.Errorf("expected error output, got %q", errOutput)
+	}
+}
+
 func TestDebugCommand_OutputPathBad(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
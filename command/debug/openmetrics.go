@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package debug
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// metricsTimestampFormat is the format of api.MetricsInfo.Timestamp.
+const metricsTimestampFormat = "2006-01-02 15:04:05 -0700 MST"
+
+const (
+	openMetricsGauge   = "gauge"
+	openMetricsCounter = "counter"
+	openMetricsSummary = "summary"
+)
+
+// openMetricsPoint is the value of a series at the end of a metrics interval.
+type openMetricsPoint struct {
+	timestamp int64
+	value     float64
+	// sum is only used by summaries, for which value is the count.
+	sum float64
+}
+
+type openMetricsSeries struct {
+	labels string
+	points []openMetricsPoint
+}
+
+type openMetricsFamily struct {
+	name   string
+	typ    string
+	series map[string]*openMetricsSeries
+}
+
+// writeOpenMetrics writes the metrics summaries captured from the agent as an
+// OpenMetrics time series, with one sample per series and interval.
+//
+// The in-memory sink reports counters and timers for each interval only, so
+// they are accumulated across intervals: counters become counters, timers
+// become summaries with a count and a sum. Gauges are written as they are.
+func writeOpenMetrics(w io.Writer, summaries []api.MetricsInfo) error {
+	families := make(map[string]*openMetricsFamily)
+	series := func(name, typ string, labels map[string]string) *openMetricsSeries {
+		name = openMetricsName(name)
+		f, ok := families[name]
+		if !ok {
+			f = &openMetricsFamily{name: name, typ: typ, series: make(map[string]*openMetricsSeries)}
+			families[name] = f
+		}
+		l := openMetricsLabels(labels)
+		s, ok := f.series[l]
+		if !ok {
+			s = &openMetricsSeries{labels: l}
+			f.series[l] = s
+		}
+		return s
+	}
+	// last returns the point of the previous interval to accumulate from.
+	last := func(s *openMetricsSeries) openMetricsPoint {
+		if len(s.points) == 0 {
+			return openMetricsPoint{}
+		}
+		return s.points[len(s.points)-1]
+	}
+
+	var prev int64
+	for _, summary := range summaries {
+		t, err := time.Parse(metricsTimestampFormat, summary.Timestamp)
+		if err != nil {
+			return fmt.Errorf("invalid metrics timestamp %q: %w", summary.Timestamp, err)
+		}
+		ts := t.Unix()
+		if ts <= prev {
+			// The stream may repeat an interval, timestamps must increase.
+			continue
+		}
+		prev = ts
+
+		for _, g := range summary.Gauges {
+			s := series(g.Name, openMetricsGauge, g.Labels)
+			s.points = append(s.points, openMetricsPoint{timestamp: ts, value: float64(g.Value)})
+		}
+		for _, c := range summary.Counters {
+			s := series(c.Name, openMetricsCounter, c.Labels)
+			s.points = append(s.points, openMetricsPoint{timestamp: ts, value: last(s).value + c.Sum})
+		}
+		for _, sample := range summary.Samples {
+			s := series(sample.Name, openMetricsSummary, sample.Labels)
+			p := last(s)
+			s.points = append(s.points, openMetricsPoint{
+				timestamp: ts,
+				value:     p.value + float64(sample.Count),
+				sum:       p.sum + sample.Sum,
+			})
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		f := families[name]
+		fmt.Fprintf(bw, "# TYPE %s %s\n", f.name, f.typ)
+
+		labels := make([]string, 0, len(f.series))
+		for l := range f.series {
+			labels = append(labels, l)
+		}
+		sort.Strings(labels)
+
+		for _, l := range labels {
+			for _, p := range f.series[l].points {
+				switch f.typ {
+				case openMetricsCounter:
+					writeOpenMetricsSample(bw, f.name+"_total", l, p.value, p.timestamp)
+				case openMetricsSummary:
+					writeOpenMetricsSample(bw, f.name+"_count", l, p.value, p.timestamp)
+					writeOpenMetricsSample(bw, f.name+"_sum", l, p.sum, p.timestamp)
+				default:
+					writeOpenMetricsSample(bw, f.name, l, p.value, p.timestamp)
+				}
+			}
+		}
+	}
+	bw.WriteString("# EOF\n")
+	return bw.Flush()
+}
+
+func writeOpenMetricsSample(w *bufio.Writer, name, labels string, value float64, timestamp int64) {
+	w.WriteString(name)
+	w.WriteString(labels)
+	w.WriteByte(' ')
+	w.WriteString(openMetricsValue(value))
+	w.WriteByte(' ')
+	w.WriteString(strconv.FormatInt(timestamp, 10))
+	w.WriteByte('\n')
+}
+
+func openMetricsValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// openMetricsName converts a go-metrics name such as consul.raft.commitTime
+// to a valid metric name.
+func openMetricsName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == ':' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// openMetricsLabels formats labels as a sorted label set, or returns an
+// empty string if there are none.
+func openMetricsLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strings.ReplaceAll(openMetricsName(k), ":", "_"))
+		b.WriteString(`="`)
+		b.WriteString(openMetricsLabelValueReplacer.Replace(labels[k]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var openMetricsLabelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package debug
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestWriteOpenMetrics(t *testing.T) {
+	summaries := []api.MetricsInfo{
+		{
+			Timestamp: "2021-07-08 09:10:10 +0000 UTC",
+			Gauges: []api.GaugeValue{
+				{Name: "consul.runtime.num_goroutines", Value: 100},
+			},
+			Counters: []api.SampledValue{
+				{Name: "consul.rpc.request", Count: 2, Sum: 2, Labels: map[string]string{"method": "KVS.Get"}},
+			},
+			Samples: []api.SampledValue{
+				{Name: "consul.raft.commitTime", Count: 3, Sum: 1.5},
+			},
+		},
+		{
+			Timestamp: "2021-07-08 09:10:20 +0000 UTC",
+			Gauges: []api.GaugeValue{
+				{Name: "consul.runtime.num_goroutines", Value: 120},
+			},
+			Counters: []api.SampledValue{
+				{Name: "consul.rpc.request", Count: 1, Sum: 1, Labels: map[string]string{"method": "KVS.Get"}},
+				{Name: "consul.rpc.request", Count: 4, Sum: 4, Labels: map[string]string{"method": `Catalog."List"`}},
+			},
+			Samples: []api.SampledValue{
+				{Name: "consul.raft.commitTime", Count: 1, Sum: 0.25},
+			},
+		},
+		{
+			// A repeated interval is skipped.
+			Timestamp: "2021-07-08 09:10:20 +0000 UTC",
+			Gauges: []api.GaugeValue{
+				{Name: "consul.runtime.num_goroutines", Value: 1},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeOpenMetrics(&buf, summaries))
+
+	expected := `# TYPE consul_raft_commitTime summary
+consul_raft_commitTime_count 3 1625735410
+consul_raft_commitTime_sum 1.5 1625735410
+consul_raft_commitTime_count 4 1625735420
+consul_raft_commitTime_sum 1.75 1625735420
+# TYPE consul_rpc_request counter
+consul_rpc_request_total{method="Catalog.\"List\""} 4 1625735420
+consul_rpc_request_total{method="KVS.Get"} 2 1625735410
+consul_rpc_request_total{method="KVS.Get"} 3 1625735420
+# TYPE consul_runtime_num_goroutines gauge
+consul_runtime_num_goroutines 100 1625735410
+consul_runtime_num_goroutines 120 1625735420
+# EOF
+`
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteOpenMetrics_InvalidTimestamp(t *testing.T) {
+	err := writeOpenMetrics(&bytes.Buffer{}, []api.MetricsInfo{{Timestamp: "yesterday"}})
+	require.ErrorContains(t, err, "invalid metrics timestamp")
+}
@@ -7,10 +7,12 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
 	"github.com/hashicorp/consul/command/kv/impexp"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/mitchellh/cli"
 )
 
@@ -21,14 +23,23 @@ func New(ui cli.Ui) *cmd {
 }
 
 type cmd struct {
-	UI    cli.Ui
-	flags *flag.FlagSet
-	http  *flags.HTTPFlags
-	help  string
+	UI       cli.Ui
+	flags    *flag.FlagSet
+	http     *flags.HTTPFlags
+	help     string
+	format   string
+	filter   string
+	relative bool
 }
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", impexp.FormatJSON, "Output format "+
+		"{json|ndjson}. The ndjson format writes each entry on its own line.")
+	c.flags.StringVar(&c.filter, "filter", "", "Filter to apply to the exported entries.")
+	c.flags.BoolVar(&c.relative, "relative", false, "Export the keys "+
+		"relative to KEY_OR_PREFIX, so that they can be imported under "+
+		"another prefix with the -prefix flag of \"consul kv import\".")
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -54,6 +65,11 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	if c.format != impexp.FormatJSON && c.format != impexp.FormatNDJSON {
+		c.UI.Error(fmt.Sprintf("Unknown format: %s", c.format))
+		return 1
+	}
+
 	// This is just a "nice" thing to do. Since pairs cannot start with a /, but
 	// users will likely put "/" or "/foo", lets go ahead and strip that for them
 	// here.
@@ -68,28 +84,139 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
-	pairs, _, err := client.KV().List(key, &api.QueryOptions{
-		AllowStale: c.http.Stale(),
-	})
+	var eval *bexpr.Evaluator
+	if c.filter != "" {
+		eval, err = bexpr.CreateEvaluatorForType(c.filter, nil, (*api.KVPair)(nil))
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error creating filter: %s", err))
+			return 1
+		}
+	}
+
+	q := &api.QueryOptions{AllowStale: c.http.Stale()}
+	keys, _, err := client.KV().Keys(key, "", q)
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
 		return 1
 	}
 
-	exported := make([]*impexp.Entry, len(pairs))
-	for i, pair := range pairs {
-		exported[i] = impexp.ToEntry(pair)
+	// The values are read in batches and each entry is written as it is
+	// read, so that the whole tree is never held in memory.
+	out := &entryWriter{ui: c.UI, format: c.format}
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > impexp.MaxTxnOps {
+			n = impexp.MaxTxnOps
+		}
+		pairs, err := c.get(client, keys[:n], q)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+			return 1
+		}
+		keys = keys[n:]
+
+		for _, pair := range pairs {
+			if eval != nil {
+				match, err := eval.Evaluate(pair)
+				if err != nil {
+					c.UI.Error(fmt.Sprintf("Error filtering KV data: %s", err))
+					return 1
+				}
+				if !match {
+					continue
+				}
+			}
+
+			entry := impexp.ToEntry(pair)
+			if c.relative {
+				entry.Key = strings.TrimPrefix(pair.Key, key)
+			}
+			if err := out.Write(entry); err != nil {
+				c.UI.Error(fmt.Sprintf("Error exporting KV data: %s", err))
+				return 1
+			}
+		}
 	}
+	out.Close()
+
+	return 0
+}
 
-	marshaled, err := json.MarshalIndent(exported, "", "\t")
+// get reads the given keys in a transaction. Keys that were deleted since
+// they were listed are left out.
+func (c *cmd) get(client *api.Client, keys []string, q *api.QueryOptions) ([]*api.KVPair, error) {
+	ops := make(api.TxnOps, len(keys))
+	for i, key := range keys {
+		ops[i] = &api.TxnOp{KV: &api.KVTxnOp{
+			Verb:      api.KVGetOrEmpty,
+			Key:       key,
+			Namespace: c.http.Namespace(),
+			Partition: c.http.Partition(),
+		}}
+	}
+	ok, resp, _, err := client.Txn().Txn(ops, q)
 	if err != nil {
-		c.UI.Error(fmt.Sprintf("Error exporting KV data: %s", err))
-		return 1
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("failed reading keys: %s", resp.Errors[0].What)
 	}
 
-	c.UI.Info(string(marshaled))
+	pairs := make([]*api.KVPair, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		if result.KV == nil || result.KV.ModifyIndex == 0 {
+			continue
+		}
+		pairs = append(pairs, result.KV)
+	}
+	return pairs, nil
+}
 
-	return 0
+// entryWriter writes the exported entries one at a time. In the JSON format,
+// the last entry is held back until it is known whether another entry
+// follows it, so that the output matches an indented JSON array.
+type entryWriter struct {
+	ui      cli.Ui
+	format  string
+	pending string
+	count   int
+}
+
+func (w *entryWriter) Write(entry *impexp.Entry) error {
+	if w.format == impexp.FormatNDJSON {
+		marshaled, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		w.ui.Output(string(marshaled))
+		return nil
+	}
+
+	marshaled, err := json.MarshalIndent(entry, "\t", "\t")
+	if err != nil {
+		return err
+	}
+	if w.count == 0 {
+		w.ui.Output("[")
+	} else {
+		w.ui.Output(w.pending + ",")
+	}
+	w.pending = "\t" + string(marshaled)
+	w.count++
+	return nil
+}
+
+// Close ends the JSON array.
+func (w *entryWriter) Close() {
+	if w.format == impexp.FormatNDJSON {
+		return
+	}
+	if w.count == 0 {
+		w.ui.Output("[]")
+		return
+	}
+	w.ui.Output(w.pending)
+	w.ui.Output("]")
 }
 
 func (c *cmd) Synopsis() string {
@@ -111,6 +238,14 @@ Usage: consul kv export [KEY_OR_PREFIX]
 
       $ consul kv export vault
 
+  To export the entries as JSON Lines, with an entry on each line:
+
+      $ consul kv export -format=ndjson vault
+
+  To only export the entries that match a filter expression:
+
+      $ consul kv export -filter='Key matches "/config$"' vault
+
   For a full list of options and examples, please see the Consul documentation.
 `
 )
@@ -6,6 +6,7 @@ package exp
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/kv/impexp"
 	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKVExportCommand_noTabs(t *testing.T) {
@@ -76,3 +78,113 @@ func TestKVExportCommand(t *testing.T) {
 		}
 	}
 }
+
+func TestKVExportCommand_NDJSON(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	for k, flags := range map[string]uint64{"foo/a": 1, "foo/b": 0, "foo/c": 1, "bar": 1} {
+		pair := &api.KVPair{Key: k, Flags: flags, Value: []byte(k)}
+		_, err := client.KV().Put(pair, nil)
+		require.NoError(t, err)
+	}
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-format=ndjson",
+		"-filter=Flags == 1",
+		"-relative",
+		"foo/",
+	}
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+	require.Len(t, lines, 2)
+	for i, key := range []string{"a", "c"} {
+		var entry impexp.Entry
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &entry))
+		require.Equal(t, key, entry.Key)
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("foo/"+key)), entry.Value)
+	}
+}
+
+func TestKVExportCommand_Batches(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	// Write enough keys that they are read in several transactions.
+	count := 2*impexp.MaxTxnOps + 1
+	for i := 0; i < count; i++ {
+		key := fmt.Sprintf("foo/%03d", i)
+		_, err := client.KV().Put(&api.KVPair{Key: key, Value: []byte(key)}, nil)
+		require.NoError(t, err)
+	}
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "foo/"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	var exported []*impexp.Entry
+	require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &exported))
+	require.Len(t, exported, count)
+	for i, entry := range exported {
+		key := fmt.Sprintf("foo/%03d", i)
+		require.Equal(t, key, entry.Key)
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte(key)), entry.Value)
+	}
+
+	// The output is the same as an indented JSON array.
+	expected, err := json.MarshalIndent(exported, "", "\t")
+	require.NoError(t, err)
+	require.Equal(t, string(expected)+"\n", ui.OutputWriter.String())
+}
+
+func TestKVExportCommand_Empty(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "foo/"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Equal(t, "[]\n", ui.OutputWriter.String())
+}
+
+func TestKVExportCommand_InvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	require.Equal(t, 1, c.Run([]string{"-filter=Bogus == 1"}))
+	require.Contains(t, ui.ErrorWriter.String(), "Error creating filter")
+}
+
+func TestKVExportCommand_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	require.Equal(t, 1, c.Run([]string{"-format=yaml"}))
+	require.Contains(t, ui.ErrorWriter.String(), "Unknown format: yaml")
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"text/tabwriter"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
@@ -219,6 +220,9 @@ func (c *cmd) Help() string {
 func prettyKVPair(w io.Writer, pair *api.KVPair, base64EncodeValue bool, keysOnly bool) error {
 	tw := tabwriter.NewWriter(w, 0, 2, 6, ' ', 0)
 	fmt.Fprintf(tw, "CreateIndex\t%d\n", pair.CreateIndex)
+	if pair.Expires != nil {
+		fmt.Fprintf(tw, "Expires\t%s\n", pair.Expires.Format(time.RFC3339))
+	}
 	fmt.Fprintf(tw, "Flags\t%d\n", pair.Flags)
 	fmt.Fprintf(tw, "Key\t%s\n", pair.Key)
 	fmt.Fprintf(tw, "LockIndex\t%d\n", pair.LockIndex)
@@ -228,6 +232,9 @@ func prettyKVPair(w io.Writer, pair *api.KVPair, base64EncodeValue bool, keysOnl
 	} else {
 		fmt.Fprintf(tw, "Session\t%s\n", pair.Session)
 	}
+	if pair.TTL != "" {
+		fmt.Fprintf(tw, "TTL\t%s\n", pair.TTL)
+	}
 	if pair.Partition != "" {
 		fmt.Fprintf(tw, "Partition\t%s\n", pair.Partition)
 	}
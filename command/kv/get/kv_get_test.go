@@ -422,6 +422,52 @@ func TestKVGetCommand_DetailedBase64(t *testing.T) {
 	}
 }
 
+func TestKVGetCommand_DetailedTTL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+
+	pair := &api.KVPair{
+		Key:   "foo",
+		Value: []byte("bar"),
+		TTL:   "10m",
+	}
+	_, err := client.KV().Put(pair, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-detailed",
+		"foo",
+	}
+
+	code := c.Run(args)
+	if code != 0 {
+		t.Fatalf("bad: %d. %#v", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	for _, key := range []string{
+		"Expires",
+		"TTL",
+		"10m",
+	} {
+		if !strings.Contains(output, key) {
+			t.Fatalf("bad %#v, missing %q", output, key)
+		}
+	}
+}
+
 func TestKVGetCommand_KeysRecurse(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package history
+
+import (
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI           cli.Ui
+	flags        *flag.FlagSet
+	http         *flags.HTTPFlags
+	help         string
+	base64encode bool
+	limit        int
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.BoolVar(&c.base64encode, "base64", false,
+		"Base64 encode the values. The default value is false.")
+	c.flags.IntVar(&c.limit, "limit", 0,
+		"Maximum number of revisions to show, newest first. The default value "+
+			"of 0 shows all the retained revisions.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check for arg validation
+	args = c.flags.Args()
+	var key string
+	switch len(args) {
+	case 0:
+		c.UI.Error("Error! Missing KEY argument")
+		return 1
+	case 1:
+		key = strings.TrimPrefix(args[0], "/")
+	default:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 1, got %d)", len(args)))
+		return 1
+	}
+	if key == "" {
+		c.UI.Error("Error! Missing KEY argument")
+		return 1
+	}
+	if c.limit < 0 {
+		c.UI.Error("Error! -limit cannot be negative")
+		return 1
+	}
+
+	// Create and test the HTTP client
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	revs, _, err := client.KV().History(key, &api.QueryOptions{
+		AllowStale: c.http.Stale(),
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+		return 1
+	}
+	if len(revs) == 0 {
+		c.UI.Error(fmt.Sprintf("Error! No history exists for: %s", key))
+		return 1
+	}
+	if c.limit > 0 && len(revs) > c.limit {
+		revs = revs[:c.limit]
+	}
+
+	c.UI.Output(c.format(revs))
+	return 0
+}
+
+func (c *cmd) format(revs []*api.KVRevision) string {
+	result := []string{"Index\x1fTime\x1fFlags\x1fValue"}
+	for _, rev := range revs {
+		flags, value := strconv.FormatUint(rev.Flags, 10), string(rev.Value)
+		switch {
+		case rev.Deleted:
+			flags, value = "-", "(deleted)"
+		case c.base64encode:
+			value = base64.StdEncoding.EncodeToString(rev.Value)
+		}
+		result = append(result, fmt.Sprintf("%d\x1f%s\x1f%s\x1f%s",
+			rev.ModifyIndex, rev.Time.Format(time.RFC3339), flags, value))
+	}
+	return columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})})
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const (
+	synopsis = "Shows the recorded revisions of a key"
+	help     = `
+Usage: consul kv history [options] KEY
+
+  Shows the revisions of a key that the servers have recorded, newest first.
+  Revisions are only recorded while KV history is enabled on the servers with
+  the kv_history configuration block.
+
+  To show the history of the key named "redis/config/connections":
+
+      $ consul kv history redis/config/connections
+
+  Each revision shows the index it was written at, which can be passed to
+  "consul kv rollback" to restore the key to that revision.
+
+  For a full list of options and examples, please see the Consul documentation.
+`
+)
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package history
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestKVHistoryCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(nil).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestKVHistoryCommand_Validation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"no key": {
+			[]string{},
+			"Missing KEY argument",
+		},
+		"extra args": {
+			[]string{"foo", "bar"},
+			"Too many arguments",
+		},
+		"negative limit": {
+			[]string{"-limit=-1", "foo"},
+			"-limit cannot be negative",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			code := New(ui).Run(tc.args)
+			require.Equal(t, 1, code)
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestKVHistoryCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, `kv_history { retain_count = 10 }`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+	kv := a.Client().KV()
+
+	// Recording starts once the leader has shared the retain count.
+	retry.Run(t, func(r *retry.R) {
+		_, err := kv.Put(&api.KVPair{Key: "foo", Value: []byte("one")}, nil)
+		require.NoError(r, err)
+		revs, _, err := kv.History("foo", nil)
+		require.NoError(r, err)
+		require.NotEmpty(r, revs)
+	})
+	_, err := kv.Put(&api.KVPair{Key: "foo", Value: []byte("two"), Flags: 12}, nil)
+	require.NoError(t, err)
+	_, err = kv.Delete("foo", nil)
+	require.NoError(t, err)
+
+	t.Run("all revisions", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+		require.GreaterOrEqual(t, len(lines), 4)
+		require.Contains(t, lines[0], "Index")
+		require.Contains(t, lines[1], "(deleted)")
+		require.Contains(t, lines[2], "12")
+		require.Contains(t, lines[2], "two")
+		require.Contains(t, lines[3], "one")
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-limit=2", "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+		require.Len(t, lines, 3)
+	})
+
+	t.Run("base64", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-base64", "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "dHdv")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "nope"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "No history exists for: nope")
+	})
+}
@@ -4,15 +4,14 @@
 package imp
 
 import (
-	"bytes"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
@@ -33,6 +32,10 @@ type cmd struct {
 	help   string
 	prefix string
 
+	cas         bool
+	onlyMissing bool
+	deleteExtra bool
+
 	// testStdin is the input for testing.
 	testStdin io.Reader
 }
@@ -40,6 +43,14 @@ type cmd struct {
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
 	c.flags.StringVar(&c.prefix, "prefix", "", "Key prefix for imported data")
+	c.flags.BoolVar(&c.cas, "cas", false, "Write each key with a "+
+		"check-and-set against the index it had when it was exported, "+
+		"skipping the keys that were changed since the export.")
+	c.flags.BoolVar(&c.onlyMissing, "only-missing", false, "Only import the "+
+		"keys that do not exist yet, leaving existing keys unchanged.")
+	c.flags.BoolVar(&c.deleteExtra, "delete-extra", false, "Delete the keys "+
+		"under -prefix that are not in the data once it is imported, so that "+
+		"the prefix matches the data. Requires -prefix.")
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -52,6 +63,11 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	if c.deleteExtra && c.prefix == "" {
+		c.UI.Error("Error! The -delete-extra flag requires -prefix")
+		return 1
+	}
+
 	// Check for arg validation
 	args = c.flags.Args()
 	data, err := c.dataFromArgs(args)
@@ -59,6 +75,7 @@ func (c *cmd) Run(args []string) int {
 		c.UI.Error(fmt.Sprintf("Error! %s", err))
 		return 1
 	}
+	defer data.Close()
 
 	// Create and test the HTTP client
 	client, err := c.http.APIClient()
@@ -67,43 +84,167 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
-	var entries []*impexp.Entry
-	if err := json.Unmarshal([]byte(data), &entries); err != nil {
-		c.UI.Error(fmt.Sprintf("Cannot unmarshal data: %s", err))
-		return 1
+	// The entries are written in transactions as they are read, so a
+	// failure leaves the entries of the transactions before it imported.
+	imported := make(map[string]bool)
+	batch := &impexp.Batcher{
+		Apply: func(ops api.KVTxnOps) error {
+			return c.write(client, ops)
+		},
+		ApplyLarge: func(op *api.KVTxnOp) error {
+			return c.writeLarge(client, op)
+		},
 	}
+	dec := impexp.NewDecoder(data)
+	for {
+		entry, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Cannot unmarshal data: %s", err))
+			return 1
+		}
 
-	for _, entry := range entries {
 		value, err := base64.StdEncoding.DecodeString(entry.Value)
 		if err != nil {
 			c.UI.Error(fmt.Sprintf("Error base 64 decoding value for key %s: %s", entry.Key, err))
 			return 1
 		}
 
-		pair := &api.KVPair{
-			Key:   path.Join(c.prefix, entry.Key),
-			Flags: entry.Flags,
-			Value: value,
+		op := &api.KVTxnOp{
+			Verb:      api.KVSet,
+			Key:       path.Join(c.prefix, entry.Key),
+			Flags:     entry.Flags,
+			Value:     value,
+			Namespace: entry.Namespace,
 		}
 
 		// if the key is a directory, we need to append /
 		if len(entry.Key) > 0 && entry.Key[len(entry.Key)-1] == '/' {
-			pair.Key += "/"
+			op.Key += "/"
 		}
 
-		w := api.WriteOptions{Namespace: entry.Namespace}
-		if _, err := client.KV().Put(pair, &w); err != nil {
-			c.UI.Error(fmt.Sprintf("Error! Failed writing data for key %s: %s", pair.Key, err))
+		// Existing keys are skipped by a check-and-set against index 0, and
+		// changed keys by one against the index they were exported with.
+		switch {
+		case c.onlyMissing:
+			op.Verb = api.KVCAS
+		case c.cas:
+			op.Verb = api.KVCAS
+			op.Index = entry.ModifyIndex
+		}
+
+		imported[op.Key] = true
+		if err := batch.Add(op); err != nil {
+			c.UI.Error(fmt.Sprintf("Error! Failed writing data: %s", err))
 			return 1
 		}
+	}
+	if err := batch.Flush(); err != nil {
+		c.UI.Error(fmt.Sprintf("Error! Failed writing data: %s", err))
+		return 1
+	}
 
-		c.UI.Info(fmt.Sprintf("Imported: %s", pair.Key))
+	if c.deleteExtra {
+		if err := c.deleteKeys(client, imported); err != nil {
+			c.UI.Error(fmt.Sprintf("Error! Failed deleting keys: %s", err))
+			return 1
+		}
 	}
 
 	return 0
 }
 
-func (c *cmd) dataFromArgs(args []string) (string, error) {
+// write imports the entries of a transaction. Check-and-set operations that
+// fail are skipped rather than failing the import.
+func (c *cmd) write(client *api.Client, ops api.KVTxnOps) error {
+	stale, err := impexp.ApplyTxn(client.Txn(), ops, nil)
+	if err != nil {
+		return err
+	}
+	skipped := make(map[int]bool, len(stale))
+	for _, idx := range stale {
+		skipped[idx] = true
+	}
+	for i, op := range ops {
+		c.report(op, skipped[i])
+	}
+	return nil
+}
+
+// writeLarge imports an entry that is too large for a transaction once its
+// value is encoded, with a write of its own to the KV endpoint.
+func (c *cmd) writeLarge(client *api.Client, op *api.KVTxnOp) error {
+	pair := &api.KVPair{
+		Key:         op.Key,
+		Flags:       op.Flags,
+		Value:       op.Value,
+		ModifyIndex: op.Index,
+	}
+	q := &api.WriteOptions{Namespace: op.Namespace}
+
+	ok := true
+	var err error
+	if op.Verb == api.KVCAS {
+		ok, _, err = client.KV().CAS(pair, q)
+	} else {
+		_, err = client.KV().Put(pair, q)
+	}
+	if err != nil {
+		return err
+	}
+	c.report(op, !ok)
+	return nil
+}
+
+func (c *cmd) report(op *api.KVTxnOp, skipped bool) {
+	switch {
+	case !skipped:
+		c.UI.Info(fmt.Sprintf("Imported: %s", op.Key))
+	case c.onlyMissing:
+		c.UI.Info(fmt.Sprintf("Skipped: %s (already exists)", op.Key))
+	default:
+		c.UI.Info(fmt.Sprintf("Skipped: %s (changed since export)", op.Key))
+	}
+}
+
+// deleteKeys deletes the keys under the prefix that were not imported. The
+// prefix is a directory, as the imported keys are joined to it as paths.
+func (c *cmd) deleteKeys(client *api.Client, imported map[string]bool) error {
+	keys, _, err := client.KV().Keys(strings.TrimSuffix(c.prefix, "/")+"/", "", nil)
+	if err != nil {
+		return err
+	}
+
+	batch := &impexp.Batcher{
+		Apply: func(ops api.KVTxnOps) error {
+			if _, err := impexp.ApplyTxn(client.Txn(), ops, nil); err != nil {
+				return err
+			}
+			for _, op := range ops {
+				c.UI.Info(fmt.Sprintf("Deleted: %s", op.Key))
+			}
+			return nil
+		},
+	}
+	for _, key := range keys {
+		if imported[key] {
+			continue
+		}
+		op := &api.KVTxnOp{
+			Verb:      api.KVDelete,
+			Key:       key,
+			Namespace: c.http.Namespace(),
+		}
+		if err := batch.Add(op); err != nil {
+			return err
+		}
+	}
+	return batch.Flush()
+}
+
+func (c *cmd) dataFromArgs(args []string) (io.ReadCloser, error) {
 	var stdin io.Reader = os.Stdin
 	if c.testStdin != nil {
 		stdin = c.testStdin
@@ -111,36 +252,32 @@ func (c *cmd) dataFromArgs(args []string) (string, error) {
 
 	switch len(args) {
 	case 0:
-		return "", errors.New("Missing DATA argument")
+		return nil, errors.New("Missing DATA argument")
 	case 1:
 	default:
-		return "", fmt.Errorf("Too many arguments (expected 1, got %d)", len(args))
+		return nil, fmt.Errorf("Too many arguments (expected 1, got %d)", len(args))
 	}
 
 	data := args[0]
 
 	if len(data) == 0 {
-		return "", errors.New("Empty DATA argument")
+		return nil, errors.New("Empty DATA argument")
 	}
 
 	switch data[0] {
 	case '@':
-		data, err := os.ReadFile(data[1:])
+		f, err := os.Open(data[1:])
 		if err != nil {
-			return "", fmt.Errorf("Failed to read file: %s", err)
+			return nil, fmt.Errorf("Failed to read file: %s", err)
 		}
-		return string(data), nil
+		return f, nil
 	case '-':
 		if len(data) > 1 {
-			return data, nil
-		}
-		var b bytes.Buffer
-		if _, err := io.Copy(&b, stdin); err != nil {
-			return "", fmt.Errorf("Failed to read stdin: %s", err)
+			return io.NopCloser(strings.NewReader(data)), nil
 		}
-		return b.String(), nil
+		return io.NopCloser(stdin), nil
 	default:
-		return data, nil
+		return io.NopCloser(strings.NewReader(data)), nil
 	}
 }
 
@@ -158,7 +295,10 @@ const (
 Usage: consul kv import [DATA]
 
   Imports key-value pairs to the key-value store from the JSON representation
-  generated by the "consul kv export" command.
+  generated by the "consul kv export" command, either as a JSON array or as
+  JSON Lines. The entries are written in transactions of up to 64 entries as
+  the data is read, and an entry too large for a transaction is written on
+  its own.
 
   The data can be read from a file by prefixing the filename with the "@"
   symbol. For example:
@@ -172,6 +312,10 @@ Usage: consul kv import [DATA]
   Alternatively the data may be provided as the final parameter to the command,
   though care must be taken with regards to shell escaping.
 
+  To make the keys under a prefix match the data, deleting the other keys:
+
+      $ consul kv import -prefix=app/ -delete-extra @app.ndjson
+
   For a full list of options and examples, please see the Consul documentation.
 `
 )
@@ -4,10 +4,13 @@
 package imp
 
 import (
+	"encoding/base64"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
 	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/require"
 )
@@ -167,3 +170,129 @@ func TestKVImportPrefixCommand(t *testing.T) {
 		t.Fatalf("bad: expected: bar, got %s", pair.Value)
 	}
 }
+
+func TestKVImportCommand_Validation(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	require.Equal(t, 1, c.Run([]string{"-delete-extra", "[]"}))
+	require.Contains(t, ui.ErrorWriter.String(), "The -delete-extra flag requires -prefix")
+}
+
+func TestKVImportCommand_Strategies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	for _, key := range []string{"app/a", "app/b", "app/extra", "apps/keep", "keep"} {
+		_, err := client.KV().Put(&api.KVPair{Key: key, Value: []byte("old")}, nil)
+		require.NoError(t, err)
+	}
+
+	// Values are base64 encoded "new".
+	const ndjson = `{"key": "a", "flags": 0, "value": "bmV3"}
+{"key": "b", "flags": 0, "value": "bmV3"}
+{"key": "c", "flags": 0, "value": "bmV3"}
+`
+	run := func(t *testing.T, data string, args ...string) string {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		c.testStdin = strings.NewReader(data)
+		args = append([]string{"-http-addr=" + a.HTTPAddr(), "-prefix=app"}, args...)
+		code := c.Run(append(args, "-"))
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		return ui.OutputWriter.String()
+	}
+	values := func(t *testing.T) map[string]string {
+		pairs, _, err := client.KV().List("", nil)
+		require.NoError(t, err)
+		out := make(map[string]string)
+		for _, pair := range pairs {
+			out[pair.Key] = string(pair.Value)
+		}
+		return out
+	}
+
+	t.Run("only missing", func(t *testing.T) {
+		output := run(t, ndjson, "-only-missing")
+		require.Contains(t, output, "Skipped: app/a (already exists)")
+		require.Contains(t, output, "Imported: app/c")
+		require.Equal(t, map[string]string{
+			"app/a":     "old",
+			"app/b":     "old",
+			"app/c":     "new",
+			"app/extra": "old",
+			"apps/keep": "old",
+			"keep":      "old",
+		}, values(t))
+	})
+
+	t.Run("cas and delete extra", func(t *testing.T) {
+		// The keys are written against the index they were exported with,
+		// so the key changed since the export is skipped.
+		index := func(key string) uint64 {
+			pair, _, err := client.KV().Get(key, nil)
+			require.NoError(t, err)
+			return pair.ModifyIndex
+		}
+		data := fmt.Sprintf(`{"key": "a", "flags": 0, "value": "bmV3", "modify_index": %d}
+{"key": "b", "flags": 0, "value": "bmV3", "modify_index": %d}
+{"key": "c", "flags": 0, "value": "bmV3"}
+`, index("app/a"), index("app/b"))
+		_, err := client.KV().Put(&api.KVPair{Key: "app/b", Value: []byte("theirs")}, nil)
+		require.NoError(t, err)
+
+		output := run(t, data, "-cas", "-delete-extra")
+		require.Contains(t, output, "Imported: app/a")
+		require.Contains(t, output, "Skipped: app/b (changed since export)")
+		require.Contains(t, output, "Skipped: app/c (changed since export)")
+		require.Contains(t, output, "Deleted: app/extra")
+		require.Equal(t, map[string]string{
+			"app/a":     "new",
+			"app/b":     "theirs",
+			"app/c":     "new",
+			"apps/keep": "old",
+			"keep":      "old",
+		}, values(t))
+	})
+}
+
+func TestKVImportCommand_LargeValue(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	// The value is within kv_max_value_size, but is larger than
+	// txn_max_req_len once it is base64 encoded.
+	large := []byte(strings.Repeat("a", 500*1024))
+	data := fmt.Sprintf(`{"key": "small", "flags": 0, "value": "bmV3"}
+{"key": "large", "flags": 0, "value": %q}
+`, base64.StdEncoding.EncodeToString(large))
+
+	for _, args := range [][]string{nil, {"-only-missing"}} {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		c.testStdin = strings.NewReader(data)
+		args = append([]string{"-http-addr=" + a.HTTPAddr()}, args...)
+		code := c.Run(append(args, "-"))
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+	}
+
+	pair, _, err := client.KV().Get("large", nil)
+	require.NoError(t, err)
+	require.Equal(t, large, pair.Value)
+	pair, _, err = client.KV().Get("small", nil)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(pair.Value))
+}
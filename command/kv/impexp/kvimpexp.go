@@ -15,6 +15,10 @@ type Entry struct {
 	Value     string `json:"value"`
 	Namespace string `json:"namespace,omitempty"`
 	Partition string `json:"partition,omitempty"`
+
+	// ModifyIndex is the index the key had when it was exported, which the
+	// import uses with -cas.
+	ModifyIndex uint64 `json:"modify_index,omitempty"`
 }
 
 func ToEntry(pair *api.KVPair) *Entry {
@@ -24,5 +28,7 @@ func ToEntry(pair *api.KVPair) *Entry {
 		Value:     base64.StdEncoding.EncodeToString(pair.Value),
 		Namespace: pair.Namespace,
 		Partition: pair.Partition,
+
+		ModifyIndex: pair.ModifyIndex,
 	}
 }
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package impexp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"unicode"
+)
+
+// The formats that KV data can be exported and imported in.
+const (
+	// FormatJSON is a JSON array of entries.
+	FormatJSON = "json"
+
+	// FormatNDJSON is JSON Lines, with an entry on each line.
+	FormatNDJSON = "ndjson"
+)
+
+// Decoder reads entries from either format one at a time, so that the data
+// does not have to fit in memory.
+type Decoder struct {
+	r     *bufio.Reader
+	dec   *json.Decoder
+	array bool
+	count int
+}
+
+// NewDecoder returns a decoder reading from r. The format is detected from
+// the first character of the data.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Next returns the next entry, or io.EOF once all of the entries are read.
+func (d *Decoder) Next() (*Entry, error) {
+	if d.dec == nil {
+		if err := d.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.array && !d.dec.More() {
+		// Read the end of the array.
+		if _, err := d.dec.Token(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	var entry Entry
+	if err := d.dec.Decode(&entry); err != nil {
+		if err == io.EOF && !d.array {
+			return nil, io.EOF
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("entry %d: %w", d.count+1, err)
+	}
+	d.count++
+	return &entry, nil
+}
+
+// start skips the leading whitespace and opens the array of the JSON format.
+func (d *Decoder) start() error {
+	for {
+		r, _, err := d.r.ReadRune()
+		if err != nil {
+			return err
+		}
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if err := d.r.UnreadRune(); err != nil {
+			return err
+		}
+		d.array = r == '['
+		break
+	}
+
+	d.dec = json.NewDecoder(d.r)
+	if d.array {
+		if _, err := d.dec.Token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
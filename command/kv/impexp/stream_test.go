@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package impexp
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		data string
+		keys []string
+		err  string
+	}{
+		"json": {
+			data: `
+				[
+					{"key": "a", "flags": 0, "value": ""},
+					{"key": "b", "flags": 1, "value": "Yg=="}
+				]`,
+			keys: []string{"a", "b"},
+		},
+		"empty json": {
+			data: `[]`,
+		},
+		"ndjson": {
+			data: "{\"key\": \"a\", \"flags\": 0, \"value\": \"\"}\n" +
+				"{\"key\": \"b\", \"flags\": 1, \"value\": \"Yg==\"}\n",
+			keys: []string{"a", "b"},
+		},
+		"empty": {
+			data: "\n",
+		},
+		"truncated json": {
+			data: `[{"key": "a", "flags": 0, "value": ""}`,
+			keys: []string{"a"},
+			err:  "entry 2: unexpected end of JSON input",
+		},
+		"unterminated json": {
+			data: `[{"key": "a", "flags": 0, "value": ""},`,
+			keys: []string{"a"},
+			err:  "entry 2:",
+		},
+		"invalid ndjson": {
+			data: "{\"key\": \"a\"}\n{\"key\": 1}\n",
+			keys: []string{"a"},
+			err:  "entry 2: json: cannot unmarshal number",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(tc.data))
+			var keys []string
+			for {
+				entry, err := dec.Next()
+				if err == io.EOF {
+					break
+				}
+				if tc.err != "" && err != nil {
+					require.ErrorContains(t, err, tc.err)
+					break
+				}
+				require.NoError(t, err)
+				keys = append(keys, entry.Key)
+			}
+			require.Equal(t, tc.keys, keys)
+		})
+	}
+}
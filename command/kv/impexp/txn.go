@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package impexp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// MaxTxnOps is the number of operations applied in a transaction, which
+	// is below the limit of the transaction endpoint.
+	MaxTxnOps = 64
+
+	// MaxTxnSize is the size of the JSON encoded operations applied in a
+	// transaction, which is the default txn_max_req_len less the brackets of
+	// the array holding them.
+	MaxTxnSize = 512*1024 - len("[]\n")
+)
+
+// Batcher groups KV operations into transactions that stay within MaxTxnOps
+// and MaxTxnSize, so that each group is applied atomically.
+type Batcher struct {
+	// Apply is called with each full group of operations, and with the last
+	// one by Flush.
+	Apply func(ops api.KVTxnOps) error
+
+	// ApplyLarge is called with an operation that is larger than MaxTxnSize
+	// on its own, which happens for values close to kv_max_value_size since
+	// they grow by a third when base64 encoded. If it is nil, the operation
+	// is applied in a transaction of its own.
+	ApplyLarge func(op *api.KVTxnOp) error
+
+	ops  api.KVTxnOps
+	size int
+}
+
+// Add adds an operation to the current group, and applies the group first
+// if the operation does not fit in it.
+func (b *Batcher) Add(op *api.KVTxnOp) error {
+	size, err := txnOpSize(op)
+	if err != nil {
+		return err
+	}
+	if size > MaxTxnSize && b.ApplyLarge != nil {
+		if err := b.Flush(); err != nil {
+			return err
+		}
+		return b.ApplyLarge(op)
+	}
+	if len(b.ops) > 0 && (len(b.ops) >= MaxTxnOps || b.size+size > MaxTxnSize) {
+		if err := b.Flush(); err != nil {
+			return err
+		}
+	}
+	b.ops = append(b.ops, op)
+	b.size += size
+	return nil
+}
+
+// Flush applies the operations that were added since the last group was
+// applied.
+func (b *Batcher) Flush() error {
+	if len(b.ops) == 0 {
+		return nil
+	}
+	ops := b.ops
+	b.ops, b.size = nil, 0
+	return b.Apply(ops)
+}
+
+// txnOpSize returns the size that an operation adds to the body of a
+// transaction, including the comma separating it from the next one.
+func txnOpSize(op *api.KVTxnOp) (int, error) {
+	encoded, err := json.Marshal(&api.TxnOp{KV: op})
+	if err != nil {
+		return 0, err
+	}
+	return len(encoded) + 1, nil
+}
+
+// ApplyTxn applies the operations in a transaction. Check-and-set operations
+// that fail because the key was changed are left out, and the transaction is
+// retried with the other operations. It returns the indexes of the operations
+// that were left out.
+func ApplyTxn(txn *api.Txn, ops api.KVTxnOps, q *api.QueryOptions) ([]int, error) {
+	// pending maps the operations of the transaction to the given ones.
+	pending := make([]int, len(ops))
+	for i := range ops {
+		pending[i] = i
+	}
+
+	var stale []int
+	for len(pending) > 0 {
+		txnOps := make(api.TxnOps, len(pending))
+		for i, idx := range pending {
+			txnOps[i] = &api.TxnOp{KV: ops[idx]}
+		}
+
+		ok, resp, _, err := txn.Txn(txnOps, q)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return stale, nil
+		}
+
+		failed := make(map[int]bool, len(resp.Errors))
+		for _, txnErr := range resp.Errors {
+			if !strings.HasSuffix(txnErr.What, "index is stale") {
+				return nil, fmt.Errorf("transaction failed: %s", txnErr.What)
+			}
+			failed[txnErr.OpIndex] = true
+		}
+		if len(failed) == 0 {
+			return nil, fmt.Errorf("transaction failed")
+		}
+
+		remaining := pending[:0]
+		for i, idx := range pending {
+			if failed[i] {
+				stale = append(stale, idx)
+			} else {
+				remaining = append(remaining, idx)
+			}
+		}
+		pending = remaining
+	}
+	return stale, nil
+}
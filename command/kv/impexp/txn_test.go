@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package impexp
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	var batches []int
+	b := &Batcher{
+		Apply: func(ops api.KVTxnOps) error {
+			batches = append(batches, len(ops))
+			return nil
+		},
+	}
+
+	for i := 0; i < MaxTxnOps+1; i++ {
+		require.NoError(t, b.Add(&api.KVTxnOp{Key: strconv.Itoa(i)}))
+	}
+	// Large values are applied on their own.
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "large", Value: make([]byte, MaxTxnSize)}))
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "small"}))
+	require.NoError(t, b.Flush())
+	require.NoError(t, b.Flush())
+
+	require.Equal(t, []int{MaxTxnOps, 1, 1, 1}, batches)
+
+	// The size is measured on the encoded values, and operations that are
+	// too large for a transaction are applied on their own.
+	batches = nil
+	var large []string
+	b.ApplyLarge = func(op *api.KVTxnOp) error {
+		large = append(large, op.Key)
+		return nil
+	}
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "small"}))
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "large", Value: make([]byte, MaxTxnSize*3/4)}))
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "half", Value: make([]byte, MaxTxnSize/2)}))
+	require.NoError(t, b.Add(&api.KVTxnOp{Key: "other half", Value: make([]byte, MaxTxnSize/2)}))
+	require.NoError(t, b.Flush())
+
+	require.Equal(t, []string{"large"}, large)
+	require.Equal(t, []int{1, 1, 1}, batches)
+}
+
+func TestApplyTxn(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	_, err := client.KV().Put(&api.KVPair{Key: "changed", Value: []byte("theirs")}, nil)
+	require.NoError(t, err)
+
+	stale, err := ApplyTxn(client.Txn(), api.KVTxnOps{
+		{Verb: api.KVSet, Key: "a", Value: []byte("ours")},
+		{Verb: api.KVCAS, Key: "changed", Value: []byte("ours"), Index: 1},
+		{Verb: api.KVCAS, Key: "b", Value: []byte("ours"), Index: 0},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, stale)
+
+	for key, value := range map[string]string{"a": "ours", "b": "ours", "changed": "theirs"} {
+		pair, _, err := client.KV().Get(key, nil)
+		require.NoError(t, err)
+		require.Equal(t, value, string(pair.Value))
+	}
+
+	_, err = ApplyTxn(client.Txn(), api.KVTxnOps{
+		{Verb: api.KVSet, Key: "c", Value: []byte("ours"), TTL: "invalid"},
+	}, nil)
+	require.Error(t, err)
+}
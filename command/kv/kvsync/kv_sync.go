@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kvsync
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/kv/impexp"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	help   string
+	prune  bool
+	dryRun bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.BoolVar(&c.prune, "prune", false, "Delete the keys under PREFIX "+
+		"that have no file in DIR. The default value is false.")
+	c.flags.BoolVar(&c.dryRun, "dry-run", false, "Print the plan without "+
+		"applying it. The default value is false.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+// The actions of a plan.
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+var actionSymbols = map[string]string{
+	actionCreate: "+",
+	actionUpdate: "~",
+	actionDelete: "-",
+}
+
+// change is a key that the plan writes or deletes.
+type change struct {
+	action string
+	key    string
+	value  []byte
+
+	// index is the modify index of the key when the plan was made, which is
+	// zero for the keys to create.
+	index uint64
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check for arg validation
+	args = c.flags.Args()
+	switch {
+	case len(args) < 2:
+		c.UI.Error(fmt.Sprintf("Error! Missing DIR and PREFIX arguments (expected 2, got %d)", len(args)))
+		return 1
+	case len(args) > 2:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 2, got %d)", len(args)))
+		return 1
+	}
+	dir := args[0]
+
+	// The prefix is a directory, as the files are mapped to keys by their
+	// path within DIR.
+	prefix := strings.Trim(args[1], "/")
+	if prefix == "" {
+		c.UI.Error("Error! PREFIX cannot be the root of the KV store")
+		return 1
+	}
+	prefix += "/"
+
+	files, err := readDir(dir, prefix)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading %s: %s", dir, err))
+		return 1
+	}
+
+	// Create and test the HTTP client
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	pairs, _, err := client.KV().List(prefix, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+		return 1
+	}
+
+	changes, extra := plan(files, pairs, c.prune)
+	if len(changes) == 0 {
+		c.UI.Output(fmt.Sprintf("No changes. The keys under %s match %s.", prefix, dir))
+		c.outputExtra(extra)
+		return 0
+	}
+
+	counts := make(map[string]int)
+	for _, ch := range changes {
+		counts[ch.action]++
+		c.UI.Output(fmt.Sprintf("%s %s", actionSymbols[ch.action], ch.key))
+	}
+	c.UI.Output("")
+	c.UI.Output(fmt.Sprintf("Plan: %d to create, %d to update, %d to delete.",
+		counts[actionCreate], counts[actionUpdate], counts[actionDelete]))
+	c.outputExtra(extra)
+	if c.dryRun {
+		return 0
+	}
+
+	// The keys are written and deleted with check-and-set operations against
+	// the indexes of the plan, so that the keys changed since the plan was
+	// made are left alone.
+	var stale []string
+	batch := &impexp.Batcher{
+		Apply: func(ops api.KVTxnOps) error {
+			skipped, err := impexp.ApplyTxn(client.Txn(), ops, nil)
+			for _, idx := range skipped {
+				stale = append(stale, ops[idx].Key)
+			}
+			return err
+		},
+	}
+	for _, ch := range changes {
+		op := &api.KVTxnOp{
+			Verb:  api.KVCAS,
+			Key:   ch.key,
+			Value: ch.value,
+			Index: ch.index,
+		}
+		if ch.action == actionDelete {
+			op.Verb = api.KVDeleteCAS
+		}
+		if err := batch.Add(op); err != nil {
+			c.UI.Error(fmt.Sprintf("Error! Failed applying the plan: %s", err))
+			return 1
+		}
+	}
+	if err := batch.Flush(); err != nil {
+		c.UI.Error(fmt.Sprintf("Error! Failed applying the plan: %s", err))
+		return 1
+	}
+
+	if len(stale) > 0 {
+		for _, key := range stale {
+			c.UI.Warn(fmt.Sprintf("Skipped: %s (changed since the plan was made)", key))
+		}
+		c.UI.Error(fmt.Sprintf("Error! %d keys changed while the plan was applied. "+
+			"Run the sync again to apply them.", len(stale)))
+		return 1
+	}
+	c.UI.Info(fmt.Sprintf("Success! Applied %d changes to %s", len(changes), prefix))
+	return 0
+}
+
+func (c *cmd) outputExtra(extra int) {
+	if extra > 0 {
+		c.UI.Output(fmt.Sprintf("%d keys under the prefix have no file and are kept. "+
+			"Use -prune to delete them.", extra))
+	}
+}
+
+// readDir maps the regular files under dir to keys under the prefix, using
+// their path relative to dir. Files and directories whose name starts with a
+// dot, such as ".git", are skipped.
+func readDir(dir, prefix string) (map[string][]byte, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("not a directory")
+	}
+
+	files := make(map[string][]byte)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow links to files, but not to directories.
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		value, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[prefix+filepath.ToSlash(rel)] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// plan compares the files with the live keys, and returns the changes sorted
+// by key. Deletions are only planned when pruning, otherwise the number of
+// keys that would be deleted is returned. Keys ending with a "/", which mark
+// folders, are never deleted.
+func plan(files map[string][]byte, pairs api.KVPairs, prune bool) ([]*change, int) {
+	var changes []*change
+	var extra int
+
+	live := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		live[pair.Key] = true
+		value, ok := files[pair.Key]
+		switch {
+		case ok && !bytes.Equal(value, pair.Value):
+			changes = append(changes, &change{
+				action: actionUpdate,
+				key:    pair.Key,
+				value:  value,
+				index:  pair.ModifyIndex,
+			})
+		case ok || strings.HasSuffix(pair.Key, "/"):
+		case prune:
+			changes = append(changes, &change{
+				action: actionDelete,
+				key:    pair.Key,
+				index:  pair.ModifyIndex,
+			})
+		default:
+			extra++
+		}
+	}
+	for key, value := range files {
+		if !live[key] {
+			changes = append(changes, &change{
+				action: actionCreate,
+				key:    key,
+				value:  value,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].key < changes[j].key
+	})
+	return changes, extra
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const (
+	synopsis = "Syncs a directory of files to a prefix of the KV store"
+	help     = `
+Usage: consul kv sync [options] DIR PREFIX
+
+  Makes the keys under PREFIX match the files in DIR. Each regular file is
+  mapped to the key of its path relative to DIR under PREFIX, with the
+  contents of the file as the value. Files and directories whose name starts
+  with a dot, such as ".git", are skipped.
+
+  The command compares the files with the keys under PREFIX, prints the plan
+  of the keys to create, update and delete, and applies it in transactions of
+  up to 64 keys. Keys that are changed by another client after the plan is
+  made are skipped. Keys without a file are only deleted with -prune.
+
+  To preview the changes to sync the "config" directory to "app/config/":
+
+      $ consul kv sync -dry-run ./config app/config
+
+  To apply them, deleting the keys that have no file:
+
+      $ consul kv sync -prune ./config app/config
+
+  For a full list of options and examples, please see the Consul documentation.
+`
+)
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kvsync
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestKVSyncCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(nil).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestKVSyncCommand_Validation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"no args": {
+			[]string{},
+			"Missing DIR and PREFIX arguments (expected 2, got 0)",
+		},
+		"no prefix": {
+			[]string{"dir"},
+			"Missing DIR and PREFIX arguments (expected 2, got 1)",
+		},
+		"too many args": {
+			[]string{"dir", "app", "extra"},
+			"Too many arguments (expected 2, got 3)",
+		},
+		"root prefix": {
+			[]string{"dir", "/"},
+			"PREFIX cannot be the root of the KV store",
+		},
+		"missing dir": {
+			[]string{filepath.Join(testutil.TempDir(t, "kv-sync"), "nope"), "app"},
+			"Error reading",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui)
+			require.Equal(t, 1, c.Run(tc.args))
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestKVSyncCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	dir := testutil.TempDir(t, "kv-sync")
+	for name, value := range map[string]string{
+		"same":        "same",
+		"changed":     "new",
+		"created":     "new",
+		"sub/nested":  "new",
+		".git/config": "ignored",
+		".hidden":     "ignored",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(value), 0644))
+	}
+
+	for key, value := range map[string]string{
+		"app/same":    "same",
+		"app/changed": "old",
+		"app/extra":   "old",
+		"app/folder/": "",
+		"other":       "old",
+	} {
+		_, err := client.KV().Put(&api.KVPair{Key: key, Value: []byte(value)}, nil)
+		require.NoError(t, err)
+	}
+
+	values := func(t *testing.T) map[string]string {
+		pairs, _, err := client.KV().List("", nil)
+		require.NoError(t, err)
+		out := make(map[string]string)
+		for _, pair := range pairs {
+			out[pair.Key] = string(pair.Value)
+		}
+		return out
+	}
+	run := func(t *testing.T, args ...string) string {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		args = append([]string{"-http-addr=" + a.HTTPAddr()}, args...)
+		code := c.Run(append(args, dir, "app"))
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		return ui.OutputWriter.String()
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		output := run(t, "-dry-run", "-prune")
+		require.Equal(t, `~ app/changed
++ app/created
+- app/extra
++ app/sub/nested
+
+Plan: 2 to create, 1 to update, 1 to delete.
+`, output)
+		require.Equal(t, "old", values(t)["app/changed"])
+	})
+
+	t.Run("without prune", func(t *testing.T) {
+		output := run(t)
+		require.Contains(t, output, "Plan: 2 to create, 1 to update, 0 to delete.")
+		require.Contains(t, output, "1 keys under the prefix have no file and are kept.")
+		require.Contains(t, output, "Success! Applied 3 changes to app/")
+		require.Equal(t, map[string]string{
+			"app/same":       "same",
+			"app/changed":    "new",
+			"app/created":    "new",
+			"app/sub/nested": "new",
+			"app/extra":      "old",
+			"app/folder/":    "",
+			"other":          "old",
+		}, values(t))
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		output := run(t, "-prune")
+		require.Contains(t, output, "- app/extra")
+		require.Contains(t, output, "Plan: 0 to create, 0 to update, 1 to delete.")
+		require.NotContains(t, values(t), "app/extra")
+		require.Contains(t, values(t), "app/folder/")
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		output := run(t, "-prune")
+		require.Contains(t, output, "No changes.")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
@@ -35,6 +36,7 @@ type cmd struct {
 	session       string
 	acquire       bool
 	release       bool
+	ttl           string
 
 	// testStdin is the input for testing.
 	testStdin io.Reader
@@ -67,6 +69,10 @@ func (c *cmd) init() {
 		"Forfeit the lock on the key at the given path. This requires the "+
 			"-session flag to be set. The key must be held by the session in order to "+
 			"be unlocked. The default value is false.")
+	c.flags.StringVar(&c.ttl, "ttl", "",
+		"Duration after which the key is deleted, such as \"30s\" or \"1h\". "+
+			"Every write sets the TTL again, and a write without a TTL makes the "+
+			"key permanent. The default value is empty (no TTL).")
 
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
@@ -102,6 +108,13 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	if c.ttl != "" {
+		if d, err := time.ParseDuration(c.ttl); err != nil || d <= 0 {
+			c.UI.Error(fmt.Sprintf("Error! Invalid -ttl: %q", c.ttl))
+			return 1
+		}
+	}
+
 	// Create and test the HTTP client
 	client, err := c.http.APIClient()
 	if err != nil {
@@ -115,6 +128,7 @@ func (c *cmd) Run(args []string) int {
 		Flags:       c.kvflags,
 		Value:       dataBytes,
 		Session:     c.session,
+		TTL:         c.ttl,
 	}
 
 	switch {
@@ -234,6 +248,11 @@ Usage: consul kv put [options] KEY [DATA]
 
       $ consul kv put -cas -modify-index=844 config/redis/maxconns 5
 
+  To write a key that Consul deletes after 10 minutes unless it is written
+  again, specify the -ttl flag:
+
+      $ consul kv put -ttl=10m cache/session/abc123 data
+
   Additional flags and more advanced use cases are detailed below.
 `
 )
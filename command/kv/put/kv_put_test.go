@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul/agent"
 	"github.com/hashicorp/consul/api"
@@ -49,6 +50,14 @@ func TestKVPutCommand_Validation(t *testing.T) {
 			[]string{"foo", "bar", "baz"},
 			"Too many arguments",
 		},
+		"invalid -ttl": {
+			[]string{"-ttl", "soon", "foo"},
+			"Invalid -ttl",
+		},
+		"non-positive -ttl": {
+			[]string{"-ttl", "0s", "foo"},
+			"Invalid -ttl",
+		},
 	}
 
 	for name, tc := range cases {
@@ -346,6 +355,43 @@ func TestKVPutCommand_Flags(t *testing.T) {
 	}
 }
 
+func TestKVPutCommand_TTL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-ttl", "10m",
+		"foo", "bar",
+	}
+
+	code := c.Run(args)
+	if code != 0 {
+		t.Fatalf("bad: %d. %#v", code, ui.ErrorWriter.String())
+	}
+
+	data, _, err := client.KV().Get("foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.TTL != "10m" {
+		t.Errorf("bad: %#v", data.TTL)
+	}
+	if data.Expires == nil || time.Until(*data.Expires) <= 0 {
+		t.Errorf("bad: %#v", data.Expires)
+	}
+}
+
 func TestKVPutCommand_CAS(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollback
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+	index uint64
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.Uint64Var(&c.index, "index", 0,
+		"Index of the revision to restore, as shown by \"consul kv history\". "+
+			"The default is the revision before the latest one.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check for arg validation
+	args = c.flags.Args()
+	var key string
+	switch len(args) {
+	case 0:
+		c.UI.Error("Error! Missing KEY argument")
+		return 1
+	case 1:
+		key = strings.TrimPrefix(args[0], "/")
+	default:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 1, got %d)", len(args)))
+		return 1
+	}
+	if key == "" {
+		c.UI.Error("Error! Missing KEY argument")
+		return 1
+	}
+
+	// Create and test the HTTP client
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+	kv := client.KV()
+
+	revs, _, err := kv.History(key, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+		return 1
+	}
+
+	var target *api.KVRevision
+	if c.index == 0 {
+		if len(revs) < 2 {
+			c.UI.Error(fmt.Sprintf("Error! No previous revision exists for: %s", key))
+			return 1
+		}
+		target = revs[1]
+	} else {
+		for _, rev := range revs {
+			if rev.ModifyIndex == c.index {
+				target = rev
+				break
+			}
+		}
+		if target == nil {
+			c.UI.Error(fmt.Sprintf("Error! No revision of %s exists at index %d", key, c.index))
+			return 1
+		}
+	}
+
+	// The key is only changed if it was not written since it was read, so a
+	// concurrent write is never overwritten.
+	current, _, err := kv.Get(key, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+		return 1
+	}
+	var modifyIndex uint64
+	if current != nil {
+		modifyIndex = current.ModifyIndex
+	}
+
+	var ok bool
+	switch {
+	case target.Deleted && current == nil:
+		ok = true
+	case target.Deleted:
+		ok, _, err = kv.DeleteCAS(&api.KVPair{Key: key, ModifyIndex: modifyIndex}, nil)
+	default:
+		ok, _, err = kv.CAS(&api.KVPair{
+			Key:         key,
+			Flags:       target.Flags,
+			Value:       target.Value,
+			ModifyIndex: modifyIndex,
+		}, nil)
+	}
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error! Failed writing data: %s", err))
+		return 1
+	}
+	if !ok {
+		c.UI.Error(fmt.Sprintf("Error! Did not roll back %s, it was modified concurrently", key))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Success! Rolled back %s to index %d", key, target.ModifyIndex))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const (
+	synopsis = "Restores a key to a recorded revision"
+	help     = `
+Usage: consul kv rollback [options] KEY
+
+  Restores the value and flags of a key from one of the revisions recorded
+  while KV history is enabled on the servers. If the revision is a deletion,
+  the key is deleted. The rollback fails if the key is modified concurrently.
+
+  To undo the latest change to the key named "redis/config/connections":
+
+      $ consul kv rollback redis/config/connections
+
+  To restore the key to the revision written at index 42, as shown by
+  "consul kv history":
+
+      $ consul kv rollback -index=42 redis/config/connections
+
+  For a full list of options and examples, please see the Consul documentation.
+`
+)
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollback
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestKVRollbackCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(nil).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestKVRollbackCommand_Validation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"no key": {
+			[]string{},
+			"Missing KEY argument",
+		},
+		"extra args": {
+			[]string{"foo", "bar"},
+			"Too many arguments",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			code := New(ui).Run(tc.args)
+			require.Equal(t, 1, code)
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestKVRollbackCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, `kv_history { retain_count = 10 }`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+	kv := a.Client().KV()
+
+	// Recording starts once the leader has shared the retain count.
+	retry.Run(t, func(r *retry.R) {
+		_, err := kv.Put(&api.KVPair{Key: "foo", Value: []byte("one"), Flags: 3}, nil)
+		require.NoError(r, err)
+		revs, _, err := kv.History("foo", nil)
+		require.NoError(r, err)
+		require.NotEmpty(r, revs)
+	})
+	_, err := kv.Put(&api.KVPair{Key: "foo", Value: []byte("two")}, nil)
+	require.NoError(t, err)
+
+	get := func(t *testing.T) *api.KVPair {
+		t.Helper()
+		pair, _, err := kv.Get("foo", nil)
+		require.NoError(t, err)
+		return pair
+	}
+
+	t.Run("previous revision", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "Success! Rolled back foo")
+
+		pair := get(t)
+		require.Equal(t, []byte("one"), pair.Value)
+		require.Equal(t, uint64(3), pair.Flags)
+	})
+
+	t.Run("deleted revision", func(t *testing.T) {
+		_, err := kv.Delete("foo", nil)
+		require.NoError(t, err)
+		_, err = kv.Put(&api.KVPair{Key: "foo", Value: []byte("three")}, nil)
+		require.NoError(t, err)
+
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Nil(t, get(t))
+	})
+
+	t.Run("specific index", func(t *testing.T) {
+		revs, _, err := kv.History("foo", nil)
+		require.NoError(t, err)
+		var index uint64
+		for _, rev := range revs {
+			if string(rev.Value) == "two" {
+				index = rev.ModifyIndex
+			}
+		}
+		require.NotZero(t, index)
+
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-index=" + strconv.FormatUint(index, 10), "foo"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Equal(t, []byte("two"), get(t).Value)
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-index=1", "foo"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "No revision of foo exists at index 1")
+	})
+}
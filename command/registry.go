@@ -67,6 +67,7 @@ import (
 	"github.com/hashicorp/consul/command/connect/proxy"
 	"github.com/hashicorp/consul/command/connect/redirecttraffic"
 	"github.com/hashicorp/consul/command/debug"
+	debuganalyze "github.com/hashicorp/consul/command/debug/analyze"
 	"github.com/hashicorp/consul/command/event"
 	"github.com/hashicorp/consul/command/exec"
 	"github.com/hashicorp/consul/command/forceleave"
@@ -85,8 +86,11 @@ import (
 	kvdel "github.com/hashicorp/consul/command/kv/del"
 	kvexp "github.com/hashicorp/consul/command/kv/exp"
 	kvget "github.com/hashicorp/consul/command/kv/get"
+	kvhistory "github.com/hashicorp/consul/command/kv/history"
 	kvimp "github.com/hashicorp/consul/command/kv/imp"
+	"github.com/hashicorp/consul/command/kv/kvsync"
 	kvput "github.com/hashicorp/consul/command/kv/put"
+	kvrollback "github.com/hashicorp/consul/command/kv/rollback"
 	"github.com/hashicorp/consul/command/leave"
 	"github.com/hashicorp/consul/command/lock"
 	"github.com/hashicorp/consul/command/login"
@@ -130,6 +134,7 @@ import (
 	svcsregister "github.com/hashicorp/consul/command/services/register"
 	"github.com/hashicorp/consul/command/snapshot"
 	snapdecode "github.com/hashicorp/consul/command/snapshot/decode"
+	snapdiff "github.com/hashicorp/consul/command/snapshot/diff"
 	snapinspect "github.com/hashicorp/consul/command/snapshot/inspect"
 	snaprestore "github.com/hashicorp/consul/command/snapshot/restore"
 	snapsave "github.com/hashicorp/consul/command/snapshot/save"
@@ -214,6 +219,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"connect expose", func(ui cli.Ui) (cli.Command, error) { return expose.New(ui), nil }},
 		entry{"connect redirect-traffic", func(ui cli.Ui) (cli.Command, error) { return redirecttraffic.New(ui), nil }},
 		entry{"debug", func(ui cli.Ui) (cli.Command, error) { return debug.New(ui), nil }},
+		entry{"debug analyze", func(ui cli.Ui) (cli.Command, error) { return debuganalyze.New(ui), nil }},
 		entry{"event", func(ui cli.Ui) (cli.Command, error) { return event.New(ui), nil }},
 		entry{"exec", func(ui cli.Ui) (cli.Command, error) { return exec.New(ui, MakeShutdownCh()), nil }},
 		entry{"force-leave", func(ui cli.Ui) (cli.Command, error) { return forceleave.New(ui), nil }},
@@ -232,8 +238,11 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"kv delete", func(ui cli.Ui) (cli.Command, error) { return kvdel.New(ui), nil }},
 		entry{"kv export", func(ui cli.Ui) (cli.Command, error) { return kvexp.New(ui), nil }},
 		entry{"kv get", func(ui cli.Ui) (cli.Command, error) { return kvget.New(ui), nil }},
+		entry{"kv history", func(ui cli.Ui) (cli.Command, error) { return kvhistory.New(ui), nil }},
 		entry{"kv import", func(ui cli.Ui) (cli.Command, error) { return kvimp.New(ui), nil }},
 		entry{"kv put", func(ui cli.Ui) (cli.Command, error) { return kvput.New(ui), nil }},
+		entry{"kv rollback", func(ui cli.Ui) (cli.Command, error) { return kvrollback.New(ui), nil }},
+		entry{"kv sync", func(ui cli.Ui) (cli.Command, error) { return kvsync.New(ui), nil }},
 		entry{"leave", func(ui cli.Ui) (cli.Command, error) { return leave.New(ui), nil }},
 		entry{"lock", func(ui cli.Ui) (cli.Command, error) { return lock.New(ui, MakeShutdownCh()), nil }},
 		entry{"login", func(ui cli.Ui) (cli.Command, error) { return login.New(ui), nil }},
@@ -278,6 +287,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"services exported-services", func(ui cli.Ui) (cli.Command, error) { return exportedservices.New(ui), nil }},
 		entry{"snapshot", func(cli.Ui) (cli.Command, error) { return snapshot.New(), nil }},
 		entry{"snapshot decode", func(ui cli.Ui) (cli.Command, error) { return snapdecode.New(ui), nil }},
+		entry{"snapshot diff", func(ui cli.Ui) (cli.Command, error) { return snapdiff.New(ui), nil }},
 		entry{"snapshot inspect", func(ui cli.Ui) (cli.Command, error) { return snapinspect.New(ui), nil }},
 		entry{"snapshot restore", func(ui cli.Ui) (cli.Command, error) { return snaprestore.New(ui), nil }},
 		entry{"snapshot save", func(ui cli.Ui) (cli.Command, error) { return snapsave.New(ui), nil }},
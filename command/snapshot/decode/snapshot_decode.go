@@ -54,6 +54,7 @@ var requestTypeZeroValues = map[structs.MessageType]func() any{
 	structs.PeeringTrustBundleWriteType:  func() any { return new(pbpeering.PeeringTrustBundle) },
 	structs.PeeringSecretsWriteType:      func() any { return new(pbpeering.PeeringSecrets) },
 	structs.ResourceOperationType:        func() any { return new(pbresource.Resource) },
+	structs.KVSRevisionRequestType:       func() any { return new(structs.KVSRevision) },
 }
 
 func New(ui cli.Ui) *cmd {
@@ -139,10 +140,7 @@ func (c *cmd) Run(args []string) int {
 			return 1
 		}
 		defer func() {
-			if err := readFile.Close(); err != nil {
-				c.UI.Error(fmt.Sprintf("Failed to close temp snapshot: %v", err))
-			}
-			if err := os.Remove(readFile.Name()); err != nil {
+			if err := snapshot.RemoveTemp(readFile); err != nil {
 				c.UI.Error(fmt.Sprintf("Failed to clean up temp snapshot: %v", err))
 			}
 		}()
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+type Formatter interface {
+	Format(*OutputFormat) (string, error)
+}
+
+func GetSupportedFormats() []string {
+	return []string{PrettyFormat, JSONFormat}
+}
+
+func NewFormatter(format string) (Formatter, error) {
+	switch format {
+	case PrettyFormat:
+		return newPrettyFormatter(), nil
+	case JSONFormat:
+		return newJSONFormatter(), nil
+	default:
+		return nil, fmt.Errorf("Unknown format: %s", format)
+	}
+}
+
+type prettyFormatter struct{}
+
+func newPrettyFormatter() Formatter {
+	return &prettyFormatter{}
+}
+
+var changeSymbols = map[string]string{
+	ChangeAdded:   "+",
+	ChangeRemoved: "-",
+	ChangeChanged: "~",
+}
+
+func (_ *prettyFormatter) Format(info *OutputFormat) (string, error) {
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 8, 8, 6, ' ', 0)
+
+	fmt.Fprintf(tw, " From\t%s (index %d)", info.From.ID, info.From.Index)
+	fmt.Fprintf(tw, "\n To\t%s (index %d)", info.To.ID, info.To.Index)
+	fmt.Fprintf(tw, "\n")
+
+	if len(info.Changes) == 0 {
+		fmt.Fprintf(tw, "\n No differences")
+	} else {
+		for _, change := range info.Changes {
+			fmt.Fprintf(tw, "\n %s %s:%s", changeSymbols[change.Change], change.Kind, change.Key)
+			if len(change.Fields) > 0 {
+				fmt.Fprintf(tw, " (%s)", strings.Join(change.Fields, ", "))
+			}
+		}
+		fmt.Fprintf(tw, "\n")
+
+		fmt.Fprintln(tw, "\n Type\tAdded\tRemoved\tChanged")
+		fmt.Fprintf(tw, " %s\t%s\t%s\t%s", "----", "----", "----", "----")
+		for _, s := range info.Summary {
+			fmt.Fprintf(tw, "\n %s\t%d\t%d\t%d", s.Kind, s.Added, s.Removed, s.Changed)
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return b.String(), err
+	}
+	return b.String(), nil
+}
+
+type jsonFormatter struct{}
+
+func newJSONFormatter() Formatter {
+	return &jsonFormatter{}
+}
+
+func (_ *jsonFormatter) Format(info *OutputFormat) (string, error) {
+	// Keys and values are output as they are, such as the "=>" of intentions.
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(info); err != nil {
+		return "", fmt.Errorf("Failed to marshal snapshot differences: %v", err)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
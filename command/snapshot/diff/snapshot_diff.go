@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package diff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
+	"github.com/hashicorp/consul/snapshot"
+)
+
+// diffKinds lists the kinds of records that are compared, in the order they
+// are reported.
+var diffKinds = []string{
+	snapshotcmd.RecordNodes,
+	snapshotcmd.RecordServices,
+	snapshotcmd.RecordChecks,
+	snapshotcmd.RecordKV,
+	snapshotcmd.RecordIntentions,
+	snapshotcmd.RecordConfigEntries,
+	snapshotcmd.RecordACLPolicies,
+	snapshotcmd.RecordACLRoles,
+	snapshotcmd.RecordACLTokens,
+	snapshotcmd.RecordACLAuthMethods,
+	snapshotcmd.RecordACLBindingRules,
+}
+
+// ignoredFields are the fields that change whenever a record is written,
+// even if its contents are the same.
+var ignoredFields = []string{"CreateIndex", "ModifyIndex", "Hash"}
+
+const redacted = "<redacted>"
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI         cli.Ui
+	flags      *flag.FlagSet
+	help       string
+	format     string
+	showValues bool
+	keys       snapshotcmd.KeyFlags
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join(GetSupportedFormats(), "|")))
+	c.flags.BoolVar(&c.showValues, "show-values", false, "Include the values "+
+		"of KV entries in the JSON output, instead of their SHA-256 hash.")
+	flags.Merge(c.flags, c.keys.EncryptFlags(false))
+	flags.Merge(c.flags, c.keys.VerifyFlags())
+
+	c.help = flags.Usage(help, c.flags)
+}
+
+// MetadataInfo identifies a snapshot being compared.
+type MetadataInfo struct {
+	ID    string
+	Index uint64
+	Term  uint64
+}
+
+// Change is a record that was added, removed or changed between the two
+// snapshots.
+type Change struct {
+	Kind   string
+	Key    string
+	Change string
+
+	// Fields lists the top-level fields of a changed record that differ.
+	Fields []string `json:",omitempty"`
+
+	Before any `json:",omitempty"`
+	After  any `json:",omitempty"`
+}
+
+// Summary counts the changes to a kind of records.
+type Summary struct {
+	Kind    string
+	Added   int
+	Removed int
+	Changed int
+}
+
+// OutputFormat is used for passing information
+// through the formatter
+type OutputFormat struct {
+	From    *MetadataInfo
+	To      *MetadataInfo
+	Changes []*Change
+	Summary []*Summary
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = c.flags.Args()
+	switch {
+	case len(args) < 2:
+		c.UI.Error(fmt.Sprintf("Missing FILE arguments (expected 2, got %d)", len(args)))
+		return 1
+	case len(args) > 2:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 2, got %d)", len(args)))
+		return 1
+	}
+
+	formatter, err := NewFormatter(c.format)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	opts, err := c.keys.Options(nil)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	from, fromRecords, err := readFile(args[0], opts)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading snapshot %q: %s", args[0], err))
+		return 1
+	}
+	to, toRecords, err := readFile(args[1], opts)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading snapshot %q: %s", args[1], err))
+		return 1
+	}
+
+	out := &OutputFormat{From: from, To: to}
+	for _, kind := range diffKinds {
+		changes, err := diffRecords(kind, fromRecords[kind], toRecords[kind], c.showValues)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error comparing %s: %s", kind, err))
+			return 1
+		}
+		if len(changes) == 0 {
+			continue
+		}
+
+		summary := &Summary{Kind: kind}
+		for _, change := range changes {
+			switch change.Change {
+			case ChangeAdded:
+				summary.Added++
+			case ChangeRemoved:
+				summary.Removed++
+			case ChangeChanged:
+				summary.Changed++
+			}
+		}
+		out.Changes = append(out.Changes, changes...)
+		out.Summary = append(out.Summary, summary)
+	}
+
+	output, err := formatter.Format(out)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	c.UI.Output(output)
+	return 0
+}
+
+// readFile reads the records of a snapshot file.
+func readFile(file string, opts *snapshot.Options) (*MetadataInfo, map[string][]*snapshotcmd.Record, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	state, meta, err := snapshot.ReadWithOptions(hclog.NewNullLogger(), f, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer snapshot.RemoveTemp(state)
+
+	records, err := snapshotcmd.ReadRecords(state)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := &MetadataInfo{
+		ID:    meta.ID,
+		Index: meta.Index,
+		Term:  meta.Term,
+	}
+	return info, records, nil
+}
+
+// The kinds of changes between two snapshots.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// diffRecords compares the records of a kind, and returns the changes sorted
+// by key. The records are compared before they are redacted, so that changes
+// to secrets are still reported.
+func diffRecords(kind string, from, to []*snapshotcmd.Record, showValues bool) ([]*Change, error) {
+	fromByKey, err := recordsByKey(from)
+	if err != nil {
+		return nil, err
+	}
+	toByKey, err := recordsByKey(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*Change
+	for key, before := range fromByKey {
+		after, ok := toByKey[key]
+		if !ok {
+			changes = append(changes, &Change{
+				Kind:   kind,
+				Key:    key,
+				Change: ChangeRemoved,
+				Before: redact(before.record.Value, showValues),
+			})
+			continue
+		}
+		if fields := changedFields(before.fields, after.fields); len(fields) > 0 {
+			changes = append(changes, &Change{
+				Kind:   kind,
+				Key:    key,
+				Change: ChangeChanged,
+				Fields: fields,
+				Before: redact(before.record.Value, showValues),
+				After:  redact(after.record.Value, showValues),
+			})
+		}
+	}
+	for key, after := range toByKey {
+		if _, ok := fromByKey[key]; !ok {
+			changes = append(changes, &Change{
+				Kind:   kind,
+				Key:    key,
+				Change: ChangeAdded,
+				After:  redact(after.record.Value, showValues),
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+type normalized struct {
+	record *snapshotcmd.Record
+	fields map[string]any
+}
+
+// recordsByKey indexes the records by key, along with their fields without
+// the ones that change on every write. Service-intentions config entries are
+// left out, as their sources are compared as individual intentions.
+func recordsByKey(records []*snapshotcmd.Record) (map[string]*normalized, error) {
+	out := make(map[string]*normalized, len(records))
+	for _, record := range records {
+		if entry, ok := record.Value.(api.ConfigEntry); ok && entry.GetKind() == api.ServiceIntentions {
+			continue
+		}
+
+		data, err := json.Marshal(record.Value)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		for _, field := range ignoredFields {
+			delete(fields, field)
+		}
+		out[record.Key] = &normalized{record: record, fields: fields}
+	}
+	return out, nil
+}
+
+// changedFields returns the sorted names of the fields that differ.
+func changedFields(before, after map[string]any) []string {
+	var fields []string
+	for field, value := range before {
+		if other, ok := after[field]; !ok || !reflect.DeepEqual(value, other) {
+			fields = append(fields, field)
+		}
+	}
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// kvPairHash is a KV entry with its value replaced by a hash, which shows
+// whether values are the same without printing them.
+type kvPairHash struct {
+	*api.KVPair
+	Value string
+}
+
+// redact hides the secrets held by a record from the output: the secret ID
+// of tokens, the config of auth methods, which can hold client secrets and
+// service account tokens, and the private key of inline certificates. The
+// values of KV entries are replaced by their hash unless showValues is set.
+func redact(value any, showValues bool) any {
+	switch v := value.(type) {
+	case *api.ACLToken:
+		if v.SecretID == "" {
+			return v
+		}
+		copied := *v
+		copied.SecretID = redacted
+		return &copied
+
+	case *api.ACLAuthMethod:
+		if len(v.Config) == 0 {
+			return v
+		}
+		copied := *v
+		copied.Config = make(map[string]interface{}, len(v.Config))
+		for key := range v.Config {
+			copied.Config[key] = redacted
+		}
+		return &copied
+
+	case *api.InlineCertificateConfigEntry:
+		if v.PrivateKey == "" {
+			return v
+		}
+		copied := *v
+		copied.PrivateKey = redacted
+		return &copied
+
+	case *api.KVPair:
+		if showValues {
+			return v
+		}
+		out := &kvPairHash{KVPair: v}
+		if len(v.Value) > 0 {
+			sum := sha256.Sum256(v.Value)
+			out.Value = "sha256:" + hex.EncodeToString(sum[:])
+		}
+		return out
+	}
+	return value
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Compares the contents of two snapshots"
+const help = `
+Usage: consul snapshot diff [options] FILE1 FILE2
+
+  Compares the state saved in two snapshots, and reports the nodes, services,
+  checks, KV entries, intentions, config entries and ACL objects that were
+  added, removed or changed from the first snapshot to the second one.
+
+  Fields that change on every write, such as the Raft indexes, are ignored.
+  Secrets such as the secret IDs of tokens are redacted from the output, and
+  the values of KV entries are replaced by their SHA-256 hash unless
+  -show-values is set.
+
+  To compare the snapshots "old.snap" and "new.snap":
+
+    $ consul snapshot diff old.snap new.snap
+
+  To output the changes as JSON, including the records before and after
+  each change:
+
+    $ consul snapshot diff -format=json old.snap new.snap
+
+  For a full list of options and examples, please see the Consul documentation.
+`
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package diff
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	_ "github.com/hashicorp/consul/agent/consul/authmethod/testauth"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestSnapshotDiffCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestSnapshotDiffCommand_Validation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"no files": {
+			[]string{},
+			"Missing FILE arguments (expected 2, got 0)",
+		},
+		"one file": {
+			[]string{"a.snap"},
+			"Missing FILE arguments (expected 2, got 1)",
+		},
+		"extra args": {
+			[]string{"a.snap", "b.snap", "c.snap"},
+			"Too many arguments (expected 2, got 3)",
+		},
+		"bad format": {
+			[]string{"-format=yaml", "a.snap", "b.snap"},
+			"Unknown format: yaml",
+		},
+		"missing file": {
+			[]string{"a.snap", "b.snap"},
+			"Error reading snapshot \"a.snap\"",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui)
+			require.Equal(t, 1, c.Run(tc.args))
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestSnapshotDiffCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	dir := testutil.TempDir(t, "snapshot")
+	save := func(name string) string {
+		snap, _, err := client.Snapshot().Save(nil)
+		require.NoError(t, err)
+		defer snap.Close()
+		data, err := io.ReadAll(snap)
+		require.NoError(t, err)
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		return file
+	}
+
+	for _, key := range []string{"app/changed", "app/removed", "app/same"} {
+		_, err := client.KV().Put(&api.KVPair{Key: key, Value: []byte("before")}, nil)
+		require.NoError(t, err)
+	}
+	before := save("before.snap")
+
+	_, err := client.KV().Put(&api.KVPair{Key: "app/changed", Value: []byte("after")}, nil)
+	require.NoError(t, err)
+	_, err = client.KV().Put(&api.KVPair{Key: "app/same", Value: []byte("before")}, nil)
+	require.NoError(t, err)
+	_, err = client.KV().Delete("app/removed", nil)
+	require.NoError(t, err)
+	_, err = client.KV().Put(&api.KVPair{Key: "app/added", Value: []byte("after")}, nil)
+	require.NoError(t, err)
+	_, err = client.Catalog().Register(&api.CatalogRegistration{
+		Node:    "external",
+		Address: "10.0.0.1",
+		Service: &api.AgentService{ID: "db", Service: "db", Port: 5432},
+	}, nil)
+	require.NoError(t, err)
+	_, _, err = client.ConfigEntries().Set(&api.ServiceIntentionsConfigEntry{
+		Kind: api.ServiceIntentions,
+		Name: "db",
+		Sources: []*api.SourceIntention{
+			{Name: "web", Action: api.IntentionActionAllow},
+		},
+	}, nil)
+	require.NoError(t, err)
+	after := save("after.snap")
+
+	t.Run("pretty", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		require.Equal(t, 0, c.Run([]string{before, after}), ui.ErrorWriter.String())
+
+		output := ui.OutputWriter.String()
+		require.Contains(t, output, "+ nodes:external")
+		require.Contains(t, output, "+ services:external/db")
+		require.Contains(t, output, "+ kv:app/added")
+		require.Contains(t, output, "~ kv:app/changed (Value)")
+		require.Contains(t, output, "- kv:app/removed")
+		require.NotContains(t, output, "app/same")
+		require.Contains(t, output, "+ intentions:web => db")
+		require.NotContains(t, output, "config-entries:service-intentions")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		require.Equal(t, 0, c.Run([]string{"-format=json", before, after}), ui.ErrorWriter.String())
+
+		var out struct {
+			Changes []struct {
+				Kind   string
+				Key    string
+				Change string
+				Fields []string
+				Before map[string]any
+				After  map[string]any
+			}
+			Summary []*Summary
+		}
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &out))
+
+		var kv []string
+		for _, change := range out.Changes {
+			if change.Kind != "kv" {
+				continue
+			}
+			kv = append(kv, change.Change+" "+change.Key)
+			if change.Key == "app/changed" {
+				require.Equal(t, []string{"Value"}, change.Fields)
+				require.NotNil(t, change.Before)
+				require.NotNil(t, change.After)
+
+				// Values are hashed by default.
+				sum := sha256.Sum256([]byte("before"))
+				require.Equal(t, "sha256:"+hex.EncodeToString(sum[:]), change.Before["Value"])
+				require.Equal(t, "app/changed", change.Before["Key"])
+			}
+		}
+		require.Equal(t, []string{"added app/added", "changed app/changed", "removed app/removed"}, kv)
+		require.Contains(t, out.Summary, &Summary{Kind: "kv", Added: 1, Removed: 1, Changed: 1})
+		require.NotContains(t, ui.OutputWriter.String(), base64.StdEncoding.EncodeToString([]byte("before")))
+	})
+
+	t.Run("json with values", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		require.Equal(t, 0, c.Run([]string{"-format=json", "-show-values", before, after}), ui.ErrorWriter.String())
+
+		var out struct {
+			Changes []struct {
+				Key    string
+				Before *api.KVPair
+			}
+		}
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &out))
+		var found bool
+		for _, change := range out.Changes {
+			if change.Key == "app/changed" {
+				found = true
+				require.Equal(t, []byte("before"), change.Before.Value)
+			}
+		}
+		require.True(t, found)
+	})
+
+	t.Run("no differences", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		require.Equal(t, 0, c.Run([]string{after, after}), ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "No differences")
+	})
+}
+
+func TestSnapshotDiffCommand_RedactsTokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, `
+		primary_datacenter = "dc1"
+		acl {
+			enabled = true
+			default_policy = "deny"
+			tokens {
+				initial_management = "root"
+			}
+		}
+	`)
+	defer a.Shutdown()
+	client := a.Client()
+	testrpc.WaitForLeader(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	dir := testutil.TempDir(t, "snapshot")
+	save := func(name string) string {
+		snap, _, err := client.Snapshot().Save(&api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		defer snap.Close()
+		data, err := io.ReadAll(snap)
+		require.NoError(t, err)
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		return file
+	}
+
+	before := save("before.snap")
+	token, _, err := client.ACL().TokenCreate(&api.ACLToken{
+		Description: "ops",
+	}, &api.WriteOptions{Token: "root"})
+	require.NoError(t, err)
+	_, _, err = client.ACL().AuthMethodCreate(&api.ACLAuthMethod{
+		Name: "test",
+		Type: "testing",
+		Config: map[string]interface{}{
+			"SessionID": "auth-method-secret",
+		},
+	}, &api.WriteOptions{Token: "root"})
+	require.NoError(t, err)
+	after := save("after.snap")
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	require.Equal(t, 0, c.Run([]string{"-format=json", before, after}), ui.ErrorWriter.String())
+
+	output := ui.OutputWriter.String()
+	require.Contains(t, output, token.AccessorID)
+	require.Contains(t, output, redacted)
+	require.NotContains(t, output, token.SecretID)
+	require.NotContains(t, output, "auth-method-secret")
+
+	var out struct {
+		Changes []struct {
+			Kind  string
+			After map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &out))
+	var found bool
+	for _, change := range out.Changes {
+		if change.Kind == "acl-auth-methods" {
+			found = true
+			require.Equal(t, map[string]any{"SessionID": redacted}, change.After["Config"])
+		}
+	}
+	require.True(t, found)
+}
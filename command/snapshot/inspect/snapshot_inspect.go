@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/consul/agent/consul/fsm"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
 	"github.com/hashicorp/consul/snapshot"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
@@ -39,6 +40,7 @@ type cmd struct {
 	kvDetails bool
 	kvDepth   int
 	kvFilter  string
+	keys      snapshotcmd.KeyFlags
 }
 
 func (c *cmd) init() {
@@ -54,6 +56,8 @@ func (c *cmd) init() {
 		"format",
 		PrettyFormat,
 		fmt.Sprintf("Output format {%s}", strings.Join(GetSupportedFormats(), "|")))
+	flags.Merge(c.flags, c.keys.EncryptFlags(false))
+	flags.Merge(c.flags, c.keys.VerifyFlags())
 
 	c.help = flags.Usage(help, c.flags)
 }
@@ -138,16 +142,18 @@ func (c *cmd) Run(args []string) int {
 		}
 		meta = &metaDecoded
 	} else {
-		readFile, meta, err = snapshot.Read(hclog.New(nil), f)
+		opts, err := c.keys.Options(nil)
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		readFile, meta, err = snapshot.ReadWithOptions(hclog.New(nil), f, opts)
 		if err != nil {
 			c.UI.Error(fmt.Sprintf("Error reading snapshot: %s", err))
 			return 1
 		}
 		defer func() {
-			if err := readFile.Close(); err != nil {
-				c.UI.Error(fmt.Sprintf("Failed to close temp snapshot: %v", err))
-			}
-			if err := os.Remove(readFile.Name()); err != nil {
+			if err := snapshot.RemoveTemp(readFile); err != nil {
 				c.UI.Error(fmt.Sprintf("Failed to clean up temp snapshot: %v", err))
 			}
 		}()
@@ -367,6 +373,10 @@ Usage: consul snapshot inspect [options] FILE
   To inspect the file "backup.snap":
 
     $ consul snapshot inspect backup.snap
-  
+
+  To inspect an encrypted snapshot:
+
+    $ consul snapshot inspect -encrypt-key=snapshot.key backup.snap
+
   For a full list of options and examples, please see the Consul documentation.
 `
@@ -10,8 +10,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/snapshot"
 )
 
 // update allows golden files to be updated based on the current output.
@@ -99,6 +105,54 @@ func TestSnapshotInspectCommand(t *testing.T) {
 	require.Equal(t, want, ui.OutputWriter.String())
 }
 
+func TestSnapshotInspectCommand_Sealed(t *testing.T) {
+	dir := testutil.TempDir(t, "snapshot")
+	encryptKey, signKey, verifyKey := snapshotcmd.TestKeyFiles(t, dir)
+
+	// Encrypt and sign a copy of the snapshot.
+	f, err := os.Open("./testdata/backup.snap")
+	require.NoError(t, err)
+	defer f.Close()
+	state, meta, err := snapshot.Read(hclog.NewNullLogger(), f)
+	require.NoError(t, err)
+	defer snapshot.RemoveTemp(state)
+
+	var keys snapshotcmd.KeyFlags
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	flags.Merge(fs, keys.EncryptFlags(false))
+	flags.Merge(fs, keys.SignFlags())
+	require.NoError(t, fs.Parse([]string{"-encrypt-key=" + encryptKey, "-sign-key=" + signKey}))
+	opts, err := keys.Options(nil)
+	require.NoError(t, err)
+
+	sealed := filepath.Join(dir, "sealed.snap")
+	out, err := os.Create(sealed)
+	require.NoError(t, err)
+	require.NoError(t, snapshot.Write(out, meta, state, opts))
+	require.NoError(t, out.Close())
+
+	t.Run("decrypted", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-encrypt-key=" + encryptKey, "-verify-key=" + verifyKey, sealed})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Equal(t, golden(t, "TestSnapshotInspectCommand", ""), ui.OutputWriter.String())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{sealed})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "snapshot is encrypted")
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-verify-key=" + verifyKey, "./testdata/backup.snap"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "snapshot is not signed")
+	})
+}
+
 func TestSnapshotInspectKVDetailsCommand(t *testing.T) {
 
 	filepath := "./testdata/backupWithKV.snap"
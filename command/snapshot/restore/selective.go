@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package restore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
+)
+
+// selector selects the records of a kind to restore, optionally limited to
+// the KV entries under a prefix.
+type selector struct {
+	kind   string
+	prefix string
+}
+
+// parseSelectors parses the comma-separated list of record kinds given to
+// the -only flag, such as "kv:/app/foo,config-entries,acl-policies".
+func parseSelectors(only string) ([]selector, error) {
+	var selectors []selector
+	for _, raw := range strings.Split(only, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		kind, prefix, hasPrefix := strings.Cut(raw, ":")
+		if !isRecordKind(kind) {
+			return nil, fmt.Errorf("unknown record type %q, must be one of: %s",
+				kind, strings.Join(snapshotcmd.RecordKinds, ", "))
+		}
+		if hasPrefix && kind != snapshotcmd.RecordKV {
+			return nil, fmt.Errorf("only %s records can be restored under a prefix, got %q",
+				snapshotcmd.RecordKV, raw)
+		}
+		selectors = append(selectors, selector{
+			kind:   kind,
+			prefix: strings.TrimPrefix(prefix, "/"),
+		})
+	}
+	if len(selectors) == 0 {
+		return nil, errors.New("no record types given")
+	}
+	return selectors, nil
+}
+
+func isRecordKind(kind string) bool {
+	for _, k := range snapshotcmd.RecordKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func selected(selectors []selector, record *snapshotcmd.Record) bool {
+	for _, s := range selectors {
+		if s.kind == record.Kind && underPrefix(record.Key, s.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// underPrefix reports whether a KV key is the prefix itself or one of the
+// keys under it. Prefixes match whole path segments, so that "app/foo" does
+// not select "app/foobar".
+func underPrefix(key, prefix string) bool {
+	if prefix == "" || key == prefix {
+		return true
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return strings.HasPrefix(key, prefix)
+}
+
+// action is what restoring a record does to the live state.
+type action int
+
+const (
+	actionUnchanged action = iota
+	actionCreate
+	actionUpdate
+	actionSkip
+)
+
+func (a action) String() string {
+	switch a {
+	case actionCreate:
+		return "create"
+	case actionUpdate:
+		return "update"
+	case actionSkip:
+		return "skip"
+	default:
+		return "unchanged"
+	}
+}
+
+// replayer writes records read from a snapshot back to a cluster through
+// the regular API endpoints, so each of them goes through a normal Raft
+// write and the usual validation.
+type replayer struct {
+	client *api.Client
+	dryRun bool
+}
+
+// replay compares a record with the live state and, unless this is a dry run,
+// writes it if it is missing or differs. For skipped records it also returns
+// the reason.
+func (r *replayer) replay(record *snapshotcmd.Record) (action, string, error) {
+	switch v := record.Value.(type) {
+	case *api.KVPair:
+		return r.replayKV(v)
+	case api.ConfigEntry:
+		return r.replayConfigEntry(v)
+	case *api.ACLPolicy:
+		return r.replayPolicy(v)
+	case *api.ACLRole:
+		return r.replayRole(v)
+	case *api.ACLAuthMethod:
+		return r.replayAuthMethod(v)
+	case *api.ACLBindingRule:
+		return r.replayBindingRule(v)
+	case *api.ACLToken:
+		return r.replayToken(v)
+	default:
+		return actionSkip, "", fmt.Errorf("unsupported record type %T", v)
+	}
+}
+
+func (r *replayer) replayKV(pair *api.KVPair) (action, string, error) {
+	live, _, err := r.client.KV().Get(pair.Key, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	act := actionCreate
+	if live != nil {
+		if reflect.DeepEqual(live.Value, pair.Value) && live.Flags == pair.Flags && live.TTL == pair.TTL {
+			return actionUnchanged, "", nil
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+	_, err = r.client.KV().Put(pair, nil)
+	return act, "", err
+}
+
+func (r *replayer) replayConfigEntry(entry api.ConfigEntry) (action, string, error) {
+	live, _, err := r.client.ConfigEntries().Get(entry.GetKind(), entry.GetName(), nil)
+	if err != nil && !isNotFound(err) {
+		return 0, "", err
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, entry)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+	_, _, err = r.client.ConfigEntries().Set(entry, nil)
+	return act, "", err
+}
+
+// replayPolicy matches the policy with a live one by its ID or else its name,
+// as policies that are created again get a new ID.
+func (r *replayer) replayPolicy(policy *api.ACLPolicy) (action, string, error) {
+	live, _, err := r.client.ACL().PolicyRead(policy.ID, nil)
+	if err != nil && !isNotFound(err) {
+		return 0, "", err
+	}
+	if live == nil {
+		if live, _, err = r.client.ACL().PolicyReadByName(policy.Name, nil); err != nil {
+			return 0, "", err
+		}
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, policy)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+
+	write := *policy
+	write.CreateIndex, write.ModifyIndex, write.Hash = 0, 0, nil
+	if live != nil {
+		write.ID = live.ID
+		_, _, err = r.client.ACL().PolicyUpdate(&write, nil)
+	} else {
+		write.ID = ""
+		_, _, err = r.client.ACL().PolicyCreate(&write, nil)
+	}
+	return act, "", err
+}
+
+// replayRole matches the role with a live one by its ID or else its name, as
+// roles that are created again get a new ID.
+func (r *replayer) replayRole(role *api.ACLRole) (action, string, error) {
+	live, _, err := r.client.ACL().RoleRead(role.ID, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	if live == nil {
+		if live, _, err = r.client.ACL().RoleReadByName(role.Name, nil); err != nil {
+			return 0, "", err
+		}
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, role)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+
+	write := *role
+	write.CreateIndex, write.ModifyIndex, write.Hash = 0, 0, nil
+	if write.Policies, err = r.policyLinks(role.Policies); err != nil {
+		return 0, "", err
+	}
+	if live != nil {
+		write.ID = live.ID
+		_, _, err = r.client.ACL().RoleUpdate(&write, nil)
+	} else {
+		write.ID = ""
+		_, _, err = r.client.ACL().RoleCreate(&write, nil)
+	}
+	return act, "", err
+}
+
+func (r *replayer) replayAuthMethod(method *api.ACLAuthMethod) (action, string, error) {
+	live, _, err := r.client.ACL().AuthMethodRead(method.Name, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, method)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+
+	write := *method
+	write.CreateIndex, write.ModifyIndex = 0, 0
+	if live != nil {
+		_, _, err = r.client.ACL().AuthMethodUpdate(&write, nil)
+	} else {
+		_, _, err = r.client.ACL().AuthMethodCreate(&write, nil)
+	}
+	return act, "", err
+}
+
+// replayBindingRule matches the binding rule with a live one by its ID or
+// else by an identical rule of the same auth method, since binding rules have
+// no name and get a new ID when they are created again.
+func (r *replayer) replayBindingRule(rule *api.ACLBindingRule) (action, string, error) {
+	live, _, err := r.client.ACL().BindingRuleRead(rule.ID, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, rule)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	} else {
+		rules, _, err := r.client.ACL().BindingRuleList(rule.AuthMethod, nil)
+		if err != nil {
+			return 0, "", err
+		}
+		for _, other := range rules {
+			equal, err := equivalent(other, rule)
+			if err != nil || equal {
+				return actionUnchanged, "", err
+			}
+		}
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+
+	write := *rule
+	write.CreateIndex, write.ModifyIndex = 0, 0
+	if live != nil {
+		_, _, err = r.client.ACL().BindingRuleUpdate(&write, nil)
+	} else {
+		write.ID = ""
+		_, _, err = r.client.ACL().BindingRuleCreate(&write, nil)
+	}
+	return act, "", err
+}
+
+// replayToken restores a token with its original accessor and secret IDs.
+// Tokens created by logging in to an auth method and expired tokens cannot be
+// written through the API, so they are skipped.
+func (r *replayer) replayToken(token *api.ACLToken) (action, string, error) {
+	if token.AuthMethod != "" {
+		return actionSkip, "created by a login to an auth method", nil
+	}
+	if token.ExpirationTime != nil && !token.ExpirationTime.After(time.Now()) {
+		return actionSkip, "expired", nil
+	}
+
+	live, _, err := r.client.ACL().TokenRead(token.AccessorID, nil)
+	if err != nil && !isNotFound(err) {
+		return 0, "", err
+	}
+
+	act := actionCreate
+	if live != nil {
+		equal, err := equivalent(live, token)
+		if err != nil || equal {
+			return actionUnchanged, "", err
+		}
+		act = actionUpdate
+	}
+	if r.dryRun {
+		return act, "", nil
+	}
+
+	write := *token
+	write.CreateIndex, write.ModifyIndex, write.Hash = 0, 0, nil
+	write.CreateTime = time.Time{}
+	if write.Policies, err = r.policyLinks(token.Policies); err != nil {
+		return 0, "", err
+	}
+	if write.Roles, err = r.roleLinks(token.Roles); err != nil {
+		return 0, "", err
+	}
+	if live != nil {
+		_, _, err = r.client.ACL().TokenUpdate(&write, nil)
+	} else {
+		_, _, err = r.client.ACL().TokenCreate(&write, nil)
+	}
+	return act, "", err
+}
+
+// policyLinks keeps the links to policies by ID when the policy still
+// exists, and otherwise links them by name so that they resolve to the
+// policies restored under a new ID.
+func (r *replayer) policyLinks(links []*api.ACLLink) ([]*api.ACLLink, error) {
+	return resolveLinks(links, func(id string) (bool, error) {
+		policy, _, err := r.client.ACL().PolicyRead(id, nil)
+		if isNotFound(err) {
+			return false, nil
+		}
+		return policy != nil, err
+	})
+}
+
+// roleLinks is the equivalent of policyLinks for links to roles.
+func (r *replayer) roleLinks(links []*api.ACLLink) ([]*api.ACLLink, error) {
+	return resolveLinks(links, func(id string) (bool, error) {
+		role, _, err := r.client.ACL().RoleRead(id, nil)
+		return role != nil, err
+	})
+}
+
+func resolveLinks(links []*api.ACLLink, exists func(id string) (bool, error)) ([]*api.ACLLink, error) {
+	var out []*api.ACLLink
+	for _, link := range links {
+		resolved := &api.ACLLink{Name: link.Name}
+		if link.ID != "" {
+			ok, err := exists(link.ID)
+			if err != nil {
+				return nil, err
+			}
+			if ok || link.Name == "" {
+				resolved = &api.ACLLink{ID: link.ID}
+			}
+		}
+		out = append(out, resolved)
+	}
+	return out, nil
+}
+
+// ignoredFields are the fields that are set by the servers as objects are
+// written, or that differ once an object is created again.
+var ignoredFields = []string{"ID", "CreateIndex", "ModifyIndex", "CreateTime", "Hash"}
+
+// equivalent reports whether two objects of the same API type are equal,
+// ignoring the fields set by the servers and comparing links to other ACL
+// objects by name.
+func equivalent(a, b any) (bool, error) {
+	na, err := normalize(a)
+	if err != nil {
+		return false, err
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(na, nb), nil
+}
+
+func normalize(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	for _, field := range ignoredFields {
+		delete(out, field)
+	}
+	for _, field := range []string{"Policies", "Roles"} {
+		links, _ := out[field].([]any)
+		for _, link := range links {
+			if link, ok := link.(map[string]any); ok {
+				delete(link, "ID")
+			}
+		}
+	}
+	return out, nil
+}
+
+// isNotFound reports whether a read failed because the object does not exist.
+// Reading a token that does not exist is refused like an unknown token.
+func isNotFound(err error) bool {
+	var statusErr api.StatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	return statusErr.Code == http.StatusNotFound ||
+		(statusErr.Code == http.StatusForbidden && strings.Contains(statusErr.Body, acl.ErrNotFound.Error()))
+}
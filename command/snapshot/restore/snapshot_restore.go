@@ -4,12 +4,20 @@
 package restore
 
 import (
+	"bufio"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
-	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/go-hclog"
 	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
+	"github.com/hashicorp/consul/snapshot"
 )
 
 func New(ui cli.Ui) *cmd {
@@ -23,6 +31,10 @@ type cmd struct {
 	flags *flag.FlagSet
 	http  *flags.HTTPFlags
 	help  string
+	keys  snapshotcmd.KeyFlags
+
+	only   string
+	dryRun bool
 }
 
 func (c *cmd) init() {
@@ -30,6 +42,16 @@ func (c *cmd) init() {
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.keys.EncryptFlags(true))
+	flags.Merge(c.flags, c.keys.VerifyFlags())
+	c.flags.StringVar(&c.only, "only", "",
+		"Restore only the given comma-separated types of records instead of "+
+			"replacing the whole state, by writing them through the regular API. "+
+			"Supported types are kv, config-entries, acl-policies, acl-roles, "+
+			"acl-tokens, acl-auth-methods and acl-binding-rules. KV entries can be "+
+			"limited to a prefix with \"kv:<prefix>\".")
+	c.flags.BoolVar(&c.dryRun, "dry-run", false,
+		"Show the records that -only would create or update, without writing them.")
 	c.help = flags.Usage(help, c.flags)
 }
 
@@ -52,6 +74,18 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	var selectors []selector
+	if c.only != "" {
+		var err error
+		if selectors, err = parseSelectors(c.only); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing -only: %s", err))
+			return 1
+		}
+	} else if c.dryRun {
+		c.UI.Error("The -dry-run flag can only be used with -only")
+		return 1
+	}
+
 	// Create and test the HTTP client
 	client, err := c.http.APIClient()
 	if err != nil {
@@ -67,8 +101,62 @@ func (c *cmd) Run(args []string) int {
 	}
 	defer f.Close()
 
+	if selectors != nil {
+		return c.restoreSelected(client, f, selectors)
+	}
+
+	// Decrypt the snapshot and check its signature locally, if configured,
+	// since the servers only accept plain snapshots. The plain snapshot is
+	// streamed to the servers, and is left incomplete so they reject it if
+	// the check fails.
+	var in io.Reader = f
+	var plain *io.PipeReader
+	var unsealErr chan error
+	if c.keys.Configured() {
+		opts, err := c.keys.Options(client)
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		var pw *io.PipeWriter
+		plain, pw = io.Pipe()
+		unsealErr = make(chan error, 1)
+		go func() {
+			// The result is reported before the request can see the end
+			// of the stream.
+			_, err := snapshot.Rewrite(pw, f, opts, nil)
+			unsealErr <- err
+			pw.CloseWithError(err)
+		}()
+		in = plain
+	} else {
+		buffered := bufio.NewReader(f)
+		encrypted, err := snapshot.IsEncrypted(buffered)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading snapshot: %s", err))
+			return 1
+		}
+		if encrypted {
+			c.UI.Error("Error reading snapshot: the snapshot is encrypted, use -encrypt-key or -encrypt-keyring to decrypt it")
+			return 1
+		}
+		in = buffered
+	}
+
 	// Restore the snapshot.
-	err = client.Snapshot().Restore(nil, f)
+	err = client.Snapshot().Restore(nil, in)
+	if plain != nil {
+		select {
+		case err := <-unsealErr:
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading snapshot: %s", err))
+				return 1
+			}
+		default:
+			// The request failed before the whole snapshot was read.
+			plain.Close()
+		}
+	}
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error restoring snapshot: %s", err))
 		return 1
@@ -78,6 +166,68 @@ func (c *cmd) Run(args []string) int {
 	return 0
 }
 
+// restoreSelected replays the selected records of the snapshot through the
+// API, leaving the rest of the state alone.
+func (c *cmd) restoreSelected(client *api.Client, in io.Reader, selectors []selector) int {
+	var opts *snapshot.Options
+	if c.keys.Configured() {
+		var err error
+		if opts, err = c.keys.Options(client); err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+	}
+
+	state, _, err := snapshot.ReadWithOptions(hclog.NewNullLogger(), in, opts)
+	if errors.Is(err, snapshot.ErrEncrypted) {
+		c.UI.Error("Error reading snapshot: the snapshot is encrypted, use -encrypt-key or -encrypt-keyring to decrypt it")
+		return 1
+	}
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading snapshot: %s", err))
+		return 1
+	}
+	defer snapshot.RemoveTemp(state)
+
+	records, err := snapshotcmd.ReadRecords(state)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error decoding snapshot: %s", err))
+		return 1
+	}
+
+	r := &replayer{client: client, dryRun: c.dryRun}
+	counts := make(map[action]int)
+	for _, kind := range snapshotcmd.RecordKinds {
+		for _, record := range records[kind] {
+			if !selected(selectors, record) {
+				continue
+			}
+			act, reason, err := r.replay(record)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error restoring %s: %s", record, err))
+				return 1
+			}
+			counts[act]++
+
+			switch act {
+			case actionCreate, actionUpdate:
+				c.UI.Output(fmt.Sprintf("%-7s %s", act, record))
+			case actionSkip:
+				c.UI.Output(fmt.Sprintf("%-7s %s (%s)", act, record, reason))
+			}
+		}
+	}
+
+	if c.dryRun {
+		c.UI.Info(fmt.Sprintf("Dry run: %d to create, %d to update, %d unchanged, %d skipped",
+			counts[actionCreate], counts[actionUpdate], counts[actionUnchanged], counts[actionSkip]))
+		return 0
+	}
+	c.UI.Info(fmt.Sprintf("Restored selected records: %d created, %d updated, %d unchanged, %d skipped",
+		counts[actionCreate], counts[actionUpdate], counts[actionUnchanged], counts[actionSkip]))
+	return 0
+}
+
 func (c *cmd) Synopsis() string {
 	return synopsis
 }
@@ -106,5 +256,17 @@ Usage: consul snapshot restore [options] FILE
 
     $ consul snapshot restore backup.snap
 
+  To restore an encrypted snapshot, and refuse it unless it is signed with the
+  private key matching "signing.pub":
+
+    $ consul snapshot restore -encrypt-key=snapshot.key -verify-key=signing.pub backup.snap
+
+  To restore only the KV entries under "app/foo" and the config entries, by
+  writing them through the regular API and leaving the rest of the state alone,
+  after listing the changes that would be made:
+
+    $ consul snapshot restore -only=kv:app/foo,config-entries -dry-run backup.snap
+    $ consul snapshot restore -only=kv:app/foo,config-entries backup.snap
+
   For a full list of options and examples, please see the Consul documentation.
 `
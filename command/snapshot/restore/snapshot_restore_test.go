@@ -12,11 +12,17 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
 	"github.com/hashicorp/consul/agent"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
 	"github.com/hashicorp/consul/sdk/testutil"
-	"github.com/mitchellh/cli"
-	"github.com/stretchr/testify/require"
+	"github.com/hashicorp/consul/snapshot"
+	"github.com/hashicorp/consul/testrpc"
 )
 
 func TestSnapshotRestoreCommand_noTabs(t *testing.T) {
@@ -28,8 +34,6 @@ func TestSnapshotRestoreCommand_noTabs(t *testing.T) {
 
 func TestSnapshotRestoreCommand_Validation(t *testing.T) {
 	t.Parallel()
-	ui := cli.NewMockUi()
-	c := New(ui)
 
 	cases := map[string]struct {
 		args   []string
@@ -43,16 +47,24 @@ func TestSnapshotRestoreCommand_Validation(t *testing.T) {
 			[]string{"foo", "bar", "baz"},
 			"Too many arguments",
 		},
+		"dry run without only": {
+			[]string{"-dry-run", "foo"},
+			"The -dry-run flag can only be used with -only",
+		},
+		"unknown record type": {
+			[]string{"-only=kv,sessions", "foo"},
+			"unknown record type \"sessions\"",
+		},
+		"prefix on non-kv": {
+			[]string{"-only=config-entries:web", "foo"},
+			"only kv records can be restored under a prefix",
+		},
 	}
 
 	for name, tc := range cases {
-		// Ensure our buffer is always clear
-		if ui.ErrorWriter != nil {
-			ui.ErrorWriter.Reset()
-		}
-		if ui.OutputWriter != nil {
-			ui.OutputWriter.Reset()
-		}
+		// Use a new command for each case, since flags are not reset.
+		ui := cli.NewMockUi()
+		c := New(ui)
 
 		code := c.Run(tc.args)
 		if code == 0 {
@@ -167,3 +179,232 @@ func TestSnapshotRestoreCommand_TruncatedSnapshot(t *testing.T) {
 		})
 	}
 }
+
+func TestSnapshotRestoreCommand_Sealed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	dir := testutil.TempDir(t, "snapshot")
+	encryptKey, signKey, verifyKey := snapshotcmd.TestKeyFiles(t, dir)
+
+	// Take a snapshot and write plain and sealed copies of it.
+	snap, _, err := client.Snapshot().Save(nil)
+	require.NoError(t, err)
+	state, meta, err := snapshot.Read(hclog.NewNullLogger(), snap)
+	snap.Close()
+	require.NoError(t, err)
+	defer snapshot.RemoveTemp(state)
+
+	var keys snapshotcmd.KeyFlags
+	fs := keys.EncryptFlags(false)
+	flags.Merge(fs, keys.SignFlags())
+	require.NoError(t, fs.Parse([]string{"-encrypt-key=" + encryptKey, "-sign-key=" + signKey}))
+	opts, err := keys.Options(nil)
+	require.NoError(t, err)
+
+	writeFile := func(name string, opts *snapshot.Options) string {
+		_, err := state.Seek(0, 0)
+		require.NoError(t, err)
+		file := filepath.Join(dir, name)
+		f, err := os.Create(file)
+		require.NoError(t, err)
+		defer f.Close()
+		require.NoError(t, snapshot.Write(f, meta, state, opts))
+		return file
+	}
+	sealed := writeFile("sealed.snap", opts)
+	plain := writeFile("plain.snap", nil)
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"sealed": {
+			args: []string{"-encrypt-key=" + encryptKey, "-verify-key=" + verifyKey, sealed},
+		},
+		"missing key": {
+			args:   []string{sealed},
+			output: "the snapshot is encrypted",
+		},
+		"unsigned": {
+			args:   []string{"-verify-key=" + verifyKey, plain},
+			output: "snapshot is not signed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui)
+			code := c.Run(append([]string{"-http-addr=" + a.HTTPAddr()}, tc.args...))
+			if tc.output == "" {
+				require.Equal(t, 0, code, ui.ErrorWriter.String())
+				return
+			}
+			require.Equal(t, 1, code)
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestUnderPrefix(t *testing.T) {
+	cases := []struct {
+		key, prefix string
+		want        bool
+	}{
+		{"app/foo", "", true},
+		{"app/foo", "app/foo", true},
+		{"app/foo/a", "app/foo", true},
+		{"app/foo/a", "app/foo/", true},
+		{"app/foo/", "app/foo/", true},
+		{"app/foobar", "app/foo", false},
+		{"app/foo", "app/foo/", false},
+		{"app", "app/foo", false},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.want, underPrefix(tc.key, tc.prefix), "key %q prefix %q", tc.key, tc.prefix)
+	}
+}
+
+func TestSnapshotRestoreCommand_Only(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, `
+		primary_datacenter = "dc1"
+		acl {
+			enabled = true
+			default_policy = "deny"
+			tokens {
+				initial_management = "root"
+			}
+		}
+	`)
+	defer a.Shutdown()
+	client := a.Client()
+	testrpc.WaitForLeader(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	q := &api.WriteOptions{Token: "root"}
+	for _, key := range []string{"app/foo/a", "app/foo/b", "app/foobar", "app/bar"} {
+		_, err := client.KV().Put(&api.KVPair{Key: key, Value: []byte(key)}, q)
+		require.NoError(t, err)
+	}
+	_, _, err := client.ConfigEntries().Set(&api.ServiceConfigEntry{
+		Kind:     api.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	}, q)
+	require.NoError(t, err)
+	policy, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "app",
+		Rules: `key_prefix "app/" { policy = "read" }`,
+	}, q)
+	require.NoError(t, err)
+	role, _, err := client.ACL().RoleCreate(&api.ACLRole{
+		Name:     "app",
+		Policies: []*api.ACLRolePolicyLink{{ID: policy.ID}},
+	}, q)
+	require.NoError(t, err)
+	token, _, err := client.ACL().TokenCreate(&api.ACLToken{
+		Description: "app",
+		Policies:    []*api.ACLTokenPolicyLink{{ID: policy.ID}},
+		Roles:       []*api.ACLTokenRoleLink{{ID: role.ID}},
+	}, q)
+	require.NoError(t, err)
+
+	dir := testutil.TempDir(t, "snapshot")
+	file := filepath.Join(dir, "backup.snap")
+	snap, _, err := client.Snapshot().Save(&api.QueryOptions{Token: "root"})
+	require.NoError(t, err)
+	data, err := io.ReadAll(snap)
+	snap.Close()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, data, 0600))
+
+	// Lose some of the state.
+	_, err = client.KV().DeleteTree("app/", q)
+	require.NoError(t, err)
+	_, err = client.KV().Put(&api.KVPair{Key: "app/foo/b", Value: []byte("changed")}, q)
+	require.NoError(t, err)
+	_, err = client.ConfigEntries().Delete(api.ServiceDefaults, "web", q)
+	require.NoError(t, err)
+	_, err = client.ACL().TokenDelete(token.AccessorID, q)
+	require.NoError(t, err)
+	_, err = client.ACL().RoleDelete(role.ID, q)
+	require.NoError(t, err)
+	_, err = client.ACL().PolicyDelete(policy.ID, q)
+	require.NoError(t, err)
+
+	only := "-only=kv:/app/foo,config-entries,acl-policies,acl-roles,acl-tokens"
+	run := func(t *testing.T, args ...string) string {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		args = append([]string{"-http-addr=" + a.HTTPAddr(), "-token=root"}, args...)
+		code := c.Run(append(args, file))
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		return ui.OutputWriter.String()
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		output := run(t, only, "-dry-run")
+		require.Contains(t, output, "create  kv:app/foo/a")
+		require.Contains(t, output, "update  kv:app/foo/b")
+		require.NotContains(t, output, "app/bar")
+		require.NotContains(t, output, "app/foobar")
+		require.Contains(t, output, "create  config-entries:service-defaults/web")
+		require.Contains(t, output, "create  acl-policies:app")
+		require.Contains(t, output, "create  acl-roles:app")
+		require.Contains(t, output, "create  acl-tokens:"+token.AccessorID)
+		require.Contains(t, output, "Dry run: 5 to create, 1 to update")
+
+		pair, _, err := client.KV().Get("app/foo/a", &api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		require.Nil(t, pair)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		output := run(t, only)
+		require.Contains(t, output, "Restored selected records: 5 created, 1 updated")
+
+		qo := &api.QueryOptions{Token: "root"}
+		for _, key := range []string{"app/foo/a", "app/foo/b"} {
+			pair, _, err := client.KV().Get(key, qo)
+			require.NoError(t, err)
+			require.NotNil(t, pair)
+			require.Equal(t, key, string(pair.Value))
+		}
+		for _, key := range []string{"app/foobar", "app/bar"} {
+			pair, _, err := client.KV().Get(key, qo)
+			require.NoError(t, err)
+			require.Nil(t, pair)
+		}
+
+		entry, _, err := client.ConfigEntries().Get(api.ServiceDefaults, "web", qo)
+		require.NoError(t, err)
+		require.Equal(t, "http", entry.(*api.ServiceConfigEntry).Protocol)
+
+		// The policy and role are created again with new IDs, and the token
+		// links to them by name.
+		restored, _, err := client.ACL().TokenRead(token.AccessorID, qo)
+		require.NoError(t, err)
+		require.Equal(t, token.SecretID, restored.SecretID)
+		require.Len(t, restored.Policies, 1)
+		require.Equal(t, "app", restored.Policies[0].Name)
+		require.NotEqual(t, policy.ID, restored.Policies[0].ID)
+		require.Len(t, restored.Roles, 1)
+		require.Equal(t, "app", restored.Roles[0].Name)
+		require.NotEqual(t, role.ID, restored.Roles[0].ID)
+	})
+
+	t.Run("restore again", func(t *testing.T) {
+		output := run(t, only)
+		require.Contains(t, output, "Restored selected records: 0 created, 0 updated")
+	})
+}
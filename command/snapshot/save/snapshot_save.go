@@ -4,9 +4,11 @@
 package save
 
 import (
+	"crypto/ed25519"
 	"flag"
 	"fmt"
 	"golang.org/x/exp/slices"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
 	"github.com/hashicorp/consul/snapshot"
 )
 
@@ -31,6 +34,7 @@ type cmd struct {
 	http               *flags.HTTPFlags
 	help               string
 	appendFileNameFlag flags.StringValue
+	keys               snapshotcmd.KeyFlags
 }
 
 func (c *cmd) getAppendFileNameFlag() *flag.FlagSet {
@@ -46,6 +50,8 @@ func (c *cmd) init() {
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
 	flags.Merge(c.flags, c.getAppendFileNameFlag())
+	flags.Merge(c.flags, c.keys.EncryptFlags(true))
+	flags.Merge(c.flags, c.keys.SignFlags())
 	c.help = flags.Usage(help, c.flags)
 }
 
@@ -116,6 +122,15 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	// Load the keys before taking the snapshot, so mistakes fail fast.
+	var opts *snapshot.Options
+	if c.keys.Configured() {
+		if opts, err = c.keys.Options(client); err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+	}
+
 	// Take the snapshot.
 	snap, qm, err := client.Snapshot().Save(&api.QueryOptions{
 		AllowStale: c.http.Stale(),
@@ -126,21 +141,35 @@ func (c *cmd) Run(args []string) int {
 	}
 	defer snap.Close()
 
-	// Save the file first.
+	// Save the file first. Snapshots that are encrypted are sealed as they
+	// are streamed to disk, so their contents are never written in plain.
 	unverifiedFile := file + ".unverified"
-	if _, err := safeio.WriteToFile(snap, unverifiedFile, 0600); err != nil {
+	if opts != nil {
+		err = sealToFile(snap, unverifiedFile, opts)
+	} else {
+		_, err = safeio.WriteToFile(snap, unverifiedFile, 0600)
+	}
+	if err != nil {
+		os.Remove(unverifiedFile)
 		c.UI.Error(fmt.Sprintf("Error writing unverified snapshot file: %s", err))
 		return 1
 	}
 	defer os.Remove(unverifiedFile)
 
-	// Read it back to verify.
+	// Read it back to verify, with the keys it was sealed with.
+	var verifyOpts *snapshot.Options
+	if opts != nil {
+		verifyOpts = &snapshot.Options{EncryptionKeys: opts.EncryptionKeys}
+		if opts.SigningKey != nil {
+			verifyOpts.VerifyKey = opts.SigningKey.Public().(ed25519.PublicKey)
+		}
+	}
 	f, err := os.Open(unverifiedFile)
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error opening snapshot file for verify: %s", err))
 		return 1
 	}
-	if _, err := snapshot.Verify(f); err != nil {
+	if _, err := snapshot.VerifyWithOptions(f, verifyOpts); err != nil {
 		f.Close()
 		c.UI.Error(fmt.Sprintf("Error verifying snapshot file: %s", err))
 		return 1
@@ -159,6 +188,24 @@ func (c *cmd) Run(args []string) int {
 	return 0
 }
 
+// sealToFile streams the plain snapshot archive read from in to the file at
+// path, encrypted and signed as configured by opts.
+func sealToFile(in io.Reader, path string, opts *snapshot.Options) error {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := snapshot.Rewrite(out, in, nil, opts); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 func (c *cmd) Synopsis() string {
 	return synopsis
 }
@@ -187,5 +234,10 @@ Usage: consul snapshot save [options] FILE
 
     $ consul snapshot save -stale backup.snap
 
+  To encrypt the snapshot with a key generated by "consul keygen" and sign it
+  with an Ed25519 private key:
+
+    $ consul snapshot save -encrypt-key=snapshot.key -sign-key=signing.pem backup.snap
+
   For a full list of options and examples, please see the Consul documentation.
 `
@@ -20,8 +20,11 @@ import (
 
 	"github.com/hashicorp/consul/agent"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	snapshotcmd "github.com/hashicorp/consul/command/snapshot"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/snapshot"
 )
 
 func TestSnapshotSaveCommand_noTabs(t *testing.T) {
@@ -177,6 +180,56 @@ func TestSnapshotSaveCommand(t *testing.T) {
 	}
 }
 
+func TestSnapshotSaveCommand_Sealed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	dir := testutil.TempDir(t, "snapshot")
+	encryptKey, signKey, verifyKey := snapshotcmd.TestKeyFiles(t, dir)
+	file := filepath.Join(dir, "backup.tgz")
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-encrypt-key=" + encryptKey,
+		"-sign-key=" + signKey,
+		file,
+	})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	fi, err := os.Stat(file)
+	require.NoError(t, err)
+	require.Equal(t, fi.Mode(), os.FileMode(0600))
+
+	// The intermediate files are cleaned up.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	verify := func(t *testing.T, opts *snapshot.Options) error {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = snapshot.VerifyWithOptions(f, opts)
+		return err
+	}
+	require.ErrorIs(t, verify(t, nil), snapshot.ErrEncrypted)
+
+	var keys snapshotcmd.KeyFlags
+	fs := keys.EncryptFlags(false)
+	flags.Merge(fs, keys.VerifyFlags())
+	require.NoError(t, fs.Parse([]string{"-encrypt-key=" + encryptKey, "-verify-key=" + verifyKey}))
+	opts, err := keys.Options(nil)
+	require.NoError(t, err)
+	require.NoError(t, verify(t, opts))
+}
+
 func TestSnapshotSaveCommand_TruncatedStream(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
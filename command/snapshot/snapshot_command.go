@@ -54,6 +54,10 @@ Usage: consul snapshot <subcommand> [options] [args]
 
       $ consul snapshot inspect backup.snap
 
+  Compare two snapshots:
+
+      $ consul snapshot diff old.snap new.snap
+
   Run a daemon process that locally saves a snapshot every hour (available only in
   Consul Enterprise) :
 
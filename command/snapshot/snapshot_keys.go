@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/snapshot"
+)
+
+// KeyFlags holds the flags that configure how snapshot archives are
+// encrypted, signed and verified by the snapshot subcommands.
+type KeyFlags struct {
+	encryptKey     string
+	encryptKeyring bool
+	signKey        string
+	verifyKey      string
+}
+
+// EncryptFlags returns the flags for the key used to encrypt or decrypt
+// archives. The keyring flag is only included for commands that talk to an
+// agent.
+func (f *KeyFlags) EncryptFlags(keyring bool) *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringVar(&f.encryptKey, "encrypt-key", "",
+		"Path to a file containing a base64-encoded AES key, such as one generated "+
+			"by \"consul keygen\", used to encrypt or decrypt the snapshot.")
+	if keyring {
+		fs.BoolVar(&f.encryptKeyring, "encrypt-keyring", false,
+			"Use the agent's gossip encryption keyring to encrypt or decrypt the "+
+				"snapshot. Snapshots are encrypted with the primary key, and can be "+
+				"decrypted with any installed key.")
+	}
+	return fs
+}
+
+// SignFlags returns the flags for the key used to sign archives.
+func (f *KeyFlags) SignFlags() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringVar(&f.signKey, "sign-key", "",
+		"Path to a PEM-encoded Ed25519 private key used to sign the snapshot.")
+	return fs
+}
+
+// VerifyFlags returns the flags for the key used to verify the signature of
+// archives.
+func (f *KeyFlags) VerifyFlags() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringVar(&f.verifyKey, "verify-key", "",
+		"Path to a PEM-encoded Ed25519 public key. If set, the snapshot must be "+
+			"signed with the matching private key and unsigned snapshots are refused.")
+	return fs
+}
+
+// Configured returns true if any of the key flags are set.
+func (f *KeyFlags) Configured() bool {
+	return f.encryptKey != "" || f.encryptKeyring || f.signKey != "" || f.verifyKey != ""
+}
+
+// Options loads the configured keys. The client is only used to read the
+// gossip encryption keyring, and may be nil if that flag is not offered.
+func (f *KeyFlags) Options(client *api.Client) (*snapshot.Options, error) {
+	var opts snapshot.Options
+
+	if f.encryptKey != "" && f.encryptKeyring {
+		return nil, errors.New("Only one of -encrypt-key and -encrypt-keyring can be set")
+	}
+	if f.encryptKey != "" {
+		data, err := os.ReadFile(f.encryptKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading encryption key: %s", err)
+		}
+		key, err := snapshot.ParseEncryptionKey(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing encryption key: %s", err)
+		}
+		opts.EncryptionKeys = [][]byte{key}
+	}
+	if f.encryptKeyring {
+		keys, err := keyringKeys(client)
+		if err != nil {
+			return nil, err
+		}
+		opts.EncryptionKeys = keys
+	}
+
+	if f.signKey != "" {
+		data, err := os.ReadFile(f.signKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading signing key: %s", err)
+		}
+		if opts.SigningKey, err = snapshot.ParseSigningKey(data); err != nil {
+			return nil, fmt.Errorf("Error parsing signing key: %s", err)
+		}
+	}
+	if f.verifyKey != "" {
+		data, err := os.ReadFile(f.verifyKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading verify key: %s", err)
+		}
+		if opts.VerifyKey, err = snapshot.ParseVerifyKey(data); err != nil {
+			return nil, fmt.Errorf("Error parsing verify key: %s", err)
+		}
+	}
+	return &opts, nil
+}
+
+// keyringKeys returns the keys installed in the gossip encryption keyring,
+// starting with the primary key of the LAN pools.
+func keyringKeys(client *api.Client) ([][]byte, error) {
+	rings, err := client.Operator().KeyringList(nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the keyring: %s", err)
+	}
+
+	primaries := make(map[string]struct{})
+	installed := make(map[string]struct{})
+	for _, ring := range rings {
+		for key := range ring.Keys {
+			installed[key] = struct{}{}
+		}
+		if ring.WAN {
+			continue
+		}
+		for key := range ring.PrimaryKeys {
+			primaries[key] = struct{}{}
+		}
+	}
+	if len(primaries) == 0 {
+		return nil, errors.New("Error reading the keyring: no primary key is installed")
+	}
+	if len(primaries) > 1 {
+		return nil, errors.New("Error reading the keyring: more than one primary key is in use, " +
+			"wait for the key rotation to complete or use -encrypt-key")
+	}
+
+	var primary string
+	for key := range primaries {
+		primary = key
+	}
+	others := make([]string, 0, len(installed))
+	for key := range installed {
+		if key != primary {
+			others = append(others, key)
+		}
+	}
+	sort.Strings(others)
+
+	var keys [][]byte
+	for _, encoded := range append([]string{primary}, others...) {
+		key, err := snapshot.ParseEncryptionKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing keyring key: %s", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
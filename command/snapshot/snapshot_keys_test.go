@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"encoding/base64"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestKeyFlags_Options(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.TempDir(t, "snapshot")
+	encryptKey, signKey, verifyKey := TestKeyFiles(t, dir)
+
+	parse := func(t *testing.T, args ...string) *KeyFlags {
+		var f KeyFlags
+		fs := flag.NewFlagSet("", flag.ContinueOnError)
+		for _, set := range []*flag.FlagSet{f.EncryptFlags(true), f.SignFlags(), f.VerifyFlags()} {
+			set.VisitAll(func(fl *flag.Flag) { fs.Var(fl.Value, fl.Name, fl.Usage) })
+		}
+		require.NoError(t, fs.Parse(args))
+		return &f
+	}
+
+	t.Run("none", func(t *testing.T) {
+		f := parse(t)
+		require.False(t, f.Configured())
+		opts, err := f.Options(nil)
+		require.NoError(t, err)
+		require.Empty(t, opts.EncryptionKeys)
+		require.Nil(t, opts.SigningKey)
+		require.Nil(t, opts.VerifyKey)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		f := parse(t, "-encrypt-key="+encryptKey, "-sign-key="+signKey, "-verify-key="+verifyKey)
+		require.True(t, f.Configured())
+		opts, err := f.Options(nil)
+		require.NoError(t, err)
+		require.Len(t, opts.EncryptionKeys, 1)
+		require.Len(t, opts.EncryptionKeys[0], 32)
+		require.True(t, opts.VerifyKey.Equal(opts.SigningKey.Public()))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for args, expected := range map[[2]string]string{
+			{"-encrypt-key=" + encryptKey, "-encrypt-keyring"}: "Only one of -encrypt-key and -encrypt-keyring",
+			{"-encrypt-key=" + signKey, ""}:                    "Error parsing encryption key",
+			{"-sign-key=" + verifyKey, ""}:                     "Error parsing signing key",
+			{"-verify-key=" + signKey, ""}:                     "Error parsing verify key",
+			{"-verify-key=" + dir + "/missing", ""}:            "Error reading verify key",
+		} {
+			f := parse(t, args[0], args[1])
+			_, err := f.Options(nil)
+			require.ErrorContains(t, err, expected)
+		}
+	})
+}
+
+func TestKeyFlags_Keyring(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	key := "ZWTL+bgjHyQPhJRKcFe3ccirc2SFHmc/Nw67l8NQfdk="
+	a := agent.NewTestAgent(t, `encrypt = "`+key+`"`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	f := &KeyFlags{encryptKeyring: true}
+	opts, err := f.Options(a.Client())
+	require.NoError(t, err)
+
+	expected, err := base64.StdEncoding.DecodeString(key)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{expected}, opts.EncryptionKeys)
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/consul-net-rpc/go-msgpack/codec"
+
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+// The kinds of records that can be read from a snapshot with ReadRecords.
+const (
+	RecordNodes           = "nodes"
+	RecordServices        = "services"
+	RecordChecks          = "checks"
+	RecordIntentions      = "intentions"
+	RecordKV              = "kv"
+	RecordConfigEntries   = "config-entries"
+	RecordACLPolicies     = "acl-policies"
+	RecordACLRoles        = "acl-roles"
+	RecordACLTokens       = "acl-tokens"
+	RecordACLAuthMethods  = "acl-auth-methods"
+	RecordACLBindingRules = "acl-binding-rules"
+)
+
+// RecordKinds lists the kinds of records that can be written back to a
+// cluster, in the order that writes the records they refer to first.
+var RecordKinds = []string{
+	RecordACLPolicies,
+	RecordACLRoles,
+	RecordACLAuthMethods,
+	RecordACLBindingRules,
+	RecordACLTokens,
+	RecordConfigEntries,
+	RecordKV,
+}
+
+// Record is an item of the state store read from a snapshot, converted to
+// its API type.
+type Record struct {
+	// Kind is one of the Record* constants.
+	Kind string
+
+	// Key identifies the record within its kind: the name of a node, the
+	// node and ID of a service or check, the source and destination of an
+	// intention, the key of a KV entry, the kind and name of a config entry,
+	// the accessor ID of a token, the ID of a binding rule and the name of
+	// other ACL objects. Nodes of peered clusters are prefixed by the peer.
+	Key string
+
+	// Value is a pointer to the API type of the record, such as *api.Node or
+	// *api.KVPair, or an api.ConfigEntry.
+	Value any
+}
+
+func (r *Record) String() string {
+	return r.Kind + ":" + r.Key
+}
+
+// configEntryKindOrder sorts the config entries that others are validated
+// against first.
+var configEntryKindOrder = map[string]int{
+	api.ProxyDefaults:   0,
+	api.ServiceDefaults: 1,
+	api.ServiceResolver: 2,
+	api.ServiceSplitter: 3,
+	api.ServiceRouter:   4,
+}
+
+// ReadRecords decodes the raw FSM state of a snapshot, as returned by
+// snapshot.Read, and returns the records of the supported kinds grouped by
+// kind. Records keep the order of the snapshot, except for config entries
+// which are sorted so that the entries others depend on come first.
+//
+// Intentions are read from both the legacy intentions and the sources of the
+// service-intentions config entries, which are also returned as config
+// entries.
+func ReadRecords(state io.Reader) (map[string][]*Record, error) {
+	records := make(map[string][]*Record)
+	handler := func(header *fsm.SnapshotHeader, msg structs.MessageType, dec *codec.Decoder) error {
+		decoded, err := decodeRecords(msg, dec)
+		if err != nil {
+			return fmt.Errorf("failed to decode msg type %v, error %v", msg, err)
+		}
+		for _, record := range decoded {
+			records[record.Kind] = append(records[record.Kind], record)
+		}
+		return nil
+	}
+	if err := fsm.ReadSnapshot(state, handler); err != nil {
+		return nil, err
+	}
+
+	entries := records[RecordConfigEntries]
+	sort.SliceStable(entries, func(i, j int) bool {
+		return kindOrder(entries[i]) < kindOrder(entries[j])
+	})
+	return records, nil
+}
+
+func kindOrder(r *Record) int {
+	if order, ok := configEntryKindOrder[r.Value.(api.ConfigEntry).GetKind()]; ok {
+		return order
+	}
+	return len(configEntryKindOrder)
+}
+
+// decodeRecords decodes a single item of the snapshot into the records it
+// holds, which are none for the items of kinds that are not supported. Every
+// item must be decoded, so that the decoder moves on to the next one.
+func decodeRecords(msg structs.MessageType, dec *codec.Decoder) ([]*Record, error) {
+	switch msg {
+	case structs.RegisterRequestType:
+		var req structs.RegisterRequest
+		if err := dec.Decode(&req); err != nil {
+			return nil, err
+		}
+		return registerRecords(&req)
+
+	case structs.IntentionRequestType:
+		var ixn structs.Intention
+		if err := dec.Decode(&ixn); err != nil {
+			return nil, err
+		}
+		record, err := intentionRecord(&ixn)
+		if err != nil {
+			return nil, err
+		}
+		return []*Record{record}, nil
+
+	case structs.KVSRequestType:
+		var entry structs.DirEntry
+		if err := dec.Decode(&entry); err != nil {
+			return nil, err
+		}
+		return []*Record{{
+			Kind: RecordKV,
+			Key:  entry.Key,
+			Value: &api.KVPair{
+				Key:   entry.Key,
+				Flags: entry.Flags,
+				Value: entry.Value,
+				TTL:   entry.TTL,
+			},
+		}}, nil
+
+	case structs.ConfigEntryRequestType:
+		var req structs.ConfigEntryRequest
+		if err := dec.Decode(&req); err != nil {
+			return nil, err
+		}
+		// Bound API gateways are managed by the servers and cannot be written
+		// through the API.
+		if req.Entry.GetKind() == structs.BoundAPIGateway {
+			return nil, nil
+		}
+		data, err := json.Marshal(req.Entry)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := api.DecodeConfigEntryFromJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		records := []*Record{{
+			Kind:  RecordConfigEntries,
+			Key:   entry.GetKind() + "/" + entry.GetName(),
+			Value: entry,
+		}}
+		if intentions, ok := req.Entry.(*structs.ServiceIntentionsConfigEntry); ok {
+			for _, ixn := range intentions.ToIntentions() {
+				record, err := intentionRecord(ixn)
+				if err != nil {
+					return nil, err
+				}
+				records = append(records, record)
+			}
+		}
+		return records, nil
+
+	case structs.ACLPolicySetRequestType:
+		var policy structs.ACLPolicy
+		var out api.ACLPolicy
+		if err := decodeACL(dec, &policy, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordACLPolicies, Key: out.Name, Value: &out}}, nil
+
+	case structs.ACLRoleSetRequestType:
+		var role structs.ACLRole
+		var out api.ACLRole
+		if err := decodeACL(dec, &role, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordACLRoles, Key: out.Name, Value: &out}}, nil
+
+	case structs.ACLTokenSetRequestType:
+		var token structs.ACLToken
+		var out api.ACLToken
+		if err := decodeACL(dec, &token, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordACLTokens, Key: out.AccessorID, Value: &out}}, nil
+
+	case structs.ACLAuthMethodSetRequestType:
+		var method structs.ACLAuthMethod
+		var out api.ACLAuthMethod
+		if err := decodeACL(dec, &method, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordACLAuthMethods, Key: out.Name, Value: &out}}, nil
+
+	case structs.ACLBindingRuleSetRequestType:
+		var rule structs.ACLBindingRule
+		var out api.ACLBindingRule
+		if err := decodeACL(dec, &rule, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordACLBindingRules, Key: out.ID, Value: &out}}, nil
+
+	default:
+		var skip interface{}
+		return nil, dec.Decode(&skip)
+	}
+}
+
+// registerRecords returns the node, service or check held by a register
+// request, as the snapshot holds a request for each of them.
+func registerRecords(req *structs.RegisterRequest) ([]*Record, error) {
+	node := req.Node
+	if req.PeerName != "" {
+		node = req.PeerName + "/" + node
+	}
+
+	switch {
+	case req.Service != nil:
+		var out api.AgentService
+		if err := convert(req.Service, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordServices, Key: node + "/" + out.ID, Value: &out}}, nil
+
+	case req.Check != nil:
+		var out api.HealthCheck
+		if err := convert(req.Check, &out); err != nil {
+			return nil, err
+		}
+		return []*Record{{Kind: RecordChecks, Key: node + "/" + out.CheckID, Value: &out}}, nil
+
+	default:
+		return []*Record{{
+			Kind: RecordNodes,
+			Key:  node,
+			Value: &api.Node{
+				ID:              string(req.ID),
+				Node:            req.Node,
+				Address:         req.Address,
+				Datacenter:      req.Datacenter,
+				TaggedAddresses: req.TaggedAddresses,
+				Meta:            req.NodeMeta,
+				Partition:       req.PartitionOrEmpty(),
+				PeerName:        req.PeerName,
+			},
+		}}, nil
+	}
+}
+
+// intentionRecord returns the record of an intention, keyed by its source
+// and destination.
+func intentionRecord(ixn *structs.Intention) (*Record, error) {
+	var out api.Intention
+	if err := convert(ixn, &out); err != nil {
+		return nil, err
+	}
+	source := out.SourceString()
+	if out.SourcePeer != "" {
+		source = "peer:" + out.SourcePeer + "/" + source
+	}
+	return &Record{
+		Kind:  RecordIntentions,
+		Key:   source + " => " + out.DestinationString(),
+		Value: &out,
+	}, nil
+}
+
+// decodeACL decodes an ACL object into its internal type, and converts it to
+// its API type.
+func decodeACL(dec *codec.Decoder, in, out any) error {
+	if err := dec.Decode(in); err != nil {
+		return err
+	}
+	return convert(in, out)
+}
+
+// convert converts an internal type to its API type, which uses the same
+// JSON encoding.
+func convert(in, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/snapshot"
+)
+
+func TestReadRecords(t *testing.T) {
+	f, err := os.Open("decode/testdata/all.snap")
+	require.NoError(t, err)
+	defer f.Close()
+
+	state, _, err := snapshot.Read(hclog.NewNullLogger(), f)
+	require.NoError(t, err)
+	defer snapshot.RemoveTemp(state)
+
+	records, err := ReadRecords(state)
+	require.NoError(t, err)
+
+	counts := make(map[string]int)
+	for kind, kindRecords := range records {
+		counts[kind] = len(kindRecords)
+		for _, record := range kindRecords {
+			require.Equal(t, kind, record.Kind)
+			require.NotEmpty(t, record.Key)
+		}
+	}
+	require.Equal(t, map[string]int{
+		RecordNodes:           1,
+		RecordServices:        1,
+		RecordChecks:          1,
+		RecordIntentions:      1,
+		RecordKV:              1,
+		RecordConfigEntries:   17,
+		RecordACLPolicies:     3,
+		RecordACLRoles:        1,
+		RecordACLTokens:       3,
+		RecordACLAuthMethods:  1,
+		RecordACLBindingRules: 1,
+	}, counts)
+
+	// Bound API gateways are left out, and the config entries that others are
+	// validated against come first.
+	entries := records[RecordConfigEntries]
+	require.Equal(t, api.ProxyDefaults, entries[0].Value.(api.ConfigEntry).GetKind())
+	for _, record := range entries {
+		entry := record.Value.(api.ConfigEntry)
+		require.Equal(t, entry.GetKind()+"/"+entry.GetName(), record.Key)
+	}
+
+	kv := records[RecordKV][0].Value.(*api.KVPair)
+	require.Equal(t, kv.Key, records[RecordKV][0].Key)
+
+	node := records[RecordNodes][0].Value.(*api.Node)
+	require.Equal(t, node.Node, records[RecordNodes][0].Key)
+	service := records[RecordServices][0].Value.(*api.AgentService)
+	require.Equal(t, node.Node+"/"+service.ID, records[RecordServices][0].Key)
+	check := records[RecordChecks][0].Value.(*api.HealthCheck)
+	require.Equal(t, node.Node+"/"+check.CheckID, records[RecordChecks][0].Key)
+
+	// Intentions are read from the sources of service-intentions entries.
+	ixn := records[RecordIntentions][0].Value.(*api.Intention)
+	require.Equal(t, ixn.SourceName+" => "+ixn.DestinationName, records[RecordIntentions][0].Key)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/go-testing-interface"
+)
+
+// TestKeyFiles writes a new encryption key, Ed25519 signing key and the
+// matching verify key to files in the given directory, and returns their
+// paths.
+func TestKeyFiles(t testing.T, dir string) (encryptKey, signKey, verifyKey string) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	encryptKey = filepath.Join(dir, "snapshot.key")
+	signKey = filepath.Join(dir, "signing.pem")
+	verifyKey = filepath.Join(dir, "signing.pub")
+	for path, data := range map[string][]byte{
+		encryptKey: []byte(base64.StdEncoding.EncodeToString(key) + "\n"),
+		signKey:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}),
+		verifyKey:  pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}),
+	} {
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+	}
+	return encryptKey, signKey, verifyKey
+}
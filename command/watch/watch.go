@@ -12,6 +12,7 @@ import (
 	osexec "os/exec"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul/agent"
 	"github.com/hashicorp/consul/agent/exec"
@@ -36,30 +37,36 @@ type cmd struct {
 	shutdownCh <-chan struct{}
 
 	// flags
-	watchType   string
-	key         string
-	prefix      string
-	service     string
-	tag         []string
-	passingOnly string
-	state       string
-	name        string
-	shell       bool
-	filter      string
+	watchType    string
+	key          string
+	prefix       string
+	service      string
+	tag          []string
+	passingOnly  string
+	state        string
+	name         string
+	kind         string
+	destination  string
+	shell        bool
+	filter       string
+	debounce     time.Duration
+	minInterval  time.Duration
+	onlyOnChange bool
 }
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
 	c.flags.StringVar(&c.watchType, "type", "",
 		"Specifies the watch type. One of key, keyprefix, services, nodes, "+
-			"service, checks, or event.")
+			"service, checks, event, config_entry, intentions, peerings, or "+
+			"service_health.")
 	c.flags.StringVar(&c.key, "key", "",
 		"Specifies the key to watch. Only for 'key' type.")
 	c.flags.StringVar(&c.prefix, "prefix", "",
 		"Specifies the key prefix to watch. Only for 'keyprefix' type.")
 	c.flags.StringVar(&c.service, "service", "",
-		"Specifies the service to watch. Required for 'service' type, "+
-			"optional for 'checks' type.")
+		"Specifies the service to watch. Required for 'service' and "+
+			"'service_health' types, optional for 'checks' type.")
 	c.flags.Var((*flags.AppendSliceValue)(&c.tag), "tag", "Specifies the service tag(s) to filter on. "+
 		"Optional for 'service' type. May be specified multiple times")
 	c.flags.StringVar(&c.passingOnly, "passingonly", "",
@@ -71,8 +78,23 @@ func (c *cmd) init() {
 	c.flags.StringVar(&c.state, "state", "",
 		"Specifies the states to watch. Optional for 'checks' type.")
 	c.flags.StringVar(&c.name, "name", "",
-		"Specifies an event name to watch. Only for 'event' type.")
-	c.flags.StringVar(&c.filter, "filter", "", "Filter to use with the request")
+		"Specifies the name to watch. Optional for 'event', 'config_entry' "+
+			"and 'peerings' types.")
+	c.flags.StringVar(&c.kind, "kind", "",
+		"Specifies the config entry kind to watch. Only for 'config_entry' type.")
+	c.flags.StringVar(&c.destination, "destination", "",
+		"Specifies the destination service of the intentions to watch. "+
+			"Optional for 'intentions' type.")
+	c.flags.StringVar(&c.filter, "filter", "", "Filter to use with the request. "+
+		"For the types that do not support filtering through the API, the filter "+
+		"is applied to the result of the watch.")
+	c.flags.DurationVar(&c.debounce, "debounce", 0,
+		"Delays the handler until the result has not changed for this long.")
+	c.flags.DurationVar(&c.minInterval, "min-interval", 0,
+		"Specifies the shortest time between two invocations of the handler.")
+	c.flags.BoolVar(&c.onlyOnChange, "only-on-change", false,
+		"Invokes the handler only when the result changed, ignoring the "+
+			"CreateIndex and ModifyIndex fields. The default value is false.")
 
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
@@ -145,6 +167,21 @@ func (c *cmd) Run(args []string) int {
 	if c.name != "" {
 		params["name"] = c.name
 	}
+	if c.kind != "" {
+		params["kind"] = c.kind
+	}
+	if c.destination != "" {
+		params["destination"] = c.destination
+	}
+	if c.debounce != 0 {
+		params["debounce"] = c.debounce.String()
+	}
+	if c.minInterval != 0 {
+		params["min_interval"] = c.minInterval.String()
+	}
+	if c.onlyOnChange {
+		params["only_on_change"] = true
+	}
 	if c.passingOnly != "" {
 		b, err := strconv.ParseBool(c.passingOnly)
 		if err != nil {
@@ -160,6 +197,13 @@ func (c *cmd) Run(args []string) int {
 		c.UI.Error(fmt.Sprintf("%s", err))
 		return 1
 	}
+	if wp.Filter != "" {
+		wp.ResultFilter, err = agent.MakeWatchResultFilter(wp.Filter)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to parse 'filter': %v", err))
+			return 1
+		}
+	}
 
 	if strings.HasPrefix(wp.Type, "connect_") || strings.HasPrefix(wp.Type, "agent_") {
 		c.UI.Error(fmt.Sprintf("Type %s is not supported in the CLI tool", wp.Type))
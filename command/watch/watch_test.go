@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/testrpc"
 	"github.com/mitchellh/cli"
@@ -47,6 +48,59 @@ func TestWatchCommand(t *testing.T) {
 	}
 }
 
+func TestWatchCommand_ConfigEntry(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	_, _, err := a.Client().ConfigEntries().Set(&api.ServiceConfigEntry{
+		Kind:     api.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	}, nil)
+	require.NoError(t, err)
+
+	ui := cli.NewMockUi()
+	c := New(ui, nil)
+	args := []string{"-http-addr=" + a.HTTPAddr(), "-type=config_entry",
+		"-kind=service-defaults", "-name=web"}
+
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `"Protocol": "http"`)
+}
+
+func TestWatchCommand_ResultFilter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	for _, key := range []string{"foo/a", "foo/b"} {
+		_, err := a.Client().KV().Put(&api.KVPair{Key: key, Value: []byte("1")}, nil)
+		require.NoError(t, err)
+	}
+
+	ui := cli.NewMockUi()
+	c := New(ui, nil)
+	args := []string{"-http-addr=" + a.HTTPAddr(), "-type=keyprefix", "-prefix=foo/",
+		`-filter=Key == "foo/a"`, "-only-on-change", "-min-interval=1s"}
+
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `"Key": "foo/a"`)
+	require.NotContains(t, ui.OutputWriter.String(), `"Key": "foo/b"`)
+}
+
 func TestWatchCommand_loadToken(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -4,7 +4,9 @@
 package file
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -18,7 +20,16 @@ func WriteAtomic(path string, contents []byte) error {
 }
 
 func WriteAtomicWithPerms(path string, contents []byte, dirPerms, filePerms os.FileMode) error {
+	return WriteAtomicFromReaderWithPerms(path, bytes.NewReader(contents), dirPerms, filePerms)
+}
+
+// WriteAtomicFromReader is like WriteAtomic, but streams the contents from
+// the given reader so they don't have to be held in memory.
+func WriteAtomicFromReader(path string, r io.Reader) error {
+	return WriteAtomicFromReaderWithPerms(path, r, 0700, 0600)
+}
 
+func WriteAtomicFromReaderWithPerms(path string, r io.Reader, dirPerms, filePerms os.FileMode) error {
 	uuid, err := uuid.GenerateUUID()
 	if err != nil {
 		return err
@@ -32,7 +43,7 @@ func WriteAtomicWithPerms(path string, contents []byte, dirPerms, filePerms os.F
 	if err != nil {
 		return err
 	}
-	if _, err := fh.Write(contents); err != nil {
+	if _, err := io.Copy(fh, r); err != nil {
 		fh.Close()
 		os.Remove(tempPath)
 		return err
@@ -4,9 +4,12 @@
 package file
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -31,3 +34,23 @@ func TestWriteAtomic(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 }
+
+func TestWriteAtomicFromReader(t *testing.T) {
+	td := t.TempDir()
+	path := filepath.Join(td, "subdir", "file")
+
+	expected := []byte("hello")
+	require.NoError(t, WriteAtomicFromReader(path, bytes.NewReader(expected)))
+
+	actual, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	// A failed read leaves neither the file nor a temporary file behind.
+	broken := filepath.Join(td, "broken")
+	err = WriteAtomicFromReader(broken, iotest.ErrReader(errors.New("boom")))
+	require.EqualError(t, err, "boom")
+	entries, err := os.ReadDir(td)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package jsonpath implements the subset of JSONPath needed to select values
+// from a decoded JSON document: the root "$", child members by name with
+// ".name" or "['name']", array elements by index with "[n]", where negative
+// indexes count from the end, and all the children with ".*" or "[*]".
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// step selects the children of a value. A wildcard selects all of them,
+// otherwise a step selects a member when key is set, or an element.
+type step struct {
+	key      *string
+	index    int
+	wildcard bool
+}
+
+// Path is a parsed JSONPath expression.
+type Path struct {
+	raw   string
+	steps []step
+}
+
+// String returns the expression the path was parsed from.
+func (p *Path) String() string {
+	return p.raw
+}
+
+// Parse parses a JSONPath expression, which must start with "$".
+func Parse(raw string) (*Path, error) {
+	if !strings.HasPrefix(raw, "$") {
+		return nil, fmt.Errorf("path must start with '$'")
+	}
+	p := &Path{raw: raw}
+
+	rest := raw[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			switch name {
+			case "":
+				return nil, fmt.Errorf("missing member name in %q", raw)
+			case "*":
+				p.steps = append(p.steps, step{wildcard: true})
+			default:
+				p.steps = append(p.steps, step{key: &name})
+			}
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ']' in %q", raw)
+			}
+			s, err := parseBracket(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector %q in %q: %w", rest[:end+1], raw, err)
+			}
+			p.steps = append(p.steps, s)
+			rest = rest[end+1:]
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", rest[0], raw)
+		}
+	}
+	return p, nil
+}
+
+func parseBracket(s string) (step, error) {
+	if s == "*" {
+		return step{wildcard: true}, nil
+	}
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		name := s[1 : len(s)-1]
+		return step{key: &name}, nil
+	}
+	index, err := strconv.Atoi(s)
+	if err != nil {
+		return step{}, fmt.Errorf("must be a quoted name, an index or '*'")
+	}
+	return step{index: index}, nil
+}
+
+// Get returns the values the path selects in a document decoded with
+// encoding/json. It returns no values if the path does not exist.
+func (p *Path) Get(doc interface{}) []interface{} {
+	values := []interface{}{doc}
+	for _, s := range p.steps {
+		var next []interface{}
+		for _, v := range values {
+			next = append(next, s.children(v)...)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		values = next
+	}
+	return values
+}
+
+func (s step) children(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			out := make([]interface{}, 0, len(v))
+			for _, child := range v {
+				out = append(out, child)
+			}
+			return out
+		}
+		if s.key != nil {
+			if child, ok := v[*s.key]; ok {
+				return []interface{}{child}
+			}
+		}
+
+	case []interface{}:
+		if s.wildcard {
+			return v
+		}
+		if s.key == nil {
+			index := s.index
+			if index < 0 {
+				index += len(v)
+			}
+			if index >= 0 && index < len(v) {
+				return []interface{}{v[index]}
+			}
+		}
+	}
+	return nil
+}
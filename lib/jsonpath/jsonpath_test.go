@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath_Get(t *testing.T) {
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"status": "degraded",
+		"checks": [
+			{"name": "db", "ok": true},
+			{"name": "cache", "ok": false}
+		],
+		"odd key": {"a.b": 1}
+	}`), &doc))
+
+	for path, expected := range map[string][]interface{}{
+		"$":                   {doc},
+		"$.status":            {"degraded"},
+		"$['status']":         {"degraded"},
+		"$.checks[0].name":    {"db"},
+		"$.checks[-1].ok":     {false},
+		"$.checks[*].name":    {"db", "cache"},
+		"$.checks.*.ok":       {true, false},
+		`$["odd key"]['a.b']`: {float64(1)},
+		"$.missing":           nil,
+		"$.checks[2]":         nil,
+		"$.status.name":       nil,
+		"$.checks.name":       nil,
+	} {
+		t.Run(path, func(t *testing.T) {
+			p, err := Parse(path)
+			require.NoError(t, err)
+			require.Equal(t, path, p.String())
+			require.Equal(t, expected, p.Get(doc))
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for path, expected := range map[string]string{
+		"status":       "path must start with '$'",
+		"$.":           "missing member name",
+		"$..status":    "missing member name",
+		"$.checks[0":   "missing ']'",
+		"$.checks[a]":  "must be a quoted name, an index or '*'",
+		"$.checks[0]x": `unexpected 'x'`,
+	} {
+		t.Run(path, func(t *testing.T) {
+			_, err := Parse(path)
+			require.ErrorContains(t, err, expected)
+		})
+	}
+}
@@ -1862,6 +1862,18 @@ func MeshTLSConfigFromStructs(t *structs.MeshTLSConfig, s *MeshTLSConfig) {
 		s.Outgoing = &x
 	}
 }
+func OutlierHealthCheckToStructs(s *OutlierHealthCheck, t *structs.OutlierHealthCheck) {
+	if s == nil {
+		return
+	}
+	t.MinProxies = int(s.MinProxies)
+}
+func OutlierHealthCheckFromStructs(t *structs.OutlierHealthCheck, s *OutlierHealthCheck) {
+	if s == nil {
+		return
+	}
+	s.MinProxies = int32(t.MinProxies)
+}
 func PassiveHealthCheckToStructs(s *PassiveHealthCheck, t *structs.PassiveHealthCheck) {
 	if s == nil {
 		return
@@ -2125,6 +2137,11 @@ func ServiceDefaultsToStructs(s *ServiceDefaults, t *structs.ServiceConfigEntry)
 		t.RateLimits = &x
 	}
 	t.EnvoyExtensions = EnvoyExtensionsToStructs(s.EnvoyExtensions)
+	if s.OutlierHealthCheck != nil {
+		var x structs.OutlierHealthCheck
+		OutlierHealthCheckToStructs(s.OutlierHealthCheck, &x)
+		t.OutlierHealthCheck = &x
+	}
 	t.Meta = s.Meta
 	t.Hash = s.Hash
 }
@@ -2171,6 +2188,11 @@ func ServiceDefaultsFromStructs(t *structs.ServiceConfigEntry, s *ServiceDefault
 		s.RateLimits = &x
 	}
 	s.EnvoyExtensions = EnvoyExtensionsFromStructs(t.EnvoyExtensions)
+	if t.OutlierHealthCheck != nil {
+		var x OutlierHealthCheck
+		OutlierHealthCheckFromStructs(t.OutlierHealthCheck, &x)
+		s.OutlierHealthCheck = &x
+	}
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
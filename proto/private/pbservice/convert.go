@@ -220,6 +220,64 @@ func NewCheckTypesFromStructs(t structs.CheckTypes) []*CheckType {
 	return s
 }
 
+// TODO: handle this with mog
+func HTTPResponseAssertionSliceToStructs(s []*HTTPResponseAssertion) []structs.HTTPResponseAssertion {
+	if len(s) == 0 {
+		return nil
+	}
+	t := make([]structs.HTTPResponseAssertion, len(s))
+	for i, v := range s {
+		if v == nil {
+			continue
+		}
+		HTTPResponseAssertionToStructs(v, &t[i])
+	}
+	return t
+}
+
+// TODO: handle this with mog
+func NewHTTPResponseAssertionSliceFromStructs(t []structs.HTTPResponseAssertion) []*HTTPResponseAssertion {
+	if len(t) == 0 {
+		return nil
+	}
+	s := make([]*HTTPResponseAssertion, len(t))
+	for i := range t {
+		a := new(HTTPResponseAssertion)
+		HTTPResponseAssertionFromStructs(&t[i], a)
+		s[i] = a
+	}
+	return s
+}
+
+// TODO: handle this with mog
+func CompositeCheckMemberSliceToStructs(s []*CompositeCheckMember) []structs.CompositeCheckMember {
+	if len(s) == 0 {
+		return nil
+	}
+	t := make([]structs.CompositeCheckMember, len(s))
+	for i, v := range s {
+		if v == nil {
+			continue
+		}
+		CompositeCheckMemberToStructs(v, &t[i])
+	}
+	return t
+}
+
+// TODO: handle this with mog
+func NewCompositeCheckMemberSliceFromStructs(t []structs.CompositeCheckMember) []*CompositeCheckMember {
+	if len(t) == 0 {
+		return nil
+	}
+	s := make([]*CompositeCheckMember, len(t))
+	for i := range t {
+		m := new(CompositeCheckMember)
+		CompositeCheckMemberFromStructs(&t[i], m)
+		s[i] = m
+	}
+	return s
+}
+
 // TODO: handle this with mog
 func ConnectProxyConfigPtrToStructs(s *ConnectProxyConfig) *structs.ConnectProxyConfig {
 	if s == nil {
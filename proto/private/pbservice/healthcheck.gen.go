@@ -20,17 +20,28 @@ func CheckTypeToStructs(s *CheckType, t *structs.CheckType) {
 	t.Method = s.Method
 	t.Body = s.Body
 	t.DisableRedirects = s.DisableRedirects
+	t.ResponseAssertions = HTTPResponseAssertionSliceToStructs(s.ResponseAssertions)
 	t.TCP = s.TCP
 	t.TCPUseTLS = s.TCPUseTLS
 	t.UDP = s.UDP
 	t.Interval = structs.DurationFromProto(s.Interval)
 	t.AliasNode = s.AliasNode
 	t.AliasService = s.AliasService
+	t.CompositeMode = s.CompositeMode
+	t.CompositeQuorum = int(s.CompositeQuorum)
+	t.CompositeMembers = CompositeCheckMemberSliceToStructs(s.CompositeMembers)
 	t.DockerContainerID = s.DockerContainerID
 	t.Shell = s.Shell
 	t.GRPC = s.GRPC
 	t.GRPCUseTLS = s.GRPCUseTLS
 	t.OSService = s.OSService
+	t.CertExpiry = s.CertExpiry
+	t.DNS = s.DNS
+	t.DNSServer = s.DNSServer
+	t.FileFreshness = s.FileFreshness
+	t.Outlier = s.Outlier
+	t.WarningThreshold = structs.DurationFromProto(s.WarningThreshold)
+	t.CriticalThreshold = structs.DurationFromProto(s.CriticalThreshold)
 	t.TLSServerName = s.TLSServerName
 	t.TLSSkipVerify = s.TLSSkipVerify
 	t.Timeout = structs.DurationFromProto(s.Timeout)
@@ -38,6 +49,10 @@ func CheckTypeToStructs(s *CheckType, t *structs.CheckType) {
 	t.SuccessBeforePassing = int(s.SuccessBeforePassing)
 	t.FailuresBeforeWarning = int(s.FailuresBeforeWarning)
 	t.FailuresBeforeCritical = int(s.FailuresBeforeCritical)
+	t.FlapHalfLife = structs.DurationFromProto(s.FlapHalfLife)
+	t.FlapMaxSuppress = structs.DurationFromProto(s.FlapMaxSuppress)
+	t.FlapSuppressThreshold = int(s.FlapSuppressThreshold)
+	t.FlapReuseThreshold = int(s.FlapReuseThreshold)
 	t.ProxyHTTP = s.ProxyHTTP
 	t.ProxyGRPC = s.ProxyGRPC
 	t.DeregisterCriticalServiceAfter = structs.DurationFromProto(s.DeregisterCriticalServiceAfter)
@@ -59,17 +74,28 @@ func CheckTypeFromStructs(t *structs.CheckType, s *CheckType) {
 	s.Method = t.Method
 	s.Body = t.Body
 	s.DisableRedirects = t.DisableRedirects
+	s.ResponseAssertions = NewHTTPResponseAssertionSliceFromStructs(t.ResponseAssertions)
 	s.TCP = t.TCP
 	s.TCPUseTLS = t.TCPUseTLS
 	s.UDP = t.UDP
 	s.Interval = structs.DurationToProto(t.Interval)
 	s.AliasNode = t.AliasNode
 	s.AliasService = t.AliasService
+	s.CompositeMode = t.CompositeMode
+	s.CompositeQuorum = int32(t.CompositeQuorum)
+	s.CompositeMembers = NewCompositeCheckMemberSliceFromStructs(t.CompositeMembers)
 	s.DockerContainerID = t.DockerContainerID
 	s.Shell = t.Shell
 	s.GRPC = t.GRPC
 	s.GRPCUseTLS = t.GRPCUseTLS
 	s.OSService = t.OSService
+	s.CertExpiry = t.CertExpiry
+	s.DNS = t.DNS
+	s.DNSServer = t.DNSServer
+	s.FileFreshness = t.FileFreshness
+	s.Outlier = t.Outlier
+	s.WarningThreshold = structs.DurationToProto(t.WarningThreshold)
+	s.CriticalThreshold = structs.DurationToProto(t.CriticalThreshold)
 	s.TLSServerName = t.TLSServerName
 	s.TLSSkipVerify = t.TLSSkipVerify
 	s.Timeout = structs.DurationToProto(t.Timeout)
@@ -77,11 +103,55 @@ func CheckTypeFromStructs(t *structs.CheckType, s *CheckType) {
 	s.SuccessBeforePassing = int32(t.SuccessBeforePassing)
 	s.FailuresBeforeWarning = int32(t.FailuresBeforeWarning)
 	s.FailuresBeforeCritical = int32(t.FailuresBeforeCritical)
+	s.FlapHalfLife = structs.DurationToProto(t.FlapHalfLife)
+	s.FlapMaxSuppress = structs.DurationToProto(t.FlapMaxSuppress)
+	s.FlapSuppressThreshold = int32(t.FlapSuppressThreshold)
+	s.FlapReuseThreshold = int32(t.FlapReuseThreshold)
 	s.ProxyHTTP = t.ProxyHTTP
 	s.ProxyGRPC = t.ProxyGRPC
 	s.DeregisterCriticalServiceAfter = structs.DurationToProto(t.DeregisterCriticalServiceAfter)
 	s.OutputMaxSize = int32(t.OutputMaxSize)
 }
+func CompositeCheckMemberToStructs(s *CompositeCheckMember, t *structs.CompositeCheckMember) {
+	if s == nil {
+		return
+	}
+	t.Node = s.Node
+	t.CheckID = CheckIDType(s.CheckID)
+	t.ServiceID = s.ServiceID
+	t.ServiceName = s.ServiceName
+	t.Weight = int(s.Weight)
+}
+func CompositeCheckMemberFromStructs(t *structs.CompositeCheckMember, s *CompositeCheckMember) {
+	if s == nil {
+		return
+	}
+	s.Node = t.Node
+	s.CheckID = string(t.CheckID)
+	s.ServiceID = t.ServiceID
+	s.ServiceName = t.ServiceName
+	s.Weight = int32(t.Weight)
+}
+func HTTPResponseAssertionToStructs(s *HTTPResponseAssertion, t *structs.HTTPResponseAssertion) {
+	if s == nil {
+		return
+	}
+	t.BodyRegex = s.BodyRegex
+	t.JSONPath = s.JSONPath
+	t.Header = s.Header
+	t.Match = s.Match
+	t.Status = s.Status
+}
+func HTTPResponseAssertionFromStructs(t *structs.HTTPResponseAssertion, s *HTTPResponseAssertion) {
+	if s == nil {
+		return
+	}
+	s.BodyRegex = t.BodyRegex
+	s.JSONPath = t.JSONPath
+	s.Header = t.Header
+	s.Match = t.Match
+	s.Status = t.Status
+}
 func HealthCheckToStructs(s *HealthCheck, t *structs.HealthCheck) {
 	if s == nil {
 		return
@@ -100,6 +170,7 @@ func HealthCheckToStructs(s *HealthCheck, t *structs.HealthCheck) {
 	t.Timeout = s.Timeout
 	t.ExposedPort = int(s.ExposedPort)
 	t.PeerName = s.PeerName
+	t.Meta = s.Meta
 	if s.Definition != nil {
 		HealthCheckDefinitionToStructs(s.Definition, &t.Definition)
 	}
@@ -124,6 +195,7 @@ func HealthCheckFromStructs(t *structs.HealthCheck, s *HealthCheck) {
 	s.Timeout = t.Timeout
 	s.ExposedPort = int32(t.ExposedPort)
 	s.PeerName = t.PeerName
+	s.Meta = t.Meta
 	{
 		var x HealthCheckDefinition
 		HealthCheckDefinitionFromStructs(&t.Definition, &x)
@@ -4,9 +4,10 @@
 // The archive utilities manage the internal format of a snapshot, which is a
 // tar file with the following contents:
 //
-// meta.json  - JSON-encoded snapshot metadata from Raft
-// state.bin  - Encoded snapshot data from Raft
-// SHA256SUMS - SHA-256 sums of the above two files
+// meta.json      - JSON-encoded snapshot metadata from Raft
+// state.bin      - Encoded snapshot data from Raft
+// SHA256SUMS     - SHA-256 sums of the above two files
+// SHA256SUMS.sig - Optional Ed25519 signature of the SHA256SUMS file
 //
 // The integrity information is automatically created and checked, and a failure
 // there just looks like an error to the caller.
@@ -16,8 +17,12 @@ import (
 	"archive/tar"
 	"bufio"
 	"bytes"
+	"crypto/ed25519"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
@@ -96,9 +101,46 @@ func (hl *hashList) DecodeAndVerify(r io.Reader) error {
 	return nil
 }
 
+// ParseSigningKey decodes a PEM-encoded PKCS #8 Ed25519 private key, such as
+// the keys generated by "openssl genpkey -algorithm ed25519".
+func ParseSigningKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode signing key: no PEM data found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %v", err)
+	}
+	signer, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing key must be an Ed25519 key, got %T", key)
+	}
+	return signer, nil
+}
+
+// ParseVerifyKey decodes a PEM-encoded PKIX Ed25519 public key, such as the
+// keys generated by "openssl pkey -pubout".
+func ParseVerifyKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode verify key: no PEM data found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse verify key: %v", err)
+	}
+	verifier, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("verify key must be an Ed25519 key, got %T", key)
+	}
+	return verifier, nil
+}
+
 // write takes a writer and creates an archive with the snapshot metadata,
-// the snapshot itself, and adds some integrity checking information.
-func write(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader) error {
+// the snapshot itself, and adds some integrity checking information. If a
+// signing key is given, the integrity information is signed with it.
+func write(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader, signer ed25519.PrivateKey) error {
 	// Start a new tarball.
 	now := time.Now()
 	archive := tar.NewWriter(out)
@@ -154,10 +196,29 @@ func write(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader) error {
 	}); err != nil {
 		return fmt.Errorf("failed to write snapshot hashes header: %v", err)
 	}
+	var sig []byte
+	if signer != nil {
+		sig = ed25519.Sign(signer, shaBuffer.Bytes())
+	}
 	if _, err := io.Copy(archive, &shaBuffer); err != nil {
 		return fmt.Errorf("failed to write snapshot metadata: %v", err)
 	}
 
+	// Sign the SHA256SUMS file, which covers the rest of the archive.
+	if signer != nil {
+		if err := archive.WriteHeader(&tar.Header{
+			Name:    "SHA256SUMS.sig",
+			Mode:    0600,
+			Size:    int64(len(sig)),
+			ModTime: now,
+		}); err != nil {
+			return fmt.Errorf("failed to write snapshot signature header: %v", err)
+		}
+		if _, err := archive.Write(sig); err != nil {
+			return fmt.Errorf("failed to write snapshot signature: %v", err)
+		}
+	}
+
 	// Finalize the archive.
 	if err := archive.Close(); err != nil {
 		return fmt.Errorf("failed to finalize snapshot: %v", err)
@@ -167,9 +228,11 @@ func write(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader) error {
 }
 
 // read takes a reader and extracts the snapshot metadata and the snapshot
-// itself, and also checks the integrity of the data. You must arrange to call
-// Close() on the returned object or else you will leak a temporary file.
-func read(in io.Reader, metadata *raft.SnapshotMeta, snap io.Writer) error {
+// itself, and also checks the integrity of the data. If a verify key is given,
+// the archive must be signed with the matching private key. You must arrange
+// to call Close() on the returned object or else you will leak a temporary
+// file.
+func read(in io.Reader, metadata *raft.SnapshotMeta, snap io.Writer, verifier ed25519.PublicKey) error {
 	// Start a new tar reader.
 	archive := tar.NewReader(in)
 
@@ -184,7 +247,7 @@ func read(in io.Reader, metadata *raft.SnapshotMeta, snap io.Writer) error {
 	snapHash := hl.Add("state.bin")
 
 	// Look through the archive for the pieces we care about.
-	var shaBuffer bytes.Buffer
+	var shaBuffer, sigBuffer bytes.Buffer
 	for {
 		hdr, err := archive.Next()
 		if err == io.EOF {
@@ -222,11 +285,26 @@ func read(in io.Reader, metadata *raft.SnapshotMeta, snap io.Writer) error {
 				return fmt.Errorf("failed to read snapshot hashes: %v", err)
 			}
 
+		case "SHA256SUMS.sig":
+			if _, err := io.Copy(&sigBuffer, archive); err != nil {
+				return fmt.Errorf("failed to read snapshot signature: %v", err)
+			}
+
 		default:
 			return fmt.Errorf("unexpected file %q in snapshot", hdr.Name)
 		}
 	}
 
+	// Verify the signature before the hashes are consumed.
+	if verifier != nil {
+		if sigBuffer.Len() == 0 {
+			return errors.New("snapshot is not signed")
+		}
+		if !ed25519.Verify(verifier, shaBuffer.Bytes(), sigBuffer.Bytes()) {
+			return errors.New("snapshot signature is not valid for the verify key")
+		}
+	}
+
 	// Verify all the hashes.
 	if err := hl.DecodeAndVerify(&shaBuffer); err != nil {
 		return fmt.Errorf("failed checking integrity of snapshot: %v", err)
@@ -5,7 +5,10 @@ package snapshot
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"os"
@@ -41,14 +44,14 @@ func TestArchive(t *testing.T) {
 
 	// Write out the snapshot.
 	var archive bytes.Buffer
-	if err := write(&archive, &metadata, &snap); err != nil {
+	if err := write(&archive, &metadata, &snap, nil); err != nil {
 		t.Fatalf("err: %v", err)
 	}
 
 	// Read the snapshot back.
 	var newMeta raft.SnapshotMeta
 	var newSnap bytes.Buffer
-	if err := read(&archive, &newMeta, &newSnap); err != nil {
+	if err := read(&archive, &newMeta, &newSnap, nil); err != nil {
 		t.Fatalf("err: %v", err)
 	}
 
@@ -65,6 +68,98 @@ func TestArchive(t *testing.T) {
 	}
 }
 
+func TestArchive_Signed(t *testing.T) {
+	metadata := raft.SnapshotMeta{Index: 2005, Term: 2011, Size: 1024}
+	data := make([]byte, metadata.Size)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	var signed, unsigned bytes.Buffer
+	if err := write(&signed, &metadata, bytes.NewReader(data), priv); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if err := write(&unsigned, &metadata, bytes.NewReader(data), nil); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	cases := []struct {
+		Name     string
+		Archive  []byte
+		Verifier ed25519.PublicKey
+		Error    string
+	}{
+		{"signed", signed.Bytes(), pub, ""},
+		{"signed without verify key", signed.Bytes(), nil, ""},
+		{"unsigned", unsigned.Bytes(), pub, "snapshot is not signed"},
+		{"other key", signed.Bytes(), otherPub, "signature is not valid"},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			var newMeta raft.SnapshotMeta
+			var newSnap bytes.Buffer
+			err := read(bytes.NewReader(c.Archive), &newMeta, &newSnap, c.Verifier)
+			if c.Error == "" {
+				if err != nil {
+					t.Fatalf("err: %v", err)
+				}
+				if !bytes.Equal(newSnap.Bytes(), data) {
+					t.Fatalf("snapshot contents didn't match")
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.Error) {
+				t.Fatalf("err: %v", err)
+			}
+		})
+	}
+}
+
+func TestArchive_ParseKeys(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	signer, err := ParseSigningKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !signer.Equal(priv) {
+		t.Fatalf("signing key didn't match")
+	}
+	verifier, err := ParseVerifyKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !verifier.Equal(pub) {
+		t.Fatalf("verify key didn't match")
+	}
+
+	if _, err := ParseSigningKey([]byte("nope")); err == nil || !strings.Contains(err.Error(), "no PEM data") {
+		t.Fatalf("err: %v", err)
+	}
+	if _, err := ParseVerifyKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER})); err == nil {
+		t.Fatalf("should have failed to parse a private key as a verify key")
+	}
+}
+
 func TestArchive_GoodData(t *testing.T) {
 	paths := []string{
 		"../test/snapshot/spaces-meta.tar",
@@ -77,7 +172,7 @@ func TestArchive_GoodData(t *testing.T) {
 		defer f.Close()
 
 		var metadata raft.SnapshotMeta
-		err = read(f, &metadata, io.Discard)
+		err = read(f, &metadata, io.Discard, nil)
 		if err != nil {
 			t.Fatalf("case %d: should've read the snapshot, but didn't: %v", i, err)
 		}
@@ -106,7 +201,7 @@ func TestArchive_BadData(t *testing.T) {
 		defer f.Close()
 
 		var metadata raft.SnapshotMeta
-		err = read(f, &metadata, io.Discard)
+		err = read(f, &metadata, io.Discard, nil)
 		if err == nil || !strings.Contains(err.Error(), c.Error) {
 			t.Fatalf("case %d (%s): %v", i, c.Name, err)
 		}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// The envelope utilities encrypt a snapshot archive so it can be stored
+// outside of the cluster without disclosing its contents. An encrypted
+// archive has the following layout:
+//
+// magic       - encryptedMagic, to tell it apart from a plain gzip'd archive
+// key ID      - the first 8 bytes of the SHA-256 sum of the key encryption key
+// wrap nonce  - nonce used to encrypt the data key with the key encryption key
+// wrapped key - the random AES-256 data key, encrypted with AES-GCM
+// prefix      - random nonce prefix for the chunks
+// chunks      - the archive in chunks of up to 64KiB, each preceded by its length
+//
+// The chunks are encrypted with the data key using AES-GCM. The nonce of each
+// chunk is the prefix followed by the chunk's counter and a flag marking the
+// final chunk, so reordered, dropped or truncated chunks are detected. Every
+// chunk also authenticates the header.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+const (
+	encryptedMagic = "CONSULSNAPENCv1\n"
+
+	envelopeKeyIDSize       = 8
+	envelopeNonceSize       = 12
+	envelopeDataKeySize     = 32
+	envelopeNoncePrefixSize = 7
+	envelopeChunkSize       = 64 * 1024
+)
+
+// envelopeHeaderSize is the size of the header of an encrypted archive.
+var envelopeHeaderSize = len(encryptedMagic) + envelopeKeyIDSize + envelopeNonceSize +
+	envelopeDataKeySize + 16 + envelopeNoncePrefixSize
+
+// ErrEncrypted is returned when reading an encrypted snapshot without any
+// encryption keys.
+var ErrEncrypted = errors.New("snapshot is encrypted and no encryption key was provided")
+
+// ParseEncryptionKey decodes a base64-encoded AES key, such as the keys
+// generated by "consul keygen". Keys must be 16, 24 or 32 bytes long.
+func ParseEncryptionKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key: %v", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("encryption key must be 16, 24 or 32 bytes long, got %d", len(key))
+	}
+}
+
+// IsEncrypted reports whether the archive read from the given reader is
+// encrypted, without consuming any of it.
+func IsEncrypted(in *bufio.Reader) (bool, error) {
+	magic, err := in.Peek(len(encryptedMagic))
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(magic) == encryptedMagic, nil
+}
+
+func envelopeKeyID(key []byte) []byte {
+	sum := sha256.Sum256(key)
+	return sum[:envelopeKeyIDSize]
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce for the given chunk.
+func chunkNonce(prefix []byte, counter uint32, final bool) []byte {
+	nonce := make([]byte, envelopeNonceSize)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[envelopeNoncePrefixSize:], counter)
+	if final {
+		nonce[envelopeNonceSize-1] = 1
+	}
+	return nonce
+}
+
+// encryptWriter encrypts everything written to it. Close must be called to
+// write the final chunk.
+type encryptWriter struct {
+	out     io.Writer
+	aead    cipher.AEAD
+	header  []byte
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// Encrypt returns a writer that encrypts everything written to it with a
+// random data key wrapped with the given key, and writes the result to out.
+// The returned writer must be closed to complete the archive, which does not
+// close out.
+func Encrypt(out io.Writer, key []byte) (io.WriteCloser, error) {
+	kek, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %v", err)
+	}
+
+	dataKey := make([]byte, envelopeDataKeySize)
+	wrapNonce := make([]byte, envelopeNonceSize)
+	prefix := make([]byte, envelopeNoncePrefixSize)
+	for _, b := range [][]byte{dataKey, wrapNonce, prefix} {
+		if _, err := io.ReadFull(rand.Reader, b); err != nil {
+			return nil, fmt.Errorf("failed to generate data key: %v", err)
+		}
+	}
+
+	header := make([]byte, 0, envelopeHeaderSize)
+	header = append(header, encryptedMagic...)
+	header = append(header, envelopeKeyID(key)...)
+	header = append(header, wrapNonce...)
+	header = kek.Seal(header, wrapNonce, dataKey, header[:len(encryptedMagic)+envelopeKeyIDSize])
+	header = append(header, prefix...)
+	if _, err := out.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write encryption header: %v", err)
+	}
+
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		out:    out,
+		aead:   aead,
+		header: header,
+		prefix: prefix,
+		buf:    make([]byte, 0, envelopeChunkSize),
+	}, nil
+}
+
+func (w *encryptWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("write to closed encrypted archive")
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		// Only flush a full chunk once more data arrives, so that the final
+		// chunk written by Close is never a duplicate empty one.
+		if len(w.buf) == envelopeChunkSize {
+			if err := w.seal(false); err != nil {
+				return 0, err
+			}
+		}
+		free := envelopeChunkSize - len(w.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		w.buf = append(w.buf, p[:free]...)
+		p = p[free:]
+	}
+	return n, nil
+}
+
+func (w *encryptWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.seal(true)
+}
+
+func (w *encryptWriter) seal(final bool) error {
+	if w.counter == math.MaxUint32 {
+		return errors.New("snapshot is too large to encrypt")
+	}
+
+	chunk := w.aead.Seal(make([]byte, 4, 4+len(w.buf)+w.aead.Overhead()),
+		chunkNonce(w.prefix, w.counter, final), w.buf, w.header)
+	binary.BigEndian.PutUint32(chunk, uint32(len(chunk)-4))
+	if _, err := w.out.Write(chunk); err != nil {
+		return fmt.Errorf("failed to write encrypted snapshot: %v", err)
+	}
+
+	w.counter++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// decryptReader decrypts an encrypted archive chunk by chunk.
+type decryptReader struct {
+	in      io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	done    bool
+}
+
+// Decrypt reads the header of an encrypted archive and returns a reader for
+// its decrypted contents. The archive must have been encrypted with one of the
+// given keys.
+func Decrypt(in io.Reader, keys [][]byte) (io.Reader, error) {
+	header := make([]byte, envelopeHeaderSize)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return nil, fmt.Errorf("failed to read encryption header: %v", err)
+	}
+	if string(header[:len(encryptedMagic)]) != encryptedMagic {
+		return nil, errors.New("snapshot is not encrypted")
+	}
+	if len(keys) == 0 {
+		return nil, ErrEncrypted
+	}
+
+	offset := len(encryptedMagic)
+	keyID := header[offset : offset+envelopeKeyIDSize]
+	offset += envelopeKeyIDSize
+	wrapNonce := header[offset : offset+envelopeNonceSize]
+	offset += envelopeNonceSize
+	wrapped := header[offset : offset+envelopeDataKeySize+16]
+	offset += envelopeDataKeySize + 16
+	prefix := header[offset:]
+
+	for _, key := range keys {
+		if !bytes.Equal(keyID, envelopeKeyID(key)) {
+			continue
+		}
+
+		kek, err := newGCM(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key: %v", err)
+		}
+		dataKey, err := kek.Open(nil, wrapNonce, wrapped, header[:len(encryptedMagic)+envelopeKeyIDSize])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt data key: %v", err)
+		}
+		aead, err := newGCM(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		return &decryptReader{in: in, aead: aead, header: header, prefix: prefix}, nil
+	}
+	return nil, errors.New("snapshot is encrypted with a key that was not provided")
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// open reads and decrypts the next chunk.
+func (r *decryptReader) open() error {
+	var size [4]byte
+	if _, err := io.ReadFull(r.in, size[:]); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("encrypted snapshot is truncated: %w", io.ErrUnexpectedEOF)
+		}
+		return fmt.Errorf("failed to read encrypted snapshot: %v", err)
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > uint32(envelopeChunkSize+r.aead.Overhead()) {
+		return fmt.Errorf("encrypted snapshot chunk is too large: %d bytes", n)
+	}
+	chunk := make([]byte, n)
+	if _, err := io.ReadFull(r.in, chunk); err != nil {
+		return fmt.Errorf("failed to read encrypted snapshot: %v", err)
+	}
+
+	// The final flag is part of the nonce, so try the regular chunk first and
+	// fall back to the final one.
+	plain, err := r.aead.Open(nil, chunkNonce(r.prefix, r.counter, false), chunk, r.header)
+	if err != nil {
+		plain, err = r.aead.Open(nil, chunkNonce(r.prefix, r.counter, true), chunk, r.header)
+		if err != nil {
+			return errors.New("failed to decrypt snapshot: message authentication failed")
+		}
+		r.done = true
+
+		var extra [1]byte
+		if n, _ := io.ReadFull(r.in, extra[:]); n != 0 {
+			return errors.New("unexpected data after the end of the encrypted snapshot")
+		}
+	}
+
+	r.counter++
+	r.buf = plain
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testEncryptionKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func testEncrypt(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	w, err := Encrypt(&out, key)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return out.Bytes()
+}
+
+func TestEnvelope_RoundTrip(t *testing.T) {
+	key := testEncryptionKey(t)
+
+	for name, size := range map[string]int{
+		"empty":            0,
+		"small":            100,
+		"exact chunk":      envelopeChunkSize,
+		"multiple chunks":  3*envelopeChunkSize + 17,
+		"two exact chunks": 2 * envelopeChunkSize,
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := make([]byte, size)
+			_, err := rand.Read(data)
+			require.NoError(t, err)
+
+			encrypted := testEncrypt(t, key, data)
+			require.False(t, bytes.Contains(encrypted, data) && size > 0)
+
+			isEncrypted, err := IsEncrypted(bufio.NewReader(bytes.NewReader(encrypted)))
+			require.NoError(t, err)
+			require.True(t, isEncrypted)
+
+			// The right key is picked among the given ones.
+			r, err := Decrypt(bytes.NewReader(encrypted), [][]byte{testEncryptionKey(t), key})
+			require.NoError(t, err)
+			decrypted, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, data, decrypted)
+		})
+	}
+}
+
+func TestEnvelope_Errors(t *testing.T) {
+	key := testEncryptionKey(t)
+	data := make([]byte, 2*envelopeChunkSize+100)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	encrypted := testEncrypt(t, key, data)
+
+	decrypt := func(in []byte, keys ...[]byte) error {
+		r, err := Decrypt(bytes.NewReader(in), keys)
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(r)
+		return err
+	}
+
+	t.Run("no key", func(t *testing.T) {
+		require.ErrorIs(t, decrypt(encrypted), ErrEncrypted)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		err := decrypt(encrypted, testEncryptionKey(t))
+		require.ErrorContains(t, err, "key that was not provided")
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		plain := bytes.Repeat([]byte{0x1f}, envelopeHeaderSize)
+		require.ErrorContains(t, decrypt(plain, key), "not encrypted")
+
+		isEncrypted, err := IsEncrypted(bufio.NewReader(bytes.NewReader(plain)))
+		require.NoError(t, err)
+		require.False(t, isEncrypted)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(encrypted)
+		tampered[envelopeHeaderSize+100] ^= 0xff
+		require.ErrorContains(t, decrypt(tampered, key), "authentication failed")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		// Dropping the final chunk leaves a valid but incomplete stream.
+		chunk := 4 + envelopeChunkSize + 16
+		truncated := encrypted[:envelopeHeaderSize+2*chunk]
+		require.ErrorIs(t, decrypt(truncated, key), io.ErrUnexpectedEOF)
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+		trailing := append(bytes.Clone(encrypted), 0)
+		require.ErrorContains(t, decrypt(trailing, key), "after the end")
+	})
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	key, err := ParseEncryptionKey("  " + base64.StdEncoding.EncodeToString(make([]byte, 32)) + "\n")
+	require.NoError(t, err)
+	require.Len(t, key, 32)
+
+	_, err = ParseEncryptionKey(base64.StdEncoding.EncodeToString(make([]byte, 20)))
+	require.ErrorContains(t, err, "16, 24 or 32 bytes")
+
+	_, err = ParseEncryptionKey("not base64!")
+	require.ErrorContains(t, err, "failed to decode")
+}
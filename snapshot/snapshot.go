@@ -3,14 +3,19 @@
 
 // snapshot manages the interactions between Consul and Raft in order to take
 // and restore snapshots for disaster recovery. The internal format of a
-// snapshot is simply a tar file, as described in archive.go.
+// snapshot is simply a tar file, as described in archive.go, which may be
+// encrypted as described in envelope.go.
 package snapshot
 
 import (
+	"bufio"
 	"compress/gzip"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
@@ -24,6 +29,42 @@ type Snapshot struct {
 	index uint64
 }
 
+// Options configure the encryption and signing of snapshot archives. A nil
+// Options writes and reads plain, unsigned archives.
+type Options struct {
+	// EncryptionKeys are AES keys. Archives are written encrypted with the
+	// first key, and encrypted archives can be read with any of them.
+	EncryptionKeys [][]byte
+
+	// SigningKey signs the archives that are written, if set.
+	SigningKey ed25519.PrivateKey
+
+	// VerifyKey makes reading refuse archives that are not signed with the
+	// matching private key, if set.
+	VerifyKey ed25519.PublicKey
+}
+
+func (o *Options) encryptionKeys() [][]byte {
+	if o == nil {
+		return nil
+	}
+	return o.EncryptionKeys
+}
+
+func (o *Options) signingKey() ed25519.PrivateKey {
+	if o == nil {
+		return nil
+	}
+	return o.SigningKey
+}
+
+func (o *Options) verifyKey() ed25519.PublicKey {
+	if o == nil {
+		return nil
+	}
+	return o.VerifyKey
+}
+
 // New takes a state snapshot of the given Raft instance into a temporary file
 // and returns an object that gives access to the file as an io.Reader. You must
 // arrange to call Close() on the returned object or else you will leak a
@@ -68,17 +109,9 @@ func New(logger hclog.Logger, r *raft.Raft) (*Snapshot, error) {
 		}
 	}()
 
-	// Wrap the file writer in a gzip compressor.
-	compressor := gzip.NewWriter(archive)
-
 	// Write the archive.
-	if err := write(compressor, metadata, snap); err != nil {
-		return nil, fmt.Errorf("failed to write snapshot file: %v", err)
-	}
-
-	// Finish the compressed stream.
-	if err := compressor.Close(); err != nil {
-		return nil, fmt.Errorf("failed to compress snapshot file: %v", err)
+	if err := Write(archive, metadata, snap, nil); err != nil {
+		return nil, err
 	}
 
 	// Sync the compressed file and rewind it so it's ready to be streamed
@@ -126,18 +159,152 @@ func (s *Snapshot) Close() error {
 	return os.Remove(s.file.Name())
 }
 
-// Verify takes the snapshot from the reader and verifies its contents.
-func Verify(in io.Reader) (*raft.SnapshotMeta, error) {
-	// Wrap the reader in a gzip decompressor.
+// Write creates a compressed archive with the snapshot metadata and the
+// snapshot data read from snap, which must be metadata.Size bytes long, and
+// encrypts and signs it as configured by opts.
+func Write(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader, opts *Options) error {
+	return writeArchive(out, metadata, snap, opts, nil)
+}
+
+// writeArchive is Write, but calls beforeClose once the archive has been
+// written and before the compressed and encrypted streams are completed. If
+// beforeClose fails the output is left incomplete, so it cannot be read back
+// as a valid snapshot.
+func writeArchive(out io.Writer, metadata *raft.SnapshotMeta, snap io.Reader, opts *Options, beforeClose func() error) error {
+	// Encrypt everything written to the file, if configured.
+	var encryptor io.WriteCloser
+	if keys := opts.encryptionKeys(); len(keys) > 0 {
+		var err error
+		if encryptor, err = Encrypt(out, keys[0]); err != nil {
+			return err
+		}
+		out = encryptor
+	}
+
+	// Wrap the file writer in a gzip compressor.
+	compressor := gzip.NewWriter(out)
+
+	// Write the archive.
+	if err := write(compressor, metadata, snap, opts.signingKey()); err != nil {
+		return fmt.Errorf("failed to write snapshot file: %v", err)
+	}
+	if beforeClose != nil {
+		if err := beforeClose(); err != nil {
+			return err
+		}
+	}
+
+	// Finish the compressed stream.
+	if err := compressor.Close(); err != nil {
+		return fmt.Errorf("failed to compress snapshot file: %v", err)
+	}
+	if encryptor != nil {
+		if err := encryptor.Close(); err != nil {
+			return fmt.Errorf("failed to encrypt snapshot file: %v", err)
+		}
+	}
+	return nil
+}
+
+// Rewrite reads the archive from in, decrypting it and checking its signature
+// as configured by inOpts, and streams it to out encrypted and signed as
+// configured by outOpts. The snapshot data is never held in memory or written
+// to disk in between. The output is only completed once the input has been
+// fully verified, so a failure leaves it unreadable.
+func Rewrite(out io.Writer, in io.Reader, inOpts, outOpts *Options) (*raft.SnapshotMeta, error) {
+	decomp, err := decompress(in, inOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer decomp.Close()
+
+	// The snapshot data is passed from the reader to the writer through a
+	// pipe, which is only closed without an error once the whole input has
+	// been read and verified.
+	pr, pw := io.Pipe()
+	var metadata raft.SnapshotMeta
+	done := make(chan struct{})
+	defer func() {
+		pr.Close()
+		<-done
+	}()
+	go func() {
+		defer close(done)
+		err := read(decomp, &metadata, pw, inOpts.verifyKey())
+		if err == nil {
+			err = concludeGzipRead(decomp)
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to read snapshot file: %w", err)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	// The metadata comes first in the archive, so it is known once the
+	// snapshot data starts to arrive or the input is done.
+	snap := bufio.NewReader(pr)
+	if _, err := snap.Peek(1); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	// Wait for the rest of the input to be verified before completing the
+	// output, and make sure there is no snapshot data left over.
+	verified := func() error {
+		n, err := snap.Read(make([]byte, 1))
+		if n > 0 {
+			return fmt.Errorf("failed to read snapshot file: snapshot data is larger than %d bytes", metadata.Size)
+		}
+		if err != io.EOF {
+			return err
+		}
+		return nil
+	}
+	if err := writeArchive(out, &metadata, snap, outOpts, verified); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// decompress wraps the reader in a gzip decompressor, after decrypting it if
+// the archive is encrypted.
+func decompress(in io.Reader, opts *Options) (*gzip.Reader, error) {
+	buffered := bufio.NewReader(in)
+	encrypted, err := IsEncrypted(buffered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %v", err)
+	}
+
+	in = buffered
+	if encrypted {
+		if in, err = Decrypt(buffered, opts.encryptionKeys()); err != nil {
+			return nil, err
+		}
+	}
+
 	decomp, err := gzip.NewReader(in)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decompress snapshot: %v", err)
 	}
+	return decomp, nil
+}
+
+// Verify takes the snapshot from the reader and verifies its contents.
+func Verify(in io.Reader) (*raft.SnapshotMeta, error) {
+	return VerifyWithOptions(in, nil)
+}
+
+// VerifyWithOptions takes the snapshot from the reader and verifies its
+// contents, decrypting it and checking its signature as configured by opts.
+func VerifyWithOptions(in io.Reader, opts *Options) (*raft.SnapshotMeta, error) {
+	decomp, err := decompress(in, opts)
+	if err != nil {
+		return nil, err
+	}
 	defer decomp.Close()
 
 	// Read the archive, throwing away the snapshot data.
 	var metadata raft.SnapshotMeta
-	if err := read(decomp, &metadata, io.Discard); err != nil {
+	if err := read(decomp, &metadata, io.Discard, opts.verifyKey()); err != nil {
 		return nil, fmt.Errorf("failed to read snapshot file: %v", err)
 	}
 
@@ -163,12 +330,20 @@ func concludeGzipRead(decomp *gzip.Reader) error {
 	return nil
 }
 
-// Read a snapshot into a temporary file. The caller is responsible for removing the file.
+// Read a snapshot into a temporary file. The caller is responsible for removing
+// the file with RemoveTemp.
 func Read(logger hclog.Logger, in io.Reader) (*os.File, *raft.SnapshotMeta, error) {
-	// Wrap the reader in a gzip decompressor.
-	decomp, err := gzip.NewReader(in)
+	return ReadWithOptions(logger, in, nil)
+}
+
+// ReadWithOptions reads a snapshot into a temporary file, decrypting it and
+// checking its signature as configured by opts. The file is only readable by
+// the current user, in a private directory. The caller is responsible for
+// removing the file with RemoveTemp.
+func ReadWithOptions(logger hclog.Logger, in io.Reader, opts *Options) (_ *os.File, _ *raft.SnapshotMeta, retErr error) {
+	decomp, err := decompress(in, opts)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to decompress snapshot: %v", err)
+		return nil, nil, err
 	}
 	defer func() {
 		if err := decomp.Close(); err != nil {
@@ -177,15 +352,28 @@ func Read(logger hclog.Logger, in io.Reader) (*os.File, *raft.SnapshotMeta, erro
 	}()
 
 	// Make a scratch file to receive the contents of the snapshot data so
-	// we can avoid buffering in memory.
-	snap, err := os.CreateTemp("", "snapshot")
+	// we can avoid buffering in memory. The data may have been encrypted, so
+	// the file is kept in a directory that only the current user can access.
+	dir, err := os.MkdirTemp("", "snapshot")
 	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create temp snapshot directory: %v", err)
+	}
+	snap, err := os.OpenFile(filepath.Join(dir, "state.bin"), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		os.RemoveAll(dir)
 		return nil, nil, fmt.Errorf("failed to create temp snapshot file: %v", err)
 	}
+	defer func() {
+		if retErr != nil {
+			if err := RemoveTemp(snap); err != nil {
+				logger.Error("Failed to clean up temp snapshot", "error", err)
+			}
+		}
+	}()
 
 	// Read the archive.
 	var metadata raft.SnapshotMeta
-	if err := read(decomp, &metadata, snap); err != nil {
+	if err := read(decomp, &metadata, snap, opts.verifyKey()); err != nil {
 		return nil, nil, fmt.Errorf("failed to read snapshot file: %v", err)
 	}
 
@@ -203,24 +391,27 @@ func Read(logger hclog.Logger, in io.Reader) (*os.File, *raft.SnapshotMeta, erro
 	return snap, &metadata, nil
 }
 
+// RemoveTemp closes and removes a temporary file returned by Read or
+// ReadWithOptions, along with its directory.
+func RemoveTemp(f *os.File) error {
+	if err := f.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return err
+	}
+	return os.RemoveAll(filepath.Dir(f.Name()))
+}
+
 // Restore takes the snapshot from the reader and attempts to apply it to the
 // given Raft instance.
 func Restore(logger hclog.Logger, in io.Reader, r *raft.Raft) error {
 	snap, metadata, err := Read(logger, in)
+	if err != nil {
+		return err
+	}
 	defer func() {
-		if snap == nil {
-			return
-		}
-		if err := snap.Close(); err != nil {
-			logger.Error("Failed to close temp snapshot", "error", err)
-		}
-		if err := os.Remove(snap.Name()); err != nil {
+		if err := RemoveTemp(snap); err != nil {
 			logger.Error("Failed to clean up temp snapshot", "error", err)
 		}
 	}()
-	if err != nil {
-		return err
-	}
 
 	// Feed the snapshot into Raft.
 	if err := r.Restore(metadata, snap, 0); err != nil {
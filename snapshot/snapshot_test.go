@@ -5,6 +5,7 @@ package snapshot
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -213,6 +214,111 @@ func TestSnapshot(t *testing.T) {
 	}
 }
 
+func TestSnapshot_WriteWithOptions(t *testing.T) {
+	metadata := raft.SnapshotMeta{Index: 2005, Term: 2011, Size: 256 * 1024}
+	data := make([]byte, metadata.Size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	opts := &Options{EncryptionKeys: [][]byte{key}, SigningKey: priv}
+	require.NoError(t, Write(&archive, &metadata, bytes.NewReader(data), opts))
+
+	t.Run("read", func(t *testing.T) {
+		logger := testutil.Logger(t)
+		snap, newMeta, err := ReadWithOptions(logger, bytes.NewReader(archive.Bytes()), &Options{
+			EncryptionKeys: [][]byte{key},
+			VerifyKey:      pub,
+		})
+		require.NoError(t, err)
+		defer RemoveTemp(snap)
+
+		require.Equal(t, metadata.Index, newMeta.Index)
+		newData, err := io.ReadAll(snap)
+		require.NoError(t, err)
+		require.Equal(t, data, newData)
+	})
+
+	t.Run("verify without key", func(t *testing.T) {
+		_, err := Verify(bytes.NewReader(archive.Bytes()))
+		require.ErrorIs(t, err, ErrEncrypted)
+	})
+
+	t.Run("verify unsigned", func(t *testing.T) {
+		var unsigned bytes.Buffer
+		require.NoError(t, Write(&unsigned, &metadata, bytes.NewReader(data), nil))
+
+		_, err := Verify(bytes.NewReader(unsigned.Bytes()))
+		require.NoError(t, err)
+		_, err = VerifyWithOptions(bytes.NewReader(unsigned.Bytes()), &Options{VerifyKey: pub})
+		require.ErrorContains(t, err, "snapshot is not signed")
+	})
+}
+
+func TestSnapshot_Rewrite(t *testing.T) {
+	metadata := raft.SnapshotMeta{Index: 2005, Term: 2011, Size: 256 * 1024}
+	data := make([]byte, metadata.Size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var plain bytes.Buffer
+	require.NoError(t, Write(&plain, &metadata, bytes.NewReader(data), nil))
+
+	// Seal the plain archive.
+	var sealed bytes.Buffer
+	newMeta, err := Rewrite(&sealed, bytes.NewReader(plain.Bytes()), nil, &Options{
+		EncryptionKeys: [][]byte{key},
+		SigningKey:     priv,
+	})
+	require.NoError(t, err)
+	require.Equal(t, metadata.Index, newMeta.Index)
+	require.NotContains(t, string(sealed.Bytes()), string(data[:64]))
+
+	opts := &Options{EncryptionKeys: [][]byte{key}, VerifyKey: pub}
+	_, err = VerifyWithOptions(bytes.NewReader(sealed.Bytes()), opts)
+	require.NoError(t, err)
+
+	t.Run("unseal", func(t *testing.T) {
+		var unsealed bytes.Buffer
+		_, err := Rewrite(&unsealed, bytes.NewReader(sealed.Bytes()), opts, nil)
+		require.NoError(t, err)
+
+		snap, _, err := Read(testutil.Logger(t), &unsealed)
+		require.NoError(t, err)
+		defer RemoveTemp(snap)
+		newData, err := io.ReadAll(snap)
+		require.NoError(t, err)
+		require.Equal(t, data, newData)
+	})
+
+	t.Run("failed verification leaves the output incomplete", func(t *testing.T) {
+		otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		var unsealed bytes.Buffer
+		_, err = Rewrite(&unsealed, bytes.NewReader(sealed.Bytes()), &Options{
+			EncryptionKeys: [][]byte{key},
+			VerifyKey:      otherPub,
+		}, nil)
+		require.ErrorContains(t, err, "snapshot signature is not valid")
+
+		_, err = Verify(&unsealed)
+		require.Error(t, err)
+	})
+}
+
 func TestSnapshot_Nil(t *testing.T) {
 	var snap *Snapshot
 
@@ -869,6 +869,27 @@ func (c *Configurator) IncomingHTTPSConfig() *tls.Config {
 	return config
 }
 
+// IncomingDNSConfig generates a *tls.Config for incoming DNS-over-TLS and
+// DNS-over-HTTPS connections. Both share the HTTPS protocol settings and
+// certificate, but advertise their own ALPN protocols.
+func (c *Configurator) IncomingDNSConfig(alpnProtos []string) *tls.Config {
+	c.log("IncomingDNSConfig")
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	config := c.commonTLSConfig(
+		c.https,
+		c.base.HTTPS,
+		c.base.HTTPS.VerifyIncoming,
+	)
+	config.NextProtos = alpnProtos
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return c.IncomingDNSConfig(alpnProtos), nil
+	}
+	return config
+}
+
 // OutgoingTLSConfigForCheck creates a client *tls.Config for executing checks.
 // It is RECOMMENDED that the serverName be left unspecified. The crypto/tls
 // client will deduce the ServerName (for SNI) from the check address unless
@@ -44,6 +44,10 @@ func TestConfigurator_IncomingConfig_Common(t *testing.T) {
 			func(lc ProtocolConfig) Config { return Config{HTTPS: lc} },
 			func(c *Configurator) *tls.Config { return c.IncomingHTTPSConfig() },
 		},
+		"DNS": {
+			func(lc ProtocolConfig) Config { return Config{HTTPS: lc} },
+			func(c *Configurator) *tls.Config { return c.IncomingDNSConfig([]string{"dot"}) },
+		},
 	}
 
 	for desc, tc := range testCases {